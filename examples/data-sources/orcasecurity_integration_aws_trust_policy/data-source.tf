@@ -0,0 +1,13 @@
+# Render the trust policy for the role Orca assumes to deliver alerts to AWS.
+data "orcasecurity_integration_aws_trust_policy" "orca" {
+  external_id = "orca-8f14e45f"
+}
+
+resource "aws_iam_role" "orca" {
+  name               = "orca-alerts-writer"
+  assume_role_policy = data.orcasecurity_integration_aws_trust_policy.orca.trust_policy_json
+}
+
+output "orca_principal_arn" {
+  value = data.orcasecurity_integration_aws_trust_policy.orca.principal_arn
+}
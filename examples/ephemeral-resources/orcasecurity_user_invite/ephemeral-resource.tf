@@ -0,0 +1,22 @@
+// Issue an invite and hand the link to a secret manager without it ever
+// reaching plan or state. Requires Terraform 1.11+ for write-only arguments.
+ephemeral "orcasecurity_user_invite" "contractor" {
+  email   = "contractor@example.com"
+  role_id = orcasecurity_custom_role.example.id
+}
+
+resource "aws_secretsmanager_secret" "contractor_invite" {
+  name = "orca/invites/contractor"
+}
+
+resource "aws_secretsmanager_secret_version" "contractor_invite" {
+  secret_id                = aws_secretsmanager_secret.contractor_invite.id
+  secret_string_wo         = ephemeral.orcasecurity_user_invite.contractor.invite_link
+  secret_string_wo_version = 1
+}
+
+resource "orcasecurity_custom_role" "example" {
+  name              = "tf_example_contractor"
+  description       = "Example role for user_invite demo"
+  permission_groups = ["assets.asset.read"]
+}
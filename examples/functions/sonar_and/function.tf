@@ -0,0 +1,11 @@
+locals {
+  # Public, internet-facing assets that also have a critical alert.
+  exposed = provider::orcasecurity::sonar_and(
+    { key = "InternetFacing", operator = "eq", type = "bool", values = [true] },
+    { key = "OrcaScore", operator = "range", type = "float", values = [9, 10] },
+  )
+}
+
+output "exposed_query" {
+  value = provider::orcasecurity::sonar_query(["Alert"], local.exposed)
+}
@@ -0,0 +1,13 @@
+# A query exported from the Orca UI keeps a stable diff regardless of its
+# formatting or key order.
+resource "orcasecurity_scheduled_report" "from_ui_export" {
+  name              = "Weekly exported query"
+  type              = "alerts_svl"
+  format            = "csv"
+  recurrence        = "weekly"
+  first_report_date = "2026-07-01T13:00:00Z"
+  export_time       = "13:00:00"
+  recipients_emails = ["security-team@example.com"]
+
+  sonar_query = provider::orcasecurity::sonar_normalize(file("${path.module}/queries/weekly.json"))
+}
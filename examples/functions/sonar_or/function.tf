@@ -0,0 +1,15 @@
+locals {
+  s3_or_sqs = provider::orcasecurity::sonar_or(
+    { key = "AlertType", operator = "in", type = "str", values = ["S3 bucket data is not protected"] },
+    { key = "AlertType", operator = "in", type = "str", values = ["SQS queue with public access"] },
+  )
+}
+
+output "query" {
+  # sonar_or results can be nested inside sonar_and / sonar_query.
+  value = provider::orcasecurity::sonar_query(
+    ["Alert"],
+    local.s3_or_sqs,
+    { key = "OrcaScore", operator = "range", type = "float", values = [7, 10] },
+  )
+}
@@ -0,0 +1,34 @@
+# Critical AWS/Azure alerts, composed from reusable conditions.
+locals {
+  critical = {
+    key      = "OrcaScore"
+    operator = "range"
+    type     = "float"
+    values   = [7, 10]
+  }
+  aws_or_azure = {
+    keys     = ["CloudAccount"]
+    models   = ["CloudAccount"]
+    operator = "has"
+    type     = "object"
+    with = {
+      key      = "CloudProvider"
+      operator = "in"
+      type     = "str"
+      values   = ["aws", "azure"]
+    }
+  }
+}
+
+resource "orcasecurity_automation_v2" "critical_cloud_alerts" {
+  name   = "Critical cloud alerts"
+  status = "enabled"
+
+  filter = {
+    sonar_query = provider::orcasecurity::sonar_query(["Alert"], local.critical, local.aws_or_azure)
+  }
+
+  slack_template = {
+    external_config_id = "11111111-2222-3333-4444-555555555555"
+  }
+}
@@ -0,0 +1,10 @@
+// Run with `terraform query` (Terraform 1.14+) to find existing objects and,
+// with -generate-config-out, write import blocks and configuration for them.
+list "orcasecurity_integration_akamai" "all" {
+  provider         = orcasecurity
+  include_resource = true
+
+  config {
+    is_enabled = true
+  }
+}
@@ -1 +1,5 @@
+# By assignment id
 terraform import orcasecurity_group_access.example ASSIGNMENT_ID
+
+# By group and role, when the group holds that role only once
+terraform import orcasecurity_group_access.example GROUP_ID/ROLE_ID
@@ -0,0 +1,20 @@
+# AWS Security Lake integration. Register a custom source for Orca in Security
+# Lake first, and use the role below as the custom source's provider role.
+data "orcasecurity_integration_aws_trust_policy" "orca" {
+  external_id = var.orca_external_id
+}
+
+resource "aws_iam_role" "orca" {
+  name               = "orca-security-lake-writer"
+  assume_role_policy = data.orcasecurity_integration_aws_trust_policy.orca.trust_policy_json
+}
+
+resource "orcasecurity_integration_aws_security_lake" "example" {
+  template_name = "orca-security-lake"
+  s3_location   = "s3://aws-security-data-lake-us-east-1-abc123/ext/orca/"
+  region        = "us-east-1"
+  role_arn      = aws_iam_role.orca.arn
+  external_id   = var.orca_external_id
+  is_enabled    = true
+  is_default    = false
+}
@@ -0,0 +1,38 @@
+# AWS SNS integration with the role Orca assumes to reach it
+data "orcasecurity_integration_aws_trust_policy" "orca" {
+  external_id = var.orca_external_id
+}
+
+resource "aws_sns_topic" "orca" {
+  name = "orca-alerts"
+}
+
+resource "aws_iam_role" "orca" {
+  name               = "orca-sns-writer"
+  assume_role_policy = data.orcasecurity_integration_aws_trust_policy.orca.trust_policy_json
+}
+
+resource "aws_iam_role_policy" "orca" {
+  role   = aws_iam_role.orca.id
+  policy = jsonencode({
+    Version   = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = "sns:Publish"
+      Resource = aws_sns_topic.orca.arn
+    }]
+  })
+}
+
+resource "orcasecurity_integration_aws_sns" "example" {
+  template_name = "orca-sns"
+  topic_arn     = aws_sns_topic.orca.arn
+  region        = "us-east-1"
+  role_arn      = aws_iam_role.orca.arn
+  external_id   = var.orca_external_id
+  is_enabled    = true
+  is_default    = false
+
+  # Orca's create call assumes the role, so the permissions must exist first.
+  depends_on = [aws_iam_role_policy.orca]
+}
@@ -0,0 +1,10 @@
+# Google Chronicle integration
+resource "orcasecurity_integration_chronicle" "example" {
+  template_name        = "chronicle-eu"
+  customer_id          = "c1a2b3c4-5d6e-4f70-8192-a3b4c5d6e7f8"
+  region               = "europe"
+  log_type             = "ORCA"
+  service_account_json = file("${path.module}/chronicle-ingestion-sa.json")
+  is_enabled           = true
+  is_default           = false
+}
@@ -0,0 +1,26 @@
+# Coralogix integration sending alerts to the EU2 region
+resource "orcasecurity_integration_coralogix" "example" {
+  template_name = "coralogix-eu2"
+  webhook_url   = "https://ingress.eu2.coralogix.com/logs/v1/singles"
+  is_enabled    = true
+  is_default    = false
+
+  auth_headers = {
+    Authorization = "Bearer ${var.coralogix_send_your_data_key}"
+  }
+}
+
+resource "orcasecurity_automation_v2" "coralogix" {
+  name = "Send alerts to Coralogix"
+
+  filter = {
+    sonar_query = jsonencode({
+      models = ["Alert"]
+      type   = "object_set"
+    })
+  }
+
+  coralogix_template = {
+    external_config_id = orcasecurity_integration_coralogix.example.id
+  }
+}
@@ -0,0 +1,8 @@
+# Cribl Stream integration
+resource "orcasecurity_integration_cribl" "example" {
+  template_name = "cribl-cloud"
+  url           = "https://default.main.acme.cribl.cloud:10080/cribl/_bulk"
+  token         = var.cribl_http_source_token
+  is_enabled    = true
+  is_default    = false
+}
@@ -0,0 +1,28 @@
+# Datadog integration
+resource "orcasecurity_integration_datadog" "example" {
+  template_name = "datadog-eu"
+  site          = "datadoghq.eu"
+  api_key       = var.datadog_api_key
+  tags          = ["env:prod", "team:security"]
+  service       = "orca"
+  source        = "orca-security"
+  is_enabled    = true
+  is_default    = false
+}
+
+# Forward matching alerts to Datadog as logs.
+resource "orcasecurity_automation_v2" "datadog" {
+  name = "Send alerts to Datadog"
+
+  filter = {
+    sonar_query = jsonencode({
+      models = ["Alert"]
+      type   = "object_set"
+    })
+  }
+
+  datadog_template = {
+    external_config_id = orcasecurity_integration_datadog.example.id
+    type               = "LOGS"
+  }
+}
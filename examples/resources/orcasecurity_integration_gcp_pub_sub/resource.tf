@@ -0,0 +1,30 @@
+# GCP Pub/Sub integration
+resource "google_pubsub_topic" "orca" {
+  name = "orca-alerts"
+}
+
+resource "google_service_account" "orca" {
+  account_id   = "orca-pubsub-publisher"
+  display_name = "Orca Pub/Sub publisher"
+}
+
+resource "google_pubsub_topic_iam_member" "orca" {
+  topic  = google_pubsub_topic.orca.id
+  role   = "roles/pubsub.publisher"
+  member = google_service_account.orca.member
+}
+
+resource "google_service_account_key" "orca" {
+  service_account_id = google_service_account.orca.name
+}
+
+resource "orcasecurity_integration_gcp_pub_sub" "example" {
+  template_name        = "orca-pubsub"
+  project_id           = google_pubsub_topic.orca.project
+  topic_id             = google_pubsub_topic.orca.name
+  service_account_json = base64decode(google_service_account_key.orca.private_key)
+  is_enabled           = true
+  is_default           = false
+
+  depends_on = [google_pubsub_topic_iam_member.orca]
+}
@@ -0,0 +1,34 @@
+# Microsoft Teams template: posts Orca alerts to a Teams channel through an incoming webhook.
+resource "orcasecurity_integration_ms_teams_template" "example" {
+  template_name = "teams-security-alerts"
+  webhook_url   = var.teams_webhook_url
+
+  # List values: a bare string pulls an Orca alert field; an object is a literal.
+  mapping_json = jsonencode({
+    title       = ["alert_title"]
+    description = ["asset_name", "risk_level", { custom = "Raised by Orca" }]
+  })
+
+  business_units = [
+    "a411f20b-0276-438c-a9d5-938c48a40957",
+  ]
+
+  is_enabled = true
+  is_default = false
+}
+
+# Reference the template from an automation.
+resource "orcasecurity_automation_v2" "teams" {
+  name = "Notify Teams on new alerts"
+
+  filter = {
+    sonar_query = jsonencode({
+      models = ["Alert"]
+      type   = "object_set"
+    })
+  }
+
+  ms_teams_template = {
+    external_config_id = orcasecurity_integration_ms_teams_template.example.id
+  }
+}
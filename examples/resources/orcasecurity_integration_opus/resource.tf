@@ -0,0 +1,31 @@
+# Opus integration scoped to two business units
+resource "orcasecurity_integration_opus" "example" {
+  template_name = "opus-alerts"
+  webhook_url   = var.opus_webhook_url
+  is_enabled    = true
+  is_default    = false
+
+  auth_headers = {
+    Authorization = "Bearer ${var.opus_token}"
+  }
+
+  business_units = [
+    "d7a3b159-3063-433b-b954-0586ff3e8438",
+    "003b5734-131f-4d3a-8bfa-abaed4d139fe",
+  ]
+}
+
+resource "orcasecurity_automation_v2" "opus" {
+  name = "Send alerts to Opus"
+
+  filter = {
+    sonar_query = jsonencode({
+      models = ["Alert"]
+      type   = "object_set"
+    })
+  }
+
+  opus_template = {
+    external_config_id = orcasecurity_integration_opus.example.id
+  }
+}
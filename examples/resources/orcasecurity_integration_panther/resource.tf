@@ -0,0 +1,26 @@
+# Panther integration posting to an HTTP log source with bearer auth
+resource "orcasecurity_integration_panther" "example" {
+  template_name = "panther-prod"
+  webhook_url   = "https://logs.acme.runpanther.net/http/5f1c3a9e-2b7d-4c8e-9a01-23456789abcd"
+  is_enabled    = true
+  is_default    = false
+
+  auth_headers = {
+    Authorization = "Bearer ${var.panther_http_source_token}"
+  }
+}
+
+resource "orcasecurity_automation_v2" "panther" {
+  name = "Send alerts to Panther"
+
+  filter = {
+    sonar_query = jsonencode({
+      models = ["Alert"]
+      type   = "object_set"
+    })
+  }
+
+  panther_template = {
+    external_config_id = orcasecurity_integration_panther.example.id
+  }
+}
@@ -0,0 +1,9 @@
+# Sumo Logic integration
+resource "orcasecurity_integration_sumo_logic" "example" {
+  template_name   = "sumo-logic-prod"
+  url             = var.sumo_logic_http_source_url
+  source_category = "security/orca"
+  source_name     = "orca-alerts"
+  is_enabled      = true
+  is_default      = false
+}
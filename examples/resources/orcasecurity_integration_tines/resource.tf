@@ -0,0 +1,31 @@
+# Tines integration scoped to two business units
+resource "orcasecurity_integration_tines" "example" {
+  template_name = "tines-alerts"
+  webhook_url   = var.tines_webhook_url
+  is_enabled    = true
+  is_default    = false
+
+  auth_headers = {
+    Authorization = "Bearer ${var.tines_token}"
+  }
+
+  business_units = [
+    "d7a3b159-3063-433b-b954-0586ff3e8438",
+    "003b5734-131f-4d3a-8bfa-abaed4d139fe",
+  ]
+}
+
+resource "orcasecurity_automation_v2" "tines" {
+  name = "Send alerts to Tines"
+
+  filter = {
+    sonar_query = jsonencode({
+      models = ["Alert"]
+      type   = "object_set"
+    })
+  }
+
+  tines_template = {
+    external_config_id = orcasecurity_integration_tines.example.id
+  }
+}
@@ -0,0 +1,31 @@
+# Torq integration scoped to two business units
+resource "orcasecurity_integration_torq" "example" {
+  template_name = "torq-alerts"
+  webhook_url   = var.torq_webhook_url
+  is_enabled    = true
+  is_default    = false
+
+  auth_headers = {
+    "X-Torq-Secret" = var.torq_webhook_secret
+  }
+
+  business_units = [
+    "d7a3b159-3063-433b-b954-0586ff3e8438",
+    "003b5734-131f-4d3a-8bfa-abaed4d139fe",
+  ]
+}
+
+resource "orcasecurity_automation_v2" "torq" {
+  name = "Send alerts to Torq"
+
+  filter = {
+    sonar_query = jsonencode({
+      models = ["Alert"]
+      type   = "object_set"
+    })
+  }
+
+  torq_template = {
+    external_config_id = orcasecurity_integration_torq.example.id
+  }
+}
@@ -1 +1,5 @@
+# By assignment id
 terraform import orcasecurity_user_access.example ASSIGNMENT_ID
+
+# By user and role, when the user holds that role only once
+terraform import orcasecurity_user_access.example USER_ID/ROLE_ID
@@ -3,7 +3,10 @@ package add_users
 import (
 	"context"
 	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	"terraform-provider-orcasecurity/orcasecurity/apierror_common"
+	"terraform-provider-orcasecurity/orcasecurity/identity_common"
 	common "terraform-provider-orcasecurity/orcasecurity/integrations_common"
+	"terraform-provider-orcasecurity/orcasecurity/timeouts_common"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -22,6 +25,7 @@ var (
 	_ resource.Resource                     = &addUsersResource{}
 	_ resource.ResourceWithConfigure        = &addUsersResource{}
 	_ resource.ResourceWithImportState      = &addUsersResource{}
+	_ resource.ResourceWithIdentity         = &addUsersResource{}
 	_ resource.ResourceWithConfigValidators = &addUsersResource{}
 )
 
@@ -40,8 +44,11 @@ type addUsersResourceModel struct {
 	ShiftleftProjects types.List   `tfsdk:"shiftleft_projects"`
 	MFARequired       types.Bool   `tfsdk:"mfa_required"`
 	ShouldSendEmail   types.Bool   `tfsdk:"should_send_email"`
+	StoreInviteLink   types.Bool   `tfsdk:"store_invite_link"`
 	InviteLink        types.String `tfsdk:"invite_link"`
 	Expired           types.Bool   `tfsdk:"expired"`
+
+	Timeouts timeouts_common.Value `tfsdk:"timeouts"`
 }
 
 func NewAddUsersResource() resource.Resource {
@@ -60,7 +67,11 @@ func (r *addUsersResource) Configure(_ context.Context, req resource.ConfigureRe
 }
 
 func (r *addUsersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	identity_common.ImportID(ctx, req, resp)
+}
+
+func (r *addUsersResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity_common.IDSchema()
 }
 
 func (r *addUsersResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
@@ -72,7 +83,7 @@ func (r *addUsersResource) ConfigValidators(_ context.Context) []resource.Config
 	}
 }
 
-func (r *addUsersResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *addUsersResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Invites a single user to the organization (\"Add Users\" in the UI). Backed by /api/user_invites. " +
 			"Assign either an RBAC role (`role_id`) or one or more groups (`groups`). The Orca invite API has no update " +
@@ -159,11 +170,23 @@ func (r *addUsersResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 					boolplanmodifier.RequiresReplace(),
 				},
 			},
+			"store_invite_link": schema.BoolAttribute{
+				Description: "Persist `invite_link` in state. Defaults to true for compatibility, but storing the link is " +
+					"deprecated and the default will change in a future release. Set to false to keep the link out of state " +
+					"and plan; the link is only returned at creation time, so it cannot be read back later. Use the " +
+					"`orcasecurity_user_invite` ephemeral resource instead of this resource to issue invites whose link is needed.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
 			"invite_link": schema.StringAttribute{
-				Description: "Registration link for the invited user. Only populated at creation time.",
-				Computed:    true,
+				Description: "Registration link for the invited user. Only populated at creation time, and null when " +
+					"`store_invite_link` is false. Deprecated, see `store_invite_link`.",
+				Computed:  true,
+				Sensitive: true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
+					inviteLinkPlanModifier{},
 				},
 			},
 			"expired": schema.BoolAttribute{
@@ -171,6 +194,9 @@ func (r *addUsersResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				Computed:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts_common.Block(ctx),
+		},
 	}
 }
 
@@ -207,33 +233,41 @@ func (r *addUsersResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	ctx, cancel := timeouts_common.Create(ctx, plan.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
 	payload, diags := r.modelToRequest(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	invite, err := r.apiClient.CreateUserInvite(payload)
+	invite, err := r.apiClient.CreateUserInvite(ctx, payload)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating user invite", err.Error())
+		apierror_common.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, "Error creating user invite", "Could not create user invite", err)
 		return
 	}
 
 	plan.ID = types.StringValue(invite.ID)
-	plan.InviteLink = types.StringValue(invite.InviteLink)
+	resp.Diagnostics.Append(identity_common.SetID(ctx, resp.Identity, plan.ID.ValueString())...)
+	plan.InviteLink = inviteLink(plan.StoreInviteLink, invite.InviteLink)
 	plan.Expired = types.BoolValue(invite.Expired)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
 func (r *addUsersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(identity_common.SetIDFromState(ctx, resp.Identity, req.State)...)
 	var state addUsersResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	invite, err := r.apiClient.GetUserInvite(state.ID.ValueString())
+	ctx, cancel := timeouts_common.Read(ctx, state.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
+	invite, err := r.apiClient.GetUserInvite(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading user invite", err.Error())
 		return
@@ -253,18 +287,29 @@ func (r *addUsersResource) Read(ctx context.Context, req resource.ReadRequest, r
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
-// Update never performs real work: every configurable argument forces replacement
-// because the invite API has no update operation. It only reconciles computed
-// state to satisfy the resource interface.
+// Update never performs real work: every invite argument forces replacement
+// because the invite API has no update operation. The only in-place change is
+// store_invite_link, whose effect on invite_link is already in the plan.
 func (r *addUsersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(identity_common.SetIDFromState(ctx, resp.Identity, req.State)...)
 	var plan addUsersResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
+// inviteLink returns the value stored for invite_link: the link itself, or
+// null when store_invite_link is off.
+func inviteLink(store types.Bool, link string) types.String {
+	if !store.IsNull() && !store.ValueBool() {
+		return types.StringNull()
+	}
+	return types.StringValue(link)
+}
+
 func (r *addUsersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var state addUsersResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -272,7 +317,30 @@ func (r *addUsersResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
-	if err := r.apiClient.DeleteUserInvite(state.ID.ValueString()); err != nil {
+	ctx, cancel := timeouts_common.Delete(ctx, state.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
+	if err := r.apiClient.DeleteUserInvite(ctx, state.ID.ValueString()); err != nil {
 		resp.Diagnostics.AddError("Error deleting user invite", err.Error())
 	}
 }
+
+// inviteLinkPlanModifier plans invite_link as null when store_invite_link is
+// false, overriding the prior state kept by UseStateForUnknown.
+type inviteLinkPlanModifier struct{}
+
+func (m inviteLinkPlanModifier) Description(_ context.Context) string {
+	return "Plans invite_link as null when store_invite_link is false."
+}
+
+func (m inviteLinkPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m inviteLinkPlanModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	var store types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("store_invite_link"), &store)...)
+	if !store.IsNull() && !store.IsUnknown() && !store.ValueBool() {
+		resp.PlanValue = types.StringNull()
+	}
+}
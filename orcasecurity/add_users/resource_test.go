@@ -125,3 +125,16 @@ func TestModelToRequest_NullEmailProducesSingleEntry(t *testing.T) {
 		t.Errorf("expected single empty-string email, got %v", got.InviteUserEmails)
 	}
 }
+
+// store_invite_link = false keeps the link out of state; null (e.g. right
+// after import, before the default applies) keeps the pre-existing behaviour.
+func TestInviteLink_RespectsStoreInviteLink(t *testing.T) {
+	if got := inviteLink(types.BoolValue(false), "https://app/invite/abc"); !got.IsNull() {
+		t.Errorf("expected null link when not storing, got %v", got)
+	}
+	for _, store := range []types.Bool{types.BoolValue(true), types.BoolNull()} {
+		if got := inviteLink(store, "https://app/invite/abc"); got.ValueString() != "https://app/invite/abc" {
+			t.Errorf("store=%v: expected link, got %v", store, got)
+		}
+	}
+}
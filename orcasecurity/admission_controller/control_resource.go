@@ -4,13 +4,15 @@ import (
 	"context"
 	"fmt"
 	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	"terraform-provider-orcasecurity/orcasecurity/apierror_common"
+	"terraform-provider-orcasecurity/orcasecurity/identity_common"
 	"terraform-provider-orcasecurity/orcasecurity/integrations_common"
+	"terraform-provider-orcasecurity/orcasecurity/timeouts_common"
 
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -24,6 +26,7 @@ var (
 	_ resource.Resource                = &controlResource{}
 	_ resource.ResourceWithConfigure   = &controlResource{}
 	_ resource.ResourceWithImportState = &controlResource{}
+	_ resource.ResourceWithIdentity    = &controlResource{}
 )
 
 const errCreatingControl = "Error creating admission controller control"
@@ -52,6 +55,8 @@ type controlResourceModel struct {
 	TemplateName    types.String              `tfsdk:"template_name"`
 	ClusterScope    *controlClusterScopeModel `tfsdk:"cluster_scope"`
 	InputParameters jsontypes.Normalized      `tfsdk:"input_parameters"`
+
+	Timeouts timeouts_common.Value `tfsdk:"timeouts"`
 }
 
 func NewAdmissionControllerControlResource() resource.Resource {
@@ -70,10 +75,14 @@ func (r *controlResource) Configure(_ context.Context, req resource.ConfigureReq
 }
 
 func (r *controlResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	identity_common.ImportID(ctx, req, resp)
+}
+
+func (r *controlResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity_common.IDSchema()
 }
 
-func (r *controlResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *controlResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Provides an Admission Controller control: one template instantiated with concrete " +
 			"parameters and a Kubernetes resource scope. Attach controls to an " +
@@ -150,6 +159,9 @@ func (r *controlResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 					"Omit for templates without parameters.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts_common.Block(ctx),
+		},
 	}
 }
 
@@ -228,14 +240,17 @@ func (r *controlResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	ctx, cancel := timeouts_common.Create(ctx, plan.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
 	payload := controlPayloadFromPlan(ctx, plan, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	instance, err := r.apiClient.CreateAdmissionControllerControl(payload)
+	instance, err := r.apiClient.CreateAdmissionControllerControl(ctx, payload)
 	if err != nil {
-		resp.Diagnostics.AddError(errCreatingControl, "Could not create control, unexpected error: "+err.Error())
+		apierror_common.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, errCreatingControl, "Could not create control", err)
 		return
 	}
 
@@ -244,16 +259,21 @@ func (r *controlResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(identity_common.SetID(ctx, resp.Identity, plan.ID.ValueString())...)
 }
 
 func (r *controlResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(identity_common.SetIDFromState(ctx, resp.Identity, req.State)...)
 	var state controlResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	instance, err := r.apiClient.GetAdmissionControllerControl(state.ID.ValueString())
+	ctx, cancel := timeouts_common.Read(ctx, state.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
+	instance, err := r.apiClient.GetAdmissionControllerControl(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(errReadingControl,
 			fmt.Sprintf("Could not read control ID %s: %s", state.ID.ValueString(), err.Error()))
@@ -273,20 +293,24 @@ func (r *controlResource) Read(ctx context.Context, req resource.ReadRequest, re
 }
 
 func (r *controlResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(identity_common.SetIDFromState(ctx, resp.Identity, req.State)...)
 	var plan controlResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := timeouts_common.Update(ctx, plan.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
 	payload := controlPayloadFromPlan(ctx, plan, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	instance, err := r.apiClient.UpdateAdmissionControllerControl(payload)
+	instance, err := r.apiClient.UpdateAdmissionControllerControl(ctx, payload)
 	if err != nil {
-		resp.Diagnostics.AddError(errUpdatingControl, "Could not update control, unexpected error: "+err.Error())
+		apierror_common.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, errUpdatingControl, "Could not update control", err)
 		return
 	}
 
@@ -304,7 +328,10 @@ func (r *controlResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
-	if err := r.apiClient.DeleteAdmissionControllerControl(state.ID.ValueString()); err != nil {
+	ctx, cancel := timeouts_common.Delete(ctx, state.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
+	if err := r.apiClient.DeleteAdmissionControllerControl(ctx, state.ID.ValueString()); err != nil {
 		resp.Diagnostics.AddError("Error deleting admission controller control",
 			"Could not delete control, unexpected error: "+err.Error())
 	}
@@ -4,11 +4,13 @@ import (
 	"context"
 	"fmt"
 	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	"terraform-provider-orcasecurity/orcasecurity/apierror_common"
+	"terraform-provider-orcasecurity/orcasecurity/identity_common"
 	"terraform-provider-orcasecurity/orcasecurity/integrations_common"
+	"terraform-provider-orcasecurity/orcasecurity/timeouts_common"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -23,6 +25,7 @@ var (
 	_ resource.Resource                   = &policyAssignmentResource{}
 	_ resource.ResourceWithConfigure      = &policyAssignmentResource{}
 	_ resource.ResourceWithImportState    = &policyAssignmentResource{}
+	_ resource.ResourceWithIdentity       = &policyAssignmentResource{}
 	_ resource.ResourceWithValidateConfig = &policyAssignmentResource{}
 )
 
@@ -42,6 +45,8 @@ type policyAssignmentResourceModel struct {
 	Clusters         types.Set    `tfsdk:"clusters"`
 	CloudAccounts    types.Set    `tfsdk:"cloud_accounts"`
 	PolicyIDs        types.Set    `tfsdk:"policy_ids"`
+
+	Timeouts timeouts_common.Value `tfsdk:"timeouts"`
 }
 
 func NewAdmissionControllerPolicyAssignmentResource() resource.Resource {
@@ -60,7 +65,11 @@ func (r *policyAssignmentResource) Configure(_ context.Context, req resource.Con
 }
 
 func (r *policyAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	identity_common.ImportID(ctx, req, resp)
+}
+
+func (r *policyAssignmentResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity_common.IDSchema()
 }
 
 // ValidateConfig requires a scope target: full_organization, clusters, or
@@ -93,7 +102,7 @@ func (r *policyAssignmentResource) ValidateConfig(ctx context.Context, req resou
 	}
 }
 
-func (r *policyAssignmentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *policyAssignmentResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Provides an Admission Controller policy assignment (the API calls it a \"scope\"): " +
 			"attaches policies to Kubernetes clusters, cloud accounts, or the whole organization.",
@@ -138,6 +147,9 @@ func (r *policyAssignmentResource) Schema(_ context.Context, _ resource.SchemaRe
 				Description: "IDs of `orcasecurity_admission_controller_policy` resources to assign.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts_common.Block(ctx),
+		},
 	}
 }
 
@@ -198,14 +210,17 @@ func (r *policyAssignmentResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	ctx, cancel := timeouts_common.Create(ctx, plan.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
 	payload := policyAssignmentPayloadFromPlan(ctx, plan, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	instance, err := r.apiClient.CreateAdmissionControllerScope(payload)
+	instance, err := r.apiClient.CreateAdmissionControllerScope(ctx, payload)
 	if err != nil {
-		resp.Diagnostics.AddError(errCreatingAssignment, "Could not create policy assignment, unexpected error: "+err.Error())
+		apierror_common.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, errCreatingAssignment, "Could not create policy assignment", err)
 		return
 	}
 
@@ -214,16 +229,21 @@ func (r *policyAssignmentResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(identity_common.SetID(ctx, resp.Identity, plan.ID.ValueString())...)
 }
 
 func (r *policyAssignmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(identity_common.SetIDFromState(ctx, resp.Identity, req.State)...)
 	var state policyAssignmentResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	instance, err := r.apiClient.GetAdmissionControllerScope(state.ID.ValueString())
+	ctx, cancel := timeouts_common.Read(ctx, state.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
+	instance, err := r.apiClient.GetAdmissionControllerScope(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(errReadingAssignment,
 			fmt.Sprintf("Could not read policy assignment ID %s: %s", state.ID.ValueString(), err.Error()))
@@ -243,20 +263,24 @@ func (r *policyAssignmentResource) Read(ctx context.Context, req resource.ReadRe
 }
 
 func (r *policyAssignmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(identity_common.SetIDFromState(ctx, resp.Identity, req.State)...)
 	var plan policyAssignmentResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := timeouts_common.Update(ctx, plan.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
 	payload := policyAssignmentPayloadFromPlan(ctx, plan, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	instance, err := r.apiClient.UpdateAdmissionControllerScope(payload)
+	instance, err := r.apiClient.UpdateAdmissionControllerScope(ctx, payload)
 	if err != nil {
-		resp.Diagnostics.AddError(errUpdatingAssignment, "Could not update policy assignment, unexpected error: "+err.Error())
+		apierror_common.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, errUpdatingAssignment, "Could not update policy assignment", err)
 		return
 	}
 
@@ -274,7 +298,10 @@ func (r *policyAssignmentResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
-	if err := r.apiClient.DeleteAdmissionControllerScope(state.ID.ValueString()); err != nil {
+	ctx, cancel := timeouts_common.Delete(ctx, state.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
+	if err := r.apiClient.DeleteAdmissionControllerScope(ctx, state.ID.ValueString()); err != nil {
 		resp.Diagnostics.AddError("Error deleting admission controller policy assignment",
 			"Could not delete policy assignment, unexpected error: "+err.Error())
 	}
@@ -4,12 +4,14 @@ import (
 	"context"
 	"fmt"
 	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	"terraform-provider-orcasecurity/orcasecurity/apierror_common"
+	"terraform-provider-orcasecurity/orcasecurity/identity_common"
 	"terraform-provider-orcasecurity/orcasecurity/integrations_common"
+	"terraform-provider-orcasecurity/orcasecurity/timeouts_common"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -25,6 +27,7 @@ var (
 	_ resource.Resource                = &policyResource{}
 	_ resource.ResourceWithConfigure   = &policyResource{}
 	_ resource.ResourceWithImportState = &policyResource{}
+	_ resource.ResourceWithIdentity    = &policyResource{}
 )
 
 const errCreatingPolicy = "Error creating admission controller policy"
@@ -42,6 +45,8 @@ type policyResourceModel struct {
 	IsActive          types.Bool   `tfsdk:"is_active"`
 	EnforcementAction types.String `tfsdk:"enforcement_action"`
 	Controls          types.Set    `tfsdk:"controls"`
+
+	Timeouts timeouts_common.Value `tfsdk:"timeouts"`
 }
 
 func NewAdmissionControllerPolicyResource() resource.Resource {
@@ -60,10 +65,14 @@ func (r *policyResource) Configure(_ context.Context, req resource.ConfigureRequ
 }
 
 func (r *policyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	identity_common.ImportID(ctx, req, resp)
+}
+
+func (r *policyResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity_common.IDSchema()
 }
 
-func (r *policyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *policyResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Provides an Admission Controller policy: a named group of controls with an " +
 			"enforcement action. Assign policies to clusters with " +
@@ -117,6 +126,9 @@ func (r *policyResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts_common.Block(ctx),
+		},
 	}
 }
 
@@ -159,14 +171,17 @@ func (r *policyResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	ctx, cancel := timeouts_common.Create(ctx, plan.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
 	payload := policyPayloadFromPlan(ctx, plan, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	instance, err := r.apiClient.CreateAdmissionControllerPolicy(payload)
+	instance, err := r.apiClient.CreateAdmissionControllerPolicy(ctx, payload)
 	if err != nil {
-		resp.Diagnostics.AddError(errCreatingPolicy, "Could not create policy, unexpected error: "+err.Error())
+		apierror_common.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, errCreatingPolicy, "Could not create policy", err)
 		return
 	}
 
@@ -175,16 +190,21 @@ func (r *policyResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(identity_common.SetID(ctx, resp.Identity, plan.ID.ValueString())...)
 }
 
 func (r *policyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(identity_common.SetIDFromState(ctx, resp.Identity, req.State)...)
 	var state policyResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	instance, err := r.apiClient.GetAdmissionControllerPolicy(state.ID.ValueString())
+	ctx, cancel := timeouts_common.Read(ctx, state.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
+	instance, err := r.apiClient.GetAdmissionControllerPolicy(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(errReadingPolicy,
 			fmt.Sprintf("Could not read policy ID %s: %s", state.ID.ValueString(), err.Error()))
@@ -204,20 +224,24 @@ func (r *policyResource) Read(ctx context.Context, req resource.ReadRequest, res
 }
 
 func (r *policyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(identity_common.SetIDFromState(ctx, resp.Identity, req.State)...)
 	var plan policyResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := timeouts_common.Update(ctx, plan.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
 	payload := policyPayloadFromPlan(ctx, plan, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	instance, err := r.apiClient.UpdateAdmissionControllerPolicy(payload)
+	instance, err := r.apiClient.UpdateAdmissionControllerPolicy(ctx, payload)
 	if err != nil {
-		resp.Diagnostics.AddError(errUpdatingPolicy, "Could not update policy, unexpected error: "+err.Error())
+		apierror_common.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, errUpdatingPolicy, "Could not update policy", err)
 		return
 	}
 
@@ -235,7 +259,10 @@ func (r *policyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	if err := r.apiClient.DeleteAdmissionControllerPolicy(state.ID.ValueString()); err != nil {
+	ctx, cancel := timeouts_common.Delete(ctx, state.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
+	if err := r.apiClient.DeleteAdmissionControllerPolicy(ctx, state.ID.ValueString()); err != nil {
 		resp.Diagnostics.AddError("Error deleting admission controller policy",
 			"Could not delete policy, unexpected error: "+err.Error())
 	}
@@ -108,7 +108,7 @@ func (ds *templateDataSource) Read(ctx context.Context, req datasource.ReadReque
 		return
 	}
 
-	templates, err := ds.apiClient.GetAdmissionControllerTemplates()
+	templates, err := ds.apiClient.GetAdmissionControllerTemplates(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading admission controller templates", err.Error())
 		return
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -15,10 +16,16 @@ import (
 
 type state struct {
 	cc.CommonFields
-	AccessToken  types.String `tfsdk:"access_token"`
-	ClientToken  types.String `tfsdk:"client_token"`
-	ClientSecret types.String `tfsdk:"client_secret"`
-	Host         types.String `tfsdk:"host"`
+	AccessToken           types.String `tfsdk:"access_token"`
+	AccessTokenWO         types.String `tfsdk:"access_token_wo"`
+	AccessTokenWOVersion  types.Int64  `tfsdk:"access_token_wo_version"`
+	ClientToken           types.String `tfsdk:"client_token"`
+	ClientTokenWO         types.String `tfsdk:"client_token_wo"`
+	ClientTokenWOVersion  types.Int64  `tfsdk:"client_token_wo_version"`
+	ClientSecret          types.String `tfsdk:"client_secret"`
+	ClientSecretWO        types.String `tfsdk:"client_secret_wo"`
+	ClientSecretWOVersion types.Int64  `tfsdk:"client_secret_wo_version"`
+	Host                  types.String `tfsdk:"host"`
 }
 
 // buildPayload converts the planned state into the Akamai API payload.
@@ -38,7 +45,7 @@ func buildPayload(_ context.Context, st cc.State, _ *diag.Diagnostics) api_clien
 }
 
 // extract maps the API envelope back onto state; an empty host never clobbers the plan.
-func extract(o *api_client.AkamaiExternalServiceConfig, st cc.State, _ *diag.Diagnostics) cc.APIObject {
+func extract(_ context.Context, o *api_client.AkamaiExternalServiceConfig, st cc.State, _ *diag.Diagnostics) cc.APIObject {
 	if o.Config.Host != "" {
 		st.(*state).Host = types.StringValue(o.Config.Host)
 	}
@@ -48,6 +55,7 @@ func extract(o *api_client.AkamaiExternalServiceConfig, st cc.State, _ *diag.Dia
 func NewAkamaiResource() resource.Resource {
 	return cc.New(cc.Spec[api_client.AkamaiExternalServiceConfig]{
 		TypeNameSuffix: "_integration_akamai",
+		ServiceName:    api_client.AkamaiServiceName,
 		UIName:         "Akamai integration",
 		Description:    "Manage an Akamai integration in Orca. Creates an external service config of `service_name = \"akamai\"`. The Akamai credentials (`access_token`, `client_token`, `client_secret`) are stored in Orca's secret store and are never returned by the API.",
 		VariantAttributes: map[string]schema.Attribute{
@@ -75,12 +83,19 @@ func NewAkamaiResource() resource.Resource {
 				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
 			},
 		},
-		NewState:     func() cc.State { return &state{} },
-		BuildPayload: buildPayload,
-		Extract:      extract,
-		Create:       (*api_client.APIClient).CreateAkamaiConfig,
-		Get:          (*api_client.APIClient).GetAkamaiConfig,
-		Update:       (*api_client.APIClient).UpdateAkamaiConfig,
-		Delete:       (*api_client.APIClient).DeleteAkamaiConfig,
+		WriteOnlySecrets: []string{"access_token", "client_token", "client_secret"},
+		NewState:         func() cc.State { return &state{} },
+		BuildPayload:     buildPayload,
+		Extract:          extract,
+		Create:           (*api_client.APIClient).CreateAkamaiConfig,
+		Get:              (*api_client.APIClient).GetAkamaiConfig,
+		Update:           (*api_client.APIClient).UpdateAkamaiConfig,
+		Delete:           (*api_client.APIClient).DeleteAkamaiConfig,
+		List:             (*api_client.APIClient).ListAkamaiConfigs,
 	})
 }
+
+// NewAkamaiListResource lists the integrations managed by NewAkamaiResource.
+func NewAkamaiListResource() list.ListResource {
+	return cc.NewList(NewAkamaiResource)
+}
@@ -1,6 +1,7 @@
 package api_client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -47,8 +48,8 @@ type admissionControllerControlListAPIResponse struct {
 // GetAdmissionControllerControl fetches one control. The API has no
 // GET /controls/{id} route (it answers 405), so this filters the list
 // endpoint by id. Returns nil (no error) when the control does not exist.
-func (client *APIClient) GetAdmissionControllerControl(id string) (*AdmissionControllerControl, error) {
-	resp, err := client.Get(fmt.Sprintf(
+func (client *APIClient) GetAdmissionControllerControl(ctx context.Context, id string) (*AdmissionControllerControl, error) {
+	resp, err := client.GetContext(ctx, fmt.Sprintf(
 		"/api/admission_controller/controls?ids=%s", url.QueryEscape(id),
 	))
 	if err != nil {
@@ -77,10 +78,10 @@ func normalizeAdmissionControllerControl(data *AdmissionControllerControl) {
 	}
 }
 
-func (client *APIClient) CreateAdmissionControllerControl(data AdmissionControllerControl) (*AdmissionControllerControl, error) {
+func (client *APIClient) CreateAdmissionControllerControl(ctx context.Context, data AdmissionControllerControl) (*AdmissionControllerControl, error) {
 	data.ID = ""
 	normalizeAdmissionControllerControl(&data)
-	resp, err := client.Post("/api/admission_controller/controls", data)
+	resp, err := client.PostContext(ctx, "/api/admission_controller/controls", data)
 	if err != nil {
 		return nil, err
 	}
@@ -92,9 +93,9 @@ func (client *APIClient) CreateAdmissionControllerControl(data AdmissionControll
 	return &response.Data, nil
 }
 
-func (client *APIClient) UpdateAdmissionControllerControl(data AdmissionControllerControl) (*AdmissionControllerControl, error) {
+func (client *APIClient) UpdateAdmissionControllerControl(ctx context.Context, data AdmissionControllerControl) (*AdmissionControllerControl, error) {
 	normalizeAdmissionControllerControl(&data)
-	resp, err := client.Put(fmt.Sprintf("/api/admission_controller/controls/%s", data.ID), data)
+	resp, err := client.PutContext(ctx, fmt.Sprintf("/api/admission_controller/controls/%s", data.ID), data)
 	if err != nil {
 		return nil, err
 	}
@@ -106,8 +107,8 @@ func (client *APIClient) UpdateAdmissionControllerControl(data AdmissionControll
 	return &response.Data, nil
 }
 
-func (client *APIClient) DeleteAdmissionControllerControl(id string) error {
-	resp, err := client.Delete(fmt.Sprintf("/api/admission_controller/controls/%s", id))
+func (client *APIClient) DeleteAdmissionControllerControl(ctx context.Context, id string) error {
+	resp, err := client.DeleteContext(ctx, fmt.Sprintf("/api/admission_controller/controls/%s", id))
 	if resp != nil && resp.StatusCode() == 404 {
 		return nil
 	}
@@ -133,8 +134,8 @@ type admissionControllerPolicyAPIResponse struct {
 	Data AdmissionControllerPolicy `json:"data"`
 }
 
-func (client *APIClient) GetAdmissionControllerPolicy(id string) (*AdmissionControllerPolicy, error) {
-	resp, err := client.Get(fmt.Sprintf("/api/admission_controller/policies/%s", id))
+func (client *APIClient) GetAdmissionControllerPolicy(ctx context.Context, id string) (*AdmissionControllerPolicy, error) {
+	resp, err := client.GetContext(ctx, fmt.Sprintf("/api/admission_controller/policies/%s", id))
 	if resp != nil && resp.StatusCode() == 404 {
 		return nil, nil
 	}
@@ -149,9 +150,9 @@ func (client *APIClient) GetAdmissionControllerPolicy(id string) (*AdmissionCont
 	return &response.Data, nil
 }
 
-func (client *APIClient) CreateAdmissionControllerPolicy(data AdmissionControllerPolicy) (*AdmissionControllerPolicy, error) {
+func (client *APIClient) CreateAdmissionControllerPolicy(ctx context.Context, data AdmissionControllerPolicy) (*AdmissionControllerPolicy, error) {
 	data.ID = ""
-	resp, err := client.Post("/api/admission_controller/policies", data)
+	resp, err := client.PostContext(ctx, "/api/admission_controller/policies", data)
 	if err != nil {
 		return nil, err
 	}
@@ -168,8 +169,8 @@ func (client *APIClient) CreateAdmissionControllerPolicy(data AdmissionControlle
 // any update that keeps the name is rejected with "Policy with name 'X'
 // already exists". PATCH skips that broken check (cross-policy uniqueness is
 // still enforced) and, with every field present, behaves as a full replace.
-func (client *APIClient) UpdateAdmissionControllerPolicy(data AdmissionControllerPolicy) (*AdmissionControllerPolicy, error) {
-	resp, err := client.Patch(fmt.Sprintf("/api/admission_controller/policies/%s", data.ID), data)
+func (client *APIClient) UpdateAdmissionControllerPolicy(ctx context.Context, data AdmissionControllerPolicy) (*AdmissionControllerPolicy, error) {
+	resp, err := client.PatchContext(ctx, fmt.Sprintf("/api/admission_controller/policies/%s", data.ID), data)
 	if err != nil {
 		return nil, err
 	}
@@ -181,8 +182,8 @@ func (client *APIClient) UpdateAdmissionControllerPolicy(data AdmissionControlle
 	return &response.Data, nil
 }
 
-func (client *APIClient) DeleteAdmissionControllerPolicy(id string) error {
-	resp, err := client.Delete(fmt.Sprintf("/api/admission_controller/policies/%s", id))
+func (client *APIClient) DeleteAdmissionControllerPolicy(ctx context.Context, id string) error {
+	resp, err := client.DeleteContext(ctx, fmt.Sprintf("/api/admission_controller/policies/%s", id))
 	if resp != nil && resp.StatusCode() == 404 {
 		return nil
 	}
@@ -218,8 +219,8 @@ type admissionControllerScopeAPIResponse struct {
 	Data AdmissionControllerScope `json:"data"`
 }
 
-func (client *APIClient) GetAdmissionControllerScope(id string) (*AdmissionControllerScope, error) {
-	resp, err := client.Get(fmt.Sprintf("/api/admission_controller/scopes/%s", id))
+func (client *APIClient) GetAdmissionControllerScope(ctx context.Context, id string) (*AdmissionControllerScope, error) {
+	resp, err := client.GetContext(ctx, fmt.Sprintf("/api/admission_controller/scopes/%s", id))
 	if resp != nil && resp.StatusCode() == 404 {
 		return nil, nil
 	}
@@ -234,10 +235,10 @@ func (client *APIClient) GetAdmissionControllerScope(id string) (*AdmissionContr
 	return &response.Data, nil
 }
 
-func (client *APIClient) CreateAdmissionControllerScope(data AdmissionControllerScope) (*AdmissionControllerScope, error) {
+func (client *APIClient) CreateAdmissionControllerScope(ctx context.Context, data AdmissionControllerScope) (*AdmissionControllerScope, error) {
 	data.ID = ""
 	data.Policies = nil
-	resp, err := client.Post("/api/admission_controller/scopes", data)
+	resp, err := client.PostContext(ctx, "/api/admission_controller/scopes", data)
 	if err != nil {
 		return nil, err
 	}
@@ -249,9 +250,9 @@ func (client *APIClient) CreateAdmissionControllerScope(data AdmissionController
 	return &response.Data, nil
 }
 
-func (client *APIClient) UpdateAdmissionControllerScope(data AdmissionControllerScope) (*AdmissionControllerScope, error) {
+func (client *APIClient) UpdateAdmissionControllerScope(ctx context.Context, data AdmissionControllerScope) (*AdmissionControllerScope, error) {
 	data.Policies = nil
-	resp, err := client.Put(fmt.Sprintf("/api/admission_controller/scopes/%s", data.ID), data)
+	resp, err := client.PutContext(ctx, fmt.Sprintf("/api/admission_controller/scopes/%s", data.ID), data)
 	if err != nil {
 		return nil, err
 	}
@@ -263,8 +264,8 @@ func (client *APIClient) UpdateAdmissionControllerScope(data AdmissionController
 	return &response.Data, nil
 }
 
-func (client *APIClient) DeleteAdmissionControllerScope(id string) error {
-	resp, err := client.Delete(fmt.Sprintf("/api/admission_controller/scopes/%s", id))
+func (client *APIClient) DeleteAdmissionControllerScope(ctx context.Context, id string) error {
+	resp, err := client.DeleteContext(ctx, fmt.Sprintf("/api/admission_controller/scopes/%s", id))
 	if resp != nil && resp.StatusCode() == 404 {
 		return nil
 	}
@@ -286,8 +287,8 @@ type admissionControllerTemplateListAPIResponse struct {
 	Data []AdmissionControllerTemplate `json:"data"`
 }
 
-func (client *APIClient) GetAdmissionControllerTemplates() ([]AdmissionControllerTemplate, error) {
-	resp, err := client.Get("/api/admission_controller/templates")
+func (client *APIClient) GetAdmissionControllerTemplates(ctx context.Context) ([]AdmissionControllerTemplate, error) {
+	resp, err := client.GetContext(ctx, "/api/admission_controller/templates")
 	if err != nil {
 		return nil, err
 	}
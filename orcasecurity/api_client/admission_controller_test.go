@@ -1,6 +1,7 @@
 package api_client
 
 import (
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -30,7 +31,7 @@ func TestGetAdmissionControllerControl(t *testing.T) {
 	})}
 
 	client := APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	control, err := client.GetAdmissionControllerControl("ctrl-1")
+	control, err := client.GetAdmissionControllerControl(context.Background(), "ctrl-1")
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -57,7 +58,7 @@ func TestGetAdmissionControllerControl_NotFound(t *testing.T) {
 	})}
 
 	client := APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	control, err := client.GetAdmissionControllerControl("missing")
+	control, err := client.GetAdmissionControllerControl(context.Background(), "missing")
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -97,7 +98,7 @@ func TestCreateAdmissionControllerControl(t *testing.T) {
 	})}
 
 	client := APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	control, err := client.CreateAdmissionControllerControl(AdmissionControllerControl{
+	control, err := client.CreateAdmissionControllerControl(context.Background(), AdmissionControllerControl{
 		Name:       "my control",
 		TemplateID: "tpl-1",
 		ClusterScope: AdmissionControllerClusterScope{Kinds: []AdmissionControllerClusterScopeKind{
@@ -131,7 +132,7 @@ func TestUpdateAdmissionControllerControl(t *testing.T) {
 	})}
 
 	client := APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	control, err := client.UpdateAdmissionControllerControl(AdmissionControllerControl{
+	control, err := client.UpdateAdmissionControllerControl(context.Background(), AdmissionControllerControl{
 		ID:         "ctrl-1",
 		Name:       "renamed",
 		TemplateID: "tpl-2",
@@ -160,7 +161,7 @@ func TestDeleteAdmissionControllerControl(t *testing.T) {
 	})}
 
 	client := APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	if err := client.DeleteAdmissionControllerControl("ctrl-1"); err != nil {
+	if err := client.DeleteAdmissionControllerControl(context.Background(), "ctrl-1"); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 }
@@ -180,7 +181,7 @@ func deleteNotFoundResponse(message string) RoundTripFunc {
 func TestDeleteAdmissionControllerControl_NotFound(t *testing.T) {
 	httpClient := &http.Client{Transport: deleteNotFoundResponse("No Control matches the given query.")}
 	client := APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	if err := client.DeleteAdmissionControllerControl("missing"); err != nil {
+	if err := client.DeleteAdmissionControllerControl(context.Background(), "missing"); err != nil {
 		t.Fatalf("expected delete of missing control to be treated as success, got: %v", err)
 	}
 }
@@ -198,7 +199,7 @@ func TestGetAdmissionControllerControl_MultipleMatches(t *testing.T) {
 	})}
 
 	client := APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	control, err := client.GetAdmissionControllerControl("ctrl-1")
+	control, err := client.GetAdmissionControllerControl(context.Background(), "ctrl-1")
 	if err == nil {
 		t.Fatalf("expected error for multiple matches, got control %+v", control)
 	}
@@ -274,7 +275,7 @@ func TestCreateAdmissionControllerControl_EmptyOptionalFields(t *testing.T) {
 	})}
 
 	client := APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	control, err := client.CreateAdmissionControllerControl(AdmissionControllerControl{
+	control, err := client.CreateAdmissionControllerControl(context.Background(), AdmissionControllerControl{
 		Name:       "minimal control",
 		TemplateID: "tpl-1",
 		ClusterScope: AdmissionControllerClusterScope{Kinds: []AdmissionControllerClusterScopeKind{
@@ -307,7 +308,7 @@ func TestUpdateAdmissionControllerControl_EmptyOptionalFields(t *testing.T) {
 	})}
 
 	client := APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	_, err := client.UpdateAdmissionControllerControl(AdmissionControllerControl{
+	_, err := client.UpdateAdmissionControllerControl(context.Background(), AdmissionControllerControl{
 		ID:         "ctrl-1",
 		Name:       "minimal control",
 		TemplateID: "tpl-1",
@@ -335,7 +336,7 @@ func TestGetAdmissionControllerPolicy(t *testing.T) {
 	})}
 
 	client := APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	policy, err := client.GetAdmissionControllerPolicy("pol-1")
+	policy, err := client.GetAdmissionControllerPolicy(context.Background(), "pol-1")
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -356,7 +357,7 @@ func TestGetAdmissionControllerPolicy_NotFound(t *testing.T) {
 	})}
 
 	client := APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	policy, err := client.GetAdmissionControllerPolicy("missing")
+	policy, err := client.GetAdmissionControllerPolicy(context.Background(), "missing")
 	if err != nil {
 		t.Fatalf("expected nil error for 404, got: %v", err)
 	}
@@ -388,7 +389,7 @@ func TestCreateAdmissionControllerPolicy_OmitsScopesKey(t *testing.T) {
 	})}
 
 	client := APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	policy, err := client.CreateAdmissionControllerPolicy(AdmissionControllerPolicy{
+	policy, err := client.CreateAdmissionControllerPolicy(context.Background(), AdmissionControllerPolicy{
 		Name: "my policy", IsActive: true, EnforcementAction: "block", Controls: []string{"ctrl-1"},
 	})
 	if err != nil {
@@ -416,7 +417,7 @@ func TestUpdateAdmissionControllerPolicy(t *testing.T) {
 	})}
 
 	client := APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	policy, err := client.UpdateAdmissionControllerPolicy(AdmissionControllerPolicy{
+	policy, err := client.UpdateAdmissionControllerPolicy(context.Background(), AdmissionControllerPolicy{
 		ID: "pol-1", Name: "renamed", IsActive: false, EnforcementAction: "monitor", Controls: []string{},
 	})
 	if err != nil {
@@ -436,7 +437,7 @@ func TestDeleteAdmissionControllerPolicy(t *testing.T) {
 	})}
 
 	client := APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	if err := client.DeleteAdmissionControllerPolicy("pol-1"); err != nil {
+	if err := client.DeleteAdmissionControllerPolicy(context.Background(), "pol-1"); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 }
@@ -444,7 +445,7 @@ func TestDeleteAdmissionControllerPolicy(t *testing.T) {
 func TestDeleteAdmissionControllerPolicy_NotFound(t *testing.T) {
 	httpClient := &http.Client{Transport: deleteNotFoundResponse("No Policy matches the given query.")}
 	client := APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	if err := client.DeleteAdmissionControllerPolicy("missing"); err != nil {
+	if err := client.DeleteAdmissionControllerPolicy(context.Background(), "missing"); err != nil {
 		t.Fatalf("expected delete of missing policy to be treated as success, got: %v", err)
 	}
 }
@@ -465,7 +466,7 @@ func TestGetAdmissionControllerScope(t *testing.T) {
 	})}
 
 	client := APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	scope, err := client.GetAdmissionControllerScope("scope-1")
+	scope, err := client.GetAdmissionControllerScope(context.Background(), "scope-1")
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -507,7 +508,7 @@ func TestCreateAdmissionControllerScope_SendsPolicyIDs(t *testing.T) {
 	})}
 
 	client := APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	scope, err := client.CreateAdmissionControllerScope(AdmissionControllerScope{
+	scope, err := client.CreateAdmissionControllerScope(context.Background(), AdmissionControllerScope{
 		Name: "my assignment", FullOrganization: true,
 		CloudAccounts: []string{}, Clusters: []string{}, PolicyIDs: []string{"pol-1"},
 	})
@@ -547,7 +548,7 @@ func TestUpdateAdmissionControllerScope(t *testing.T) {
 	})}
 
 	client := APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	scope, err := client.UpdateAdmissionControllerScope(AdmissionControllerScope{
+	scope, err := client.UpdateAdmissionControllerScope(context.Background(), AdmissionControllerScope{
 		ID: "scope-1", Name: "renamed", FullOrganization: true,
 		CloudAccounts: []string{}, Clusters: []string{}, PolicyIDs: []string{},
 	})
@@ -568,7 +569,7 @@ func TestDeleteAdmissionControllerScope(t *testing.T) {
 	})}
 
 	client := APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	if err := client.DeleteAdmissionControllerScope("scope-1"); err != nil {
+	if err := client.DeleteAdmissionControllerScope(context.Background(), "scope-1"); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 }
@@ -576,7 +577,7 @@ func TestDeleteAdmissionControllerScope(t *testing.T) {
 func TestDeleteAdmissionControllerScope_NotFound(t *testing.T) {
 	httpClient := &http.Client{Transport: deleteNotFoundResponse("No Scope matches the given query.")}
 	client := APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	if err := client.DeleteAdmissionControllerScope("missing"); err != nil {
+	if err := client.DeleteAdmissionControllerScope(context.Background(), "missing"); err != nil {
 		t.Fatalf("expected delete of missing scope to be treated as success, got: %v", err)
 	}
 }
@@ -596,7 +597,7 @@ func TestGetAdmissionControllerTemplates(t *testing.T) {
 	})}
 
 	client := APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	templates, err := client.GetAdmissionControllerTemplates()
+	templates, err := client.GetAdmissionControllerTemplates(context.Background())
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
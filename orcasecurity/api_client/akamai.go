@@ -1,5 +1,7 @@
 package api_client
 
+import "context"
+
 const AkamaiServiceName = "akamai"
 
 type AkamaiConfig struct {
@@ -11,15 +13,19 @@ type AkamaiConfig struct {
 
 type AkamaiExternalServiceConfig = ConfigEnvelope[AkamaiConfig]
 
-func (client *APIClient) CreateAkamaiConfig(payload AkamaiExternalServiceConfig) (*AkamaiExternalServiceConfig, error) {
-	return CreateExternalServiceConfig[AkamaiConfig](client, AkamaiServiceName, payload)
+func (client *APIClient) CreateAkamaiConfig(ctx context.Context, payload AkamaiExternalServiceConfig) (*AkamaiExternalServiceConfig, error) {
+	return CreateExternalServiceConfig[AkamaiConfig](ctx, client, AkamaiServiceName, payload)
+}
+
+func (client *APIClient) GetAkamaiConfig(ctx context.Context, templateName string) (*AkamaiExternalServiceConfig, error) {
+	return GetExternalServiceConfig[AkamaiConfig](ctx, client, AkamaiServiceName, templateName, nil)
 }
 
-func (client *APIClient) GetAkamaiConfig(templateName string) (*AkamaiExternalServiceConfig, error) {
-	return GetExternalServiceConfig[AkamaiConfig](client, AkamaiServiceName, templateName, nil)
+func (client *APIClient) ListAkamaiConfigs(ctx context.Context) ([]AkamaiExternalServiceConfig, error) {
+	return ListExternalServiceConfigs[AkamaiConfig](ctx, client, AkamaiServiceName, nil)
 }
 
-func (client *APIClient) UpdateAkamaiConfig(templateName string, payload AkamaiExternalServiceConfig) (*AkamaiExternalServiceConfig, error) {
+func (client *APIClient) UpdateAkamaiConfig(ctx context.Context, templateName string, payload AkamaiExternalServiceConfig) (*AkamaiExternalServiceConfig, error) {
 	// PUT body is partial. Omit empty secret fields so the API keeps the SSM-resident value
 	// when the user did not change them.
 	cfg := map[string]interface{}{}
@@ -35,9 +41,9 @@ func (client *APIClient) UpdateAkamaiConfig(templateName string, payload AkamaiE
 	if payload.Config.Host != "" {
 		cfg["host"] = payload.Config.Host
 	}
-	return UpdateExternalServiceConfig[AkamaiConfig](client, AkamaiServiceName, templateName, BuildUpdateBody(payload, cfg, false))
+	return UpdateExternalServiceConfig[AkamaiConfig](ctx, client, AkamaiServiceName, templateName, BuildUpdateBody(payload, cfg, false))
 }
 
-func (client *APIClient) DeleteAkamaiConfig(templateName string) error {
-	return DeleteExternalServiceConfig(client, AkamaiServiceName, templateName)
+func (client *APIClient) DeleteAkamaiConfig(ctx context.Context, templateName string) error {
+	return DeleteExternalServiceConfig(ctx, client, AkamaiServiceName, templateName)
 }
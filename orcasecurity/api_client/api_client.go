@@ -1,8 +1,8 @@
 package api_client
 
 import (
+	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"log"
 	"net/http"
@@ -25,17 +25,86 @@ func (c *APIClient) debugf(format string, a ...any) {
 	log.Printf(format, a...)
 }
 
+// defaultRequestTimeout bounds a single HTTP attempt.
+const defaultRequestTimeout = 10 * time.Second
+
 type APIClient struct {
 	APIEndpoint string
 	APIToken    string
 	HTTPClient  *http.Client
+
+	// Credentials, when set, supplies the Authorization header instead of
+	// APIToken: a token file, credential helper or OAuth exchange.
+	Credentials CredentialSource
+
+	// Organization is the identity the provider validated the credentials
+	// against during Configure. Nil when validation was skipped.
+	Organization *Organization
+
+	// RequestTimeout bounds each HTTP attempt. A context deadline (a resource
+	// `timeouts` block or DefaultTimeout) bounds the operation as a whole, so
+	// an attempt gets whichever of the two ends first. Zero disables it.
+	RequestTimeout time.Duration
+
+	// DefaultTimeout is the operation deadline resources apply when their
+	// `timeouts` block leaves the operation unset. Zero means no deadline.
+	DefaultTimeout time.Duration
+
+	// ReadOnly refuses every request other than GET and HEAD with
+	// ErrReadOnly, so nothing in Orca can be changed through this client.
+	ReadOnly bool
+
+	// SkipSonarQueryValidation turns off the plan-time check of Sonar queries
+	// against the backend parser, e.g. for offline plans.
+	SkipSonarQueryValidation bool
+
+	// MaxRetryAttempts caps the attempts per request, first try included.
+	// Zero uses maxHTTPRetryAttempts.
+	MaxRetryAttempts int
+
+	// MaxRetryBackoff caps a single exponential backoff sleep. Zero uses
+	// retryMaxDelay. Server-sent Retry-After values are capped separately.
+	MaxRetryBackoff time.Duration
+
+	// limiter paces requests across every goroutine sharing this client. Nil
+	// (a client built as a struct literal) applies no limits.
+	limiter *requestLimiter
+
+	// cache serves repeated collection GETs within one run. Nil unless
+	// TransportConfig.CacheResponses is set.
+	cache *responseCache
+
+	// accessSnapshots serves RBAC access Reads from one listing per
+	// collection. Nil (a struct-literal client) lists on every Read.
+	accessSnapshots *rbacAccessSnapshots
 }
 
 func NewAPIClient(endpoint, token *string) (*APIClient, error) {
+	return NewAPIClientWithTransport(endpoint, token, TransportConfig{})
+}
+
+// NewAPIClientWithTransport builds a client whose http.Client and retry budget
+// follow cfg. Zero-valued fields keep the NewAPIClient defaults.
+func NewAPIClientWithTransport(endpoint, token *string, cfg TransportConfig) (*APIClient, error) {
+	httpClient, err := newHTTPClient(cfg)
+	if err != nil {
+		return nil, err
+	}
 	apiclient := APIClient{
-		APIEndpoint: *endpoint,
-		APIToken:    *token,
-		HTTPClient:  &http.Client{Timeout: 10 * time.Second},
+		APIEndpoint:      *endpoint,
+		APIToken:         *token,
+		HTTPClient:       httpClient,
+		RequestTimeout:   defaultRequestTimeout,
+		MaxRetryAttempts: cfg.MaxRetryAttempts,
+		MaxRetryBackoff:  cfg.MaxRetryBackoff,
+		limiter:          newRequestLimiter(cfg.MaxRequestsPerSecond, cfg.MaxConcurrentRequests),
+		accessSnapshots:  newRBACAccessSnapshots(),
+	}
+	if cfg.RequestTimeout > 0 {
+		apiclient.RequestTimeout = cfg.RequestTimeout
+	}
+	if cfg.CacheResponses {
+		apiclient.cache = newResponseCache()
 	}
 	return &apiclient, nil
 }
@@ -75,31 +144,25 @@ func (resp *APIResponse) ReadJSON(typ interface{}) error {
 	return json.Unmarshal(resp.Body(), typ)
 }
 
-// Return API error message.
-// Returns nil if request was successful.
+// Error returns the *APIError describing an unsuccessful response, or nil if
+// the request was successful.
 func (resp *APIResponse) Error() error {
-	type errorType struct {
-		Message string `json:"message,omitempty"`
-		Error   string `json:"error,omitempty"`
-	}
-	if !resp.IsOk() {
-		errorMessage := errorType{}
-		err := json.Unmarshal(resp.Body(), &errorMessage)
-		if err != nil {
-			return fmt.Errorf("status: %d, %s", resp.StatusCode(), resp.Body())
-		}
-		message := errorMessage.Error
-		if errorMessage.Message != "" {
-			message = errorMessage.Message
-		}
-		return errors.New(message)
+	if resp.IsOk() {
+		return nil
 	}
-	return nil
+	return newAPIError(resp.response, resp.Body())
 }
 
 // Perform API call.
 func (c *APIClient) Execute(req http.Request) (*http.Response, error) {
-	req.Header.Set("authorization", fmt.Sprintf("Token %s", c.APIToken))
+	authorization := fmt.Sprintf("Token %s", c.APIToken)
+	if c.Credentials != nil {
+		var err error
+		if authorization, err = c.Credentials.Authorization(req.Context()); err != nil {
+			return nil, fmt.Errorf("obtain API credentials: %w", err)
+		}
+	}
+	req.Header.Set("authorization", authorization)
 	req.Header.Set("content-type", "application/json")
 	req.Header.Set("user-agent", "orca-terraform-provider (+https://registry.terraform.io/providers/orcasecurity)")
 	return c.HTTPClient.Do(&req)
@@ -110,41 +173,56 @@ func (c *APIClient) doRequest(req http.Request) (*APIResponse, error) {
 
 	resp, err := c.roundTripWithRetry(req)
 	if err != nil {
-		return nil, fmt.Errorf("request execution failed: %v", err)
+		return nil, fmt.Errorf("request execution failed: %w", err)
 	}
 
 	c.debugf("Response Status: %d", resp.StatusCode())
 	c.debugf("Response Body: %s", string(resp.Body()))
 
-	if !resp.IsOk() {
-		apiErr := resp.Error()
-		return resp, fmt.Errorf("API returned error - status: %d, body: %s, error: %v",
-			resp.StatusCode(), string(resp.Body()), apiErr)
+	if err := resp.Error(); err != nil {
+		return resp, err
 	}
 
 	return resp, nil
 }
 
-// Execute GET HTTP request.
+// Get executes a GET HTTP request with a background context. Prefer GetContext
+// from resource code so Terraform cancellation reaches the request.
 func (c *APIClient) Get(path string) (*APIResponse, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s%s", c.APIEndpoint, path), nil)
+	return c.GetContext(context.Background(), path)
+}
+
+// GetContext executes a GET HTTP request bound to ctx. Cancelling ctx aborts
+// the in-flight request and any pending retry backoff.
+func (c *APIClient) GetContext(ctx context.Context, path string) (*APIResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s%s", c.APIEndpoint, path), nil)
 	if err != nil {
 		return nil, err
 	}
 	return c.doRequest(*req)
 }
 
-// Execute HEAD HTTP request
+// Head executes a HEAD HTTP request with a background context.
 func (c *APIClient) Head(path string) (*APIResponse, error) {
-	req, err := http.NewRequest("HEAD", fmt.Sprintf("%s%s", c.APIEndpoint, path), nil)
+	return c.HeadContext(context.Background(), path)
+}
+
+// HeadContext executes a HEAD HTTP request bound to ctx.
+func (c *APIClient) HeadContext(ctx context.Context, path string) (*APIResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, "HEAD", fmt.Sprintf("%s%s", c.APIEndpoint, path), nil)
 	if err != nil {
 		return nil, err
 	}
 	return c.doRequest(*req)
 }
 
-// Execute POST HTTP request.
+// Post executes a POST HTTP request with a background context.
 func (c *APIClient) Post(path string, data interface{}) (*APIResponse, error) {
+	return c.PostContext(context.Background(), path, data)
+}
+
+// PostContext executes a POST HTTP request bound to ctx.
+func (c *APIClient) PostContext(ctx context.Context, path string, data interface{}) (*APIResponse, error) {
 	payload, err := json.Marshal(&data)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal payload: %v", err)
@@ -153,7 +231,8 @@ func (c *APIClient) Post(path string, data interface{}) (*APIResponse, error) {
 	fullURL := fmt.Sprintf("%s%s", c.APIEndpoint, path)
 	c.debugf("Making POST request to: %s", fullURL)
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
 		fullURL,
 		strings.NewReader(string(payload)),
@@ -165,61 +244,50 @@ func (c *APIClient) Post(path string, data interface{}) (*APIResponse, error) {
 	c.debugf("Request payload: %s", string(payload))
 
 	response, err := c.doRequest(*req)
+	c.invalidateCache(path)
 	if err != nil {
 		// Do not append the request payload — it carries integration secrets and
 		// this error surfaces to the user via diagnostics. doRequest already wraps
 		// the server's response body for context.
-		return nil, fmt.Errorf("request failed: %v, URL: %s", err, fullURL)
+		return nil, fmt.Errorf("request failed: %w, URL: %s", err, fullURL)
 	}
 
 	return response, nil
 }
 
-// Execute PUT HTTP request.
+// Put executes a PUT HTTP request with a background context.
 func (c *APIClient) Put(path string, data interface{}) (*APIResponse, error) {
-	payload, err := json.Marshal(&data)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest(
-		"PUT",
-		fmt.Sprintf("%s%s", c.APIEndpoint, path),
-		strings.NewReader(string(payload)),
-	)
-	if err != nil {
-		return nil, err
-	}
+	return c.PutContext(context.Background(), path, data)
+}
 
-	return c.doRequest(*req)
+// PutContext executes a PUT HTTP request bound to ctx.
+func (c *APIClient) PutContext(ctx context.Context, path string, data interface{}) (*APIResponse, error) {
+	return c.doJSONRequest(ctx, "PUT", path, data)
 }
 
-// Execute PATCH HTTP request.
+// Patch executes a PATCH HTTP request with a background context.
 func (c *APIClient) Patch(path string, data interface{}) (*APIResponse, error) {
-	payload, err := json.Marshal(&data)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest(
-		"PATCH",
-		fmt.Sprintf("%s%s", c.APIEndpoint, path),
-		strings.NewReader(string(payload)),
-	)
-	if err != nil {
-		return nil, err
-	}
+	return c.PatchContext(context.Background(), path, data)
+}
 
-	return c.doRequest(*req)
+// PatchContext executes a PATCH HTTP request bound to ctx.
+func (c *APIClient) PatchContext(ctx context.Context, path string, data interface{}) (*APIResponse, error) {
+	return c.doJSONRequest(ctx, "PATCH", path, data)
 }
 
-// Execute DELETE HTTP request.
+// Delete executes a DELETE HTTP request with a background context.
 func (c *APIClient) Delete(path string) (*APIResponse, error) {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s%s", c.APIEndpoint, path), nil)
+	return c.DeleteContext(context.Background(), path)
+}
+
+// DeleteContext executes a DELETE HTTP request bound to ctx.
+func (c *APIClient) DeleteContext(ctx context.Context, path string) (*APIResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s%s", c.APIEndpoint, path), nil)
 	if err != nil {
 		return nil, err
 	}
 
+	defer c.invalidateCache(path)
 	return c.doRequest(*req)
 }
 
@@ -227,13 +295,25 @@ func (c *APIClient) Delete(path string) (*APIResponse, error) {
 // RBAC endpoints (e.g. /api/rbac/access/user) identify the record by an id in
 // the request body rather than in the URL path.
 func (c *APIClient) DeleteWithBody(path string, data interface{}) (*APIResponse, error) {
+	return c.DeleteWithBodyContext(context.Background(), path, data)
+}
+
+// DeleteWithBodyContext is DeleteWithBody bound to ctx.
+func (c *APIClient) DeleteWithBodyContext(ctx context.Context, path string, data interface{}) (*APIResponse, error) {
+	return c.doJSONRequest(ctx, "DELETE", path, data)
+}
+
+// doJSONRequest marshals data as the request body and executes method against
+// path, bound to ctx.
+func (c *APIClient) doJSONRequest(ctx context.Context, method, path string, data interface{}) (*APIResponse, error) {
 	payload, err := json.Marshal(&data)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
-		"DELETE",
+	req, err := http.NewRequestWithContext(
+		ctx,
+		method,
 		fmt.Sprintf("%s%s", c.APIEndpoint, path),
 		strings.NewReader(string(payload)),
 	)
@@ -241,5 +321,6 @@ func (c *APIClient) DeleteWithBody(path string, data interface{}) (*APIResponse,
 		return nil, err
 	}
 
+	defer c.invalidateCache(path)
 	return c.doRequest(*req)
 }
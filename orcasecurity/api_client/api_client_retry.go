@@ -53,11 +53,15 @@ func readResponseBody(res *http.Response) ([]byte, error) {
 // sleepIfRetriableTransportError backs off when err is retriable and attempts remain.
 // Returns retry=true to run another attempt; retry=false with nil err to surface errOut;
 // or retry=false with non-nil err on context cancellation during sleep.
-func sleepIfRetriableTransportError(ctx context.Context, attempt int, errOut error) (retry bool, err error) {
-	if attempt >= maxHTTPRetryAttempts-1 || !isRetriableRoundTripError(errOut) {
+func (c *APIClient) sleepIfRetriableTransportError(ctx context.Context, attempt int, errOut error) (retry bool, err error) {
+	if attempt >= c.retryAttempts()-1 || !isRetriableRoundTripError(errOut) {
 		return false, nil
 	}
-	if err := sleepCtx(ctx, retryDelay(attempt, nil)); err != nil {
+	delay := c.retryDelay(attempt, nil)
+	if !backoffFitsDeadline(ctx, delay) {
+		return false, nil
+	}
+	if err := sleepCtx(ctx, delay); err != nil {
 		return false, err
 	}
 	return true, nil
@@ -66,15 +70,28 @@ func sleepIfRetriableTransportError(ctx context.Context, attempt int, errOut err
 // httpResponseFinalOrBackoff returns the API response when the caller should stop (success,
 // non-retriable HTTP status, or last attempt). If retry is true, backoff was applied and
 // another attempt should run.
-func httpResponseFinalOrBackoff(ctx context.Context, attempt int, body []byte, res *http.Response) (apiResp *APIResponse, retry bool, err error) {
+func (c *APIClient) httpResponseFinalOrBackoff(ctx context.Context, attempt int, body []byte, res *http.Response) (apiResp *APIResponse, retry bool, err error) {
 	apiResp = &APIResponse{_body: body, response: res}
 	if apiResp.IsOk() {
 		return apiResp, false, nil
 	}
-	if !isRetriableHTTPStatus(res.StatusCode) || attempt == maxHTTPRetryAttempts-1 {
+	if d, ok := retryAfter(res); ok {
+		c.limiter.pause(d)
+	}
+	// A rejected short-lived credential may have been revoked or rotated
+	// early; fetch a new one and retry once, without backoff.
+	if res.StatusCode == http.StatusUnauthorized && attempt == 0 && attempt < c.retryAttempts()-1 &&
+		c.Credentials != nil && c.Credentials.Invalidate() {
+		return nil, true, nil
+	}
+	if !isRetriableHTTPStatus(res.StatusCode) || attempt == c.retryAttempts()-1 {
+		return apiResp, false, nil
+	}
+	delay := c.retryDelay(attempt, res)
+	if !backoffFitsDeadline(ctx, delay) {
 		return apiResp, false, nil
 	}
-	if err := sleepCtx(ctx, retryDelay(attempt, res)); err != nil {
+	if err := sleepCtx(ctx, delay); err != nil {
 		return nil, false, err
 	}
 	return nil, true, nil
@@ -82,8 +99,8 @@ func httpResponseFinalOrBackoff(ctx context.Context, attempt int, body []byte, r
 
 // transportPhaseOutcome maps Execute / body-read errors to either another attempt
 // (continueLoop) or a terminal error for the caller to return.
-func transportPhaseOutcome(ctx context.Context, attempt int, phaseErr error) (continueLoop bool, err error) {
-	retry, sleepErr := sleepIfRetriableTransportError(ctx, attempt, phaseErr)
+func (c *APIClient) transportPhaseOutcome(ctx context.Context, attempt int, phaseErr error) (continueLoop bool, err error) {
+	retry, sleepErr := c.sleepIfRetriableTransportError(ctx, attempt, phaseErr)
 	if sleepErr != nil {
 		return false, sleepErr
 	}
@@ -94,8 +111,8 @@ func transportPhaseOutcome(ctx context.Context, attempt int, phaseErr error) (co
 }
 
 // httpResponsePhaseOutcome maps HTTP status handling to continue, terminal error, or success.
-func httpResponsePhaseOutcome(ctx context.Context, attempt int, body []byte, res *http.Response) (apiResp *APIResponse, continueLoop bool, err error) {
-	apiResp, retry, sleepErr := httpResponseFinalOrBackoff(ctx, attempt, body, res)
+func (c *APIClient) httpResponsePhaseOutcome(ctx context.Context, attempt int, body []byte, res *http.Response) (apiResp *APIResponse, continueLoop bool, err error) {
+	apiResp, retry, sleepErr := c.httpResponseFinalOrBackoff(ctx, attempt, body, res)
 	if sleepErr != nil {
 		return nil, false, sleepErr
 	}
@@ -134,29 +151,70 @@ func isRetriableRoundTripError(err error) bool {
 	return false
 }
 
-func retryDelay(attempt int, resp *http.Response) time.Duration {
-	if resp != nil && resp.StatusCode == http.StatusTooManyRequests {
-		if ra := resp.Header.Get("Retry-After"); ra != "" {
-			if s, err := strconv.ParseInt(ra, 10, 64); err == nil && s > 0 {
-				d := time.Duration(s) * time.Second
-				if d > retryAfterCap {
-					d = retryAfterCap
-				}
-				return d
-			}
-		}
+// retryAttempts is the per-request attempt budget, first try included.
+func (c *APIClient) retryAttempts() int {
+	if c.MaxRetryAttempts > 0 {
+		return c.MaxRetryAttempts
+	}
+	return maxHTTPRetryAttempts
+}
+
+// retryAfter returns the server-requested wait on a 429 or 503, capped at retryAfterCap.
+// Retry-After is either delay-seconds or an HTTP-date; a date already past yields no wait.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp == nil || (resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode != http.StatusServiceUnavailable) {
+		return 0, false
+	}
+	v := resp.Header.Get("Retry-After")
+	var d time.Duration
+	if s, err := strconv.ParseInt(v, 10, 64); err == nil {
+		d = time.Duration(s) * time.Second
+	} else if at, err := http.ParseTime(v); err == nil {
+		d = time.Until(at)
+	}
+	if d <= 0 {
+		return 0, false
+	}
+	return min(d, retryAfterCap), true
+}
+
+func (c *APIClient) retryDelay(attempt int, resp *http.Response) time.Duration {
+	if d, ok := retryAfter(resp); ok {
+		return d
 	}
 	shift := attempt
 	if shift > 6 {
 		shift = 6
 	}
+	maxDelay := retryMaxDelay
+	if c.MaxRetryBackoff > 0 {
+		maxDelay = c.MaxRetryBackoff
+	}
 	d := retryBaseDelay * time.Duration(1<<uint(shift))
-	if d > retryMaxDelay {
-		return retryMaxDelay
+	if d > maxDelay {
+		return maxDelay
 	}
 	return d
 }
 
+// backoffFitsDeadline reports whether sleeping d still leaves time before the
+// context deadline. When it does not, the caller surfaces the last response or
+// error instead of sleeping into a bare "context deadline exceeded".
+func backoffFitsDeadline(ctx context.Context, d time.Duration) bool {
+	deadline, ok := ctx.Deadline()
+	return !ok || time.Until(deadline) > d
+}
+
+// attemptContext derives the context for a single HTTP attempt, bounded by
+// the earlier of RequestTimeout and the caller's deadline. A hung attempt then
+// times out and is retried instead of consuming the whole operation budget.
+func (c *APIClient) attemptContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if c.RequestTimeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, c.RequestTimeout)
+}
+
 func sleepCtx(ctx context.Context, d time.Duration) error {
 	if d <= 0 {
 		return nil
@@ -171,30 +229,43 @@ func sleepCtx(ctx context.Context, d time.Duration) error {
 	}
 }
 
+// exchange performs one HTTP attempt and reads the full body. The attempt holds
+// a limiter slot only while on the wire, so callers sleeping in backoff do not
+// count against max_concurrent_requests.
+func (c *APIClient) exchange(ctx context.Context, proto *http.Request, reqBody []byte) (*http.Response, []byte, error) {
+	release, err := c.limiter.acquire(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer release()
+	attemptCtx, cancel := c.attemptContext(ctx)
+	defer cancel()
+
+	res, err := c.Execute(cloneRequestWithBody(attemptCtx, proto, reqBody))
+	if err != nil {
+		return nil, nil, err
+	}
+	body, err := readResponseBody(res)
+	if err != nil {
+		return nil, nil, err
+	}
+	return res, body, nil
+}
+
 // roundTripIteration runs a single HTTP attempt (execute, read body, apply HTTP retry policy).
 // If tryAgain is true, the caller should run another attempt. If tryAgain is false and err is nil,
 // resp is the final APIResponse. If err is non-nil, the caller must return that error.
 func (c *APIClient) roundTripIteration(ctx context.Context, attempt int, proto *http.Request, reqBody []byte) (resp *APIResponse, tryAgain bool, err error) {
-	r := cloneRequestWithBody(ctx, proto, reqBody)
-	res, execErr := c.Execute(r)
-	if execErr != nil {
-		cont, out := transportPhaseOutcome(ctx, attempt, execErr)
-		if cont {
-			return nil, true, nil
-		}
-		return nil, false, out
-	}
-
-	body, readErr := readResponseBody(res)
-	if readErr != nil {
-		cont, out := transportPhaseOutcome(ctx, attempt, readErr)
+	res, body, exchangeErr := c.exchange(ctx, proto, reqBody)
+	if exchangeErr != nil {
+		cont, out := c.transportPhaseOutcome(ctx, attempt, exchangeErr)
 		if cont {
 			return nil, true, nil
 		}
 		return nil, false, out
 	}
 
-	apiResp, cont, out := httpResponsePhaseOutcome(ctx, attempt, body, res)
+	apiResp, cont, out := c.httpResponsePhaseOutcome(ctx, attempt, body, res)
 	if out != nil {
 		return nil, false, out
 	}
@@ -207,16 +278,19 @@ func (c *APIClient) roundTripIteration(ctx context.Context, attempt int, proto *
 // roundTripWithRetry performs the HTTP round trip with retries for transient
 // transport failures and selected HTTP status codes (408, 429, 502, 503, 504).
 // On success (any HTTP status), returns a fully read APIResponse; err is only
-// for request body read failures, transport failures after retries, or context
-// cancellation during backoff.
+// for requests refused in read-only mode, request body read failures, transport
+// failures after retries, or context cancellation during backoff.
 func (c *APIClient) roundTripWithRetry(req http.Request) (*APIResponse, error) {
+	if err := c.checkReadOnly(&req); err != nil {
+		return nil, err
+	}
 	ctx := req.Context()
 	reqBody, err := slurpRequestBody(&req)
 	if err != nil {
 		return nil, err
 	}
 
-	for attempt := 0; attempt < maxHTTPRetryAttempts; attempt++ {
+	for attempt := 0; attempt < c.retryAttempts(); attempt++ {
 		resp, tryAgain, iterErr := c.roundTripIteration(ctx, attempt, &req, reqBody)
 		if iterErr != nil {
 			return nil, iterErr
@@ -1,10 +1,13 @@
 package api_client
 
 import (
+	"context"
+	"errors"
 	"io"
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 )
 
 const (
@@ -33,6 +36,40 @@ func TestIsRetriableHTTPStatus(t *testing.T) {
 	}
 }
 
+func TestRetryAfter(t *testing.T) {
+	future := time.Now().Add(45 * time.Second).UTC().Format(http.TimeFormat)
+	past := time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat)
+	tests := []struct {
+		name    string
+		code    int
+		header  string
+		wantMin time.Duration
+		wantMax time.Duration
+		wantOK  bool
+	}{
+		{"429 seconds", http.StatusTooManyRequests, "30", 30 * time.Second, 30 * time.Second, true},
+		{"503 seconds", http.StatusServiceUnavailable, "7", 7 * time.Second, 7 * time.Second, true},
+		{"503 http-date", http.StatusServiceUnavailable, future, 40 * time.Second, 45 * time.Second, true},
+		{"429 http-date in the past", http.StatusTooManyRequests, past, 0, 0, false},
+		{"capped", http.StatusTooManyRequests, "3600", retryAfterCap, retryAfterCap, true},
+		{"garbage", http.StatusTooManyRequests, "soon", 0, 0, false},
+		{"missing", http.StatusServiceUnavailable, "", 0, 0, false},
+		{"502 ignored", http.StatusBadGateway, "30", 0, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := make(http.Header)
+			if tt.header != "" {
+				h.Set("Retry-After", tt.header)
+			}
+			got, ok := retryAfter(&http.Response{StatusCode: tt.code, Header: h})
+			if ok != tt.wantOK || got < tt.wantMin || got > tt.wantMax {
+				t.Fatalf("retryAfter = %s, %v; want [%s, %s], %v", got, ok, tt.wantMin, tt.wantMax, tt.wantOK)
+			}
+		})
+	}
+}
+
 func TestRoundTripWithRetry_502Then200(t *testing.T) {
 	var n int
 	httpClient := &http.Client{Transport: RoundTripFunc(func(req *http.Request) *http.Response {
@@ -139,3 +176,135 @@ func TestRoundTripWithRetry_POSTBodyPreservedAcrossRetries(t *testing.T) {
 		t.Fatalf(errFmtRetryTestStatus, resp.StatusCode())
 	}
 }
+
+// A cancelled context must interrupt the backoff sleep instead of running the
+// remaining attempts against a server that keeps answering 503.
+func TestGetContext_CancelStopsRetryBackoff(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var n int
+	httpClient := &http.Client{Transport: RoundTripFunc(func(req *http.Request) *http.Response {
+		n++
+		cancel()
+		return &http.Response{
+			StatusCode: http.StatusServiceUnavailable,
+			Body:       io.NopCloser(strings.NewReader(`busy`)),
+			Header:     make(http.Header),
+			Request:    req,
+		}
+	})}
+
+	c := &APIClient{APIEndpoint: retryTestAPIEndpoint, APIToken: "secret", HTTPClient: httpClient}
+	start := time.Now()
+	_, err := c.GetContext(ctx, "/api/x")
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("err = %v, want context.Canceled", err)
+	}
+	if n != 1 {
+		t.Fatalf("expected 1 attempt before cancellation, got %d", n)
+	}
+	if elapsed := time.Since(start); elapsed > retryBaseDelay {
+		t.Fatalf("cancellation took %s, want under the first backoff (%s)", elapsed, retryBaseDelay)
+	}
+}
+
+// The request handed to the transport must carry the caller's context so an
+// in-flight request is aborted on cancellation, not just the backoff.
+func TestPostContext_PropagatesContextToTransport(t *testing.T) {
+	type ctxKey struct{}
+	ctx := context.WithValue(context.Background(), ctxKey{}, "marker")
+	httpClient := &http.Client{Transport: RoundTripFunc(func(req *http.Request) *http.Response {
+		if req.Context().Value(ctxKey{}) != "marker" {
+			t.Error("request context does not derive from the caller's context")
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(`{}`)),
+			Header:     make(http.Header),
+			Request:    req,
+		}
+	})}
+
+	c := &APIClient{APIEndpoint: retryTestAPIEndpoint, APIToken: "secret", HTTPClient: httpClient}
+	if _, err := c.PostContext(ctx, "/api/x", map[string]string{"a": "b"}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGetContext_DeadlineShorterThanBackoffReturnsLastResponse(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), retryBaseDelay/2)
+	defer cancel()
+	var n int
+	httpClient := &http.Client{Transport: RoundTripFunc(func(req *http.Request) *http.Response {
+		n++
+		return &http.Response{
+			StatusCode: http.StatusServiceUnavailable,
+			Body:       io.NopCloser(strings.NewReader(`busy`)),
+			Header:     make(http.Header),
+			Request:    req,
+		}
+	})}
+
+	c := &APIClient{APIEndpoint: retryTestAPIEndpoint, APIToken: "secret", HTTPClient: httpClient}
+	resp, err := c.GetContext(ctx, "/api/x")
+	if errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("err = %v, want the 503 surfaced instead of a bare deadline error", err)
+	}
+	if n != 1 {
+		t.Fatalf("expected 1 attempt, got %d", n)
+	}
+	if resp == nil || resp.StatusCode() != http.StatusServiceUnavailable {
+		t.Fatalf("resp = %v, want the 503 response", resp)
+	}
+}
+
+func TestRoundTripWithRetry_HonorsMaxRetryAttempts(t *testing.T) {
+	var n int
+	httpClient := &http.Client{Transport: RoundTripFunc(func(req *http.Request) *http.Response {
+		n++
+		return &http.Response{
+			StatusCode: http.StatusBadGateway,
+			Body:       io.NopCloser(strings.NewReader(`bad`)),
+			Header:     make(http.Header),
+			Request:    req,
+		}
+	})}
+
+	c := &APIClient{APIEndpoint: retryTestAPIEndpoint, APIToken: "secret", HTTPClient: httpClient, MaxRetryAttempts: 2}
+	resp, _ := c.Get("/api/x")
+	if n != 2 {
+		t.Fatalf("expected 2 attempts, got %d", n)
+	}
+	if resp == nil || resp.StatusCode() != http.StatusBadGateway {
+		t.Fatalf("resp = %v, want the final 502", resp)
+	}
+}
+
+// A caller deadline bounds the whole operation, not each attempt: a hung attempt
+// must still time out at RequestTimeout so the retry can run within the budget.
+func TestGetContext_RequestTimeoutBoundsAttemptUnderCallerDeadline(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	var n int
+	httpClient := &http.Client{Transport: RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		n++
+		if n == 1 {
+			<-req.Context().Done()
+			return nil, req.Context().Err()
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(`{}`)),
+			Header:     make(http.Header),
+			Request:    req,
+		}, nil
+	})}
+
+	c := &APIClient{APIEndpoint: retryTestAPIEndpoint, APIToken: "secret", HTTPClient: httpClient, RequestTimeout: 50 * time.Millisecond}
+	resp, err := c.GetContext(ctx, "/api/x")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 2 || resp.StatusCode() != http.StatusOK {
+		t.Fatalf("attempts = %d, status = %d; want the hung attempt retried", n, resp.StatusCode())
+	}
+}
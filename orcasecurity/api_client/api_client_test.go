@@ -1,6 +1,7 @@
 package api_client
 
 import (
+	"errors"
 	"io"
 	"io/ioutil"
 	"net/http"
@@ -14,6 +15,13 @@ func (f RoundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
 	return f(req), nil
 }
 
+// RoundTripperFunc is RoundTripFunc for transports that need to fail.
+type RoundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f RoundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
 // assertMethodPath checks the request method and path. Extracted so callers
 // keep these assertions out of the RoundTripFunc closure (where each branch
 // would count double toward cognitive complexity due to nesting).
@@ -142,9 +150,12 @@ func TestAPIResponse_Error_FromMessage(t *testing.T) {
 	apiClient := APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
 	resp, _ := apiClient.Get("/")
 
-	err := resp.Error()
-	if err.Error() != "not ok" {
-		t.Errorf("expected to read 'ok', got %s", err.Error())
+	var apiErr *APIError
+	if !errors.As(resp.Error(), &apiErr) {
+		t.Fatalf("expected *APIError, got %T", resp.Error())
+	}
+	if apiErr.Message != "not ok" || apiErr.StatusCode != 400 {
+		t.Errorf("expected status 400 with message 'not ok', got %d %q", apiErr.StatusCode, apiErr.Message)
 	}
 }
 
@@ -159,9 +170,12 @@ func TestAPIResponse_Error_FromError(t *testing.T) {
 	apiClient := APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
 	resp, _ := apiClient.Get("/")
 
-	err := resp.Error()
-	if err.Error() != "not ok" {
-		t.Errorf("expected to read 'ok', got %s", err.Error())
+	var apiErr *APIError
+	if !errors.As(resp.Error(), &apiErr) {
+		t.Fatalf("expected *APIError, got %T", resp.Error())
+	}
+	if apiErr.Message != "not ok" || apiErr.StatusCode != 400 {
+		t.Errorf("expected status 400 with message 'not ok', got %d %q", apiErr.StatusCode, apiErr.Message)
 	}
 }
 
@@ -0,0 +1,151 @@
+package api_client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// requestIDHeaders are checked in order for the ID Orca support needs to find
+// a request in their logs.
+var requestIDHeaders = []string{"X-Request-Id", "X-Correlation-Id", "X-Amzn-Requestid"}
+
+// APIError is the error returned for any response with status >= 400. Use
+// errors.As to branch on it; Error() keeps the "status: N, message" prefix
+// older callers matched on.
+type APIError struct {
+	StatusCode int
+	// Code is Orca's machine-readable error code (e.g. "not_found"), if sent.
+	Code    string
+	Message string
+	// RequestID is the server-assigned request/correlation ID, if sent.
+	RequestID string
+	// FieldErrors are per-field validation failures, sorted by field.
+	FieldErrors []FieldError
+}
+
+// FieldError is one validation failure. Field is the API's dotted field name,
+// e.g. "name" or "config.api_key" or "sections.0.title".
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+func (e *APIError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "status: %d, %s", e.StatusCode, e.Message)
+	for _, fe := range e.FieldErrors {
+		fmt.Fprintf(&b, "; %s: %s", fe.Field, fe.Message)
+	}
+	var meta []string
+	if e.Code != "" {
+		meta = append(meta, "code: "+e.Code)
+	}
+	if e.RequestID != "" {
+		meta = append(meta, "request ID: "+e.RequestID)
+	}
+	if len(meta) > 0 {
+		fmt.Fprintf(&b, " (%s)", strings.Join(meta, ", "))
+	}
+	return b.String()
+}
+
+// IsNotFound reports whether err carries a 404 APIError.
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
+// newAPIError decodes the error shapes Orca endpoints use:
+// {"message"|"error"|"detail": ..., "error_code"|"code": ...} and
+// {"errors": {"field": ["msg", ...]}} or {"errors": [{"field": ..., "message": ...}]}.
+// A body that is not JSON becomes the message verbatim.
+func newAPIError(res *http.Response, body []byte) *APIError {
+	apiErr := &APIError{StatusCode: res.StatusCode}
+	for _, h := range requestIDHeaders {
+		if id := res.Header.Get(h); id != "" {
+			apiErr.RequestID = id
+			break
+		}
+	}
+
+	var wire struct {
+		Message   string          `json:"message"`
+		Error     string          `json:"error"`
+		Detail    string          `json:"detail"`
+		ErrorCode string          `json:"error_code"`
+		Code      json.RawMessage `json:"code"`
+		Errors    json.RawMessage `json:"errors"`
+	}
+	if err := json.Unmarshal(body, &wire); err != nil {
+		apiErr.Message = strings.TrimSpace(string(body))
+		return apiErr
+	}
+
+	apiErr.Message = firstNonEmpty(wire.Message, wire.Error, wire.Detail)
+	apiErr.Code = wire.ErrorCode
+	if apiErr.Code == "" {
+		var code string
+		if json.Unmarshal(wire.Code, &code) == nil {
+			apiErr.Code = code
+		}
+	}
+	apiErr.FieldErrors = decodeFieldErrors(wire.Errors)
+	if apiErr.Message == "" {
+		if len(apiErr.FieldErrors) > 0 {
+			apiErr.Message = "validation failed"
+		} else {
+			apiErr.Message = http.StatusText(res.StatusCode)
+		}
+	}
+	return apiErr
+}
+
+func decodeFieldErrors(raw json.RawMessage) []FieldError {
+	if len(raw) == 0 {
+		return nil
+	}
+	var out []FieldError
+
+	var byField map[string]json.RawMessage
+	if json.Unmarshal(raw, &byField) == nil {
+		for field, v := range byField {
+			var msgs []string
+			if json.Unmarshal(v, &msgs) != nil {
+				var msg string
+				if json.Unmarshal(v, &msg) != nil {
+					msg = string(v)
+				}
+				msgs = []string{msg}
+			}
+			for _, m := range msgs {
+				out = append(out, FieldError{Field: field, Message: m})
+			}
+		}
+	} else {
+		var list []struct {
+			Field   string `json:"field"`
+			Message string `json:"message"`
+		}
+		if json.Unmarshal(raw, &list) == nil {
+			for _, fe := range list {
+				out = append(out, FieldError{Field: fe.Field, Message: fe.Message})
+			}
+		}
+	}
+
+	sort.SliceStable(out, func(i, j int) bool { return out[i].Field < out[j].Field })
+	return out
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
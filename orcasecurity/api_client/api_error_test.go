@@ -0,0 +1,77 @@
+package api_client
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func errorResponse(status int, headers map[string]string) *http.Response {
+	res := &http.Response{StatusCode: status, Header: http.Header{}}
+	for k, v := range headers {
+		res.Header.Set(k, v)
+	}
+	return res
+}
+
+func TestNewAPIError_MessageAndCode(t *testing.T) {
+	res := errorResponse(409, map[string]string{"X-Request-Id": "req-1"})
+	err := newAPIError(res, []byte(`{"message": "already exists", "error_code": "conflict"}`))
+
+	if err.StatusCode != 409 || err.Message != "already exists" || err.Code != "conflict" || err.RequestID != "req-1" {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	want := "status: 409, already exists (code: conflict, request ID: req-1)"
+	if err.Error() != want {
+		t.Errorf("expected %q, got %q", want, err.Error())
+	}
+}
+
+func TestNewAPIError_FieldErrorsMap(t *testing.T) {
+	err := newAPIError(errorResponse(400, nil), []byte(`{"errors": {"name": ["is required"], "config.api_key": "too short"}}`))
+
+	if err.Message != "validation failed" {
+		t.Errorf("expected fallback message, got %q", err.Message)
+	}
+	want := []FieldError{{Field: "config.api_key", Message: "too short"}, {Field: "name", Message: "is required"}}
+	if fmt.Sprint(err.FieldErrors) != fmt.Sprint(want) {
+		t.Errorf("expected %v, got %v", want, err.FieldErrors)
+	}
+	if got := err.Error(); got != "status: 400, validation failed; config.api_key: too short; name: is required" {
+		t.Errorf("unexpected Error(): %q", got)
+	}
+}
+
+func TestNewAPIError_FieldErrorsList(t *testing.T) {
+	res := errorResponse(422, map[string]string{"X-Correlation-Id": "corr-9"})
+	err := newAPIError(res, []byte(`{"detail": "bad input", "code": "invalid", "errors": [{"field": "sections.0.title", "message": "blank"}]}`))
+
+	if err.Message != "bad input" || err.Code != "invalid" || err.RequestID != "corr-9" {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if len(err.FieldErrors) != 1 || err.FieldErrors[0].Field != "sections.0.title" {
+		t.Errorf("unexpected field errors: %v", err.FieldErrors)
+	}
+}
+
+func TestNewAPIError_NonJSONBody(t *testing.T) {
+	err := newAPIError(errorResponse(502, nil), []byte("bad gateway\n"))
+	if err.Message != "bad gateway" {
+		t.Errorf("expected raw body as message, got %q", err.Message)
+	}
+
+	err = newAPIError(errorResponse(404, nil), []byte(`{}`))
+	if err.Message != "Not Found" {
+		t.Errorf("expected status text as message, got %q", err.Message)
+	}
+}
+
+func TestIsNotFound(t *testing.T) {
+	notFound := fmt.Errorf("reading widget: %w", &APIError{StatusCode: 404})
+	if !IsNotFound(notFound) {
+		t.Error("expected wrapped 404 to be not found")
+	}
+	if IsNotFound(&APIError{StatusCode: 400}) || IsNotFound(fmt.Errorf("status: 404")) {
+		t.Error("expected only a 404 APIError to be not found")
+	}
+}
@@ -1,6 +1,7 @@
 package api_client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -43,8 +44,8 @@ type AutomationV2Action struct {
 	SiemToken *string `json:"siem_token,omitempty"`
 }
 
-func (client *APIClient) GetAutomationV2(automationID string) (*AutomationV2, error) {
-	resp, err := client.Get(fmt.Sprintf("/api/automations/%s", automationID))
+func (client *APIClient) GetAutomationV2(ctx context.Context, automationID string) (*AutomationV2, error) {
+	resp, err := client.GetContext(ctx, fmt.Sprintf("/api/automations/%s", automationID))
 	if err != nil {
 		return nil, err
 	}
@@ -61,14 +62,14 @@ func (client *APIClient) GetAutomationV2(automationID string) (*AutomationV2, er
 // through the list endpoint. Priorities in real-world data may contain
 // duplicates or gaps from legacy rows; the server order is still
 // deterministic.
-func (client *APIClient) ListAutomationsV2() ([]AutomationV2, error) {
+func (client *APIClient) ListAutomationsV2(ctx context.Context) ([]AutomationV2, error) {
 	const pageLimit = 300
 	var all []AutomationV2
 	for {
 		// Offset by items actually received, not page count, so a short page
 		// with more items remaining (concurrent inserts, server-side cap)
 		// under-fetches safely instead of silently skipping items.
-		resp, err := client.Get(fmt.Sprintf("/api/automations?limit=%d&start_at_index=%d", pageLimit, len(all)))
+		resp, err := client.getCached(ctx, fmt.Sprintf("/api/automations?limit=%d&start_at_index=%d", pageLimit, len(all)))
 		if err != nil {
 			return nil, err
 		}
@@ -88,17 +89,17 @@ func (client *APIClient) ListAutomationsV2() ([]AutomationV2, error) {
 	}
 }
 
-func (client *APIClient) DoesAutomationV2Exist(id string) (bool, error) {
-	resp, _ := client.Head(fmt.Sprintf("/api/automations/%s", id))
+func (client *APIClient) DoesAutomationV2Exist(ctx context.Context, id string) (bool, error) {
+	resp, _ := client.HeadContext(ctx, fmt.Sprintf("/api/automations/%s", id))
 	return resp.StatusCode() == 200, nil
 }
 
-func (client *APIClient) CreateAutomationV2(automation AutomationV2, applyOnExisting bool) (*AutomationV2, error) {
+func (client *APIClient) CreateAutomationV2(ctx context.Context, automation AutomationV2, applyOnExisting bool) (*AutomationV2, error) {
 	path := "/api/automations"
 	if applyOnExisting {
 		path += "?apply_on_existing=true"
 	}
-	resp, err := client.Post(path, automation)
+	resp, err := client.PostContext(ctx, path, automation)
 	if err != nil {
 		return nil, err
 	}
@@ -106,8 +107,8 @@ func (client *APIClient) CreateAutomationV2(automation AutomationV2, applyOnExis
 	return readData[AutomationV2](resp)
 }
 
-func (client *APIClient) UpdateAutomationV2(ID string, data AutomationV2) (*AutomationV2, error) {
-	resp, err := client.Put(fmt.Sprintf("/api/automations/%s", ID), data)
+func (client *APIClient) UpdateAutomationV2(ctx context.Context, ID string, data AutomationV2) (*AutomationV2, error) {
+	resp, err := client.PutContext(ctx, fmt.Sprintf("/api/automations/%s", ID), data)
 	if err != nil {
 		return nil, err
 	}
@@ -121,12 +122,12 @@ func (client *APIClient) UpdateAutomationV2(ID string, data AutomationV2) (*Auto
 // current highest priority (Least(new, Max(priority)), NOT the automation
 // count — legacy data can have gaps and duplicates), so callers must compare
 // the returned Priority with the requested one.
-func (client *APIClient) SetAutomationV2Priority(automationID string, priority int64) (*AutomationV2, error) {
+func (client *APIClient) SetAutomationV2Priority(ctx context.Context, automationID string, priority int64) (*AutomationV2, error) {
 	payload := struct {
 		Priority int64 `json:"priority"`
 	}{Priority: priority}
 
-	resp, err := client.Put(fmt.Sprintf("/api/automations/%s/priority", automationID), payload)
+	resp, err := client.PutContext(ctx, fmt.Sprintf("/api/automations/%s/priority", automationID), payload)
 	if err != nil {
 		return nil, err
 	}
@@ -134,7 +135,7 @@ func (client *APIClient) SetAutomationV2Priority(automationID string, priority i
 	return readData[AutomationV2](resp)
 }
 
-func (client *APIClient) DeleteAutomationV2(ID string) error {
-	_, err := client.Delete(fmt.Sprintf("/api/automations/%s", ID))
+func (client *APIClient) DeleteAutomationV2(ctx context.Context, ID string) error {
+	_, err := client.DeleteContext(ctx, fmt.Sprintf("/api/automations/%s", ID))
 	return err
 }
@@ -1,6 +1,7 @@
 package api_client_test
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -20,7 +21,7 @@ func TestAutomationsV2_DoesAutomationV2Exist(t *testing.T) {
 	})}
 
 	apiClient := api_client.APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	exists, err := apiClient.DoesAutomationV2Exist("1")
+	exists, err := apiClient.DoesAutomationV2Exist(context.Background(), "1")
 	if err != nil {
 		t.Error(err)
 	}
@@ -39,7 +40,7 @@ func TestAutomationsV2_DoesAutomationV2ExistFalse(t *testing.T) {
 	})}
 
 	apiClient := api_client.APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	exists, err := apiClient.DoesAutomationV2Exist("1")
+	exists, err := apiClient.DoesAutomationV2Exist(context.Background(), "1")
 	if err != nil {
 		t.Error(err)
 	}
@@ -94,7 +95,7 @@ func TestAutomationsV2_GetAutomationV2(t *testing.T) {
 	})}
 
 	apiClient := api_client.APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	automation, err := apiClient.GetAutomationV2("test-id-123")
+	automation, err := apiClient.GetAutomationV2(context.Background(), "test-id-123")
 	if err != nil {
 		t.Fatalf("GetAutomationV2 failed: %v", err)
 	}
@@ -127,7 +128,7 @@ func TestAutomationsV2_GetAutomationV2NotFound(t *testing.T) {
 	})}
 
 	apiClient := api_client.APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	automation, err := apiClient.GetAutomationV2("non-existent")
+	automation, err := apiClient.GetAutomationV2(context.Background(), "non-existent")
 	if err == nil {
 		t.Fatal("GetAutomationV2 should return error for 404")
 	}
@@ -212,7 +213,7 @@ func TestAutomationsV2_CreateAutomationV2(t *testing.T) {
 	})}
 
 	apiClient := api_client.APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	automation, err := apiClient.CreateAutomationV2(expectedRequest, false)
+	automation, err := apiClient.CreateAutomationV2(context.Background(), expectedRequest, false)
 	if err != nil {
 		t.Fatalf("CreateAutomationV2 failed: %v", err)
 	}
@@ -272,7 +273,7 @@ func TestAutomationsV2_CreateAutomationV2_ApplyOnExisting(t *testing.T) {
 	}
 
 	apiClient := api_client.APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	result, err := apiClient.CreateAutomationV2(automation, true)
+	result, err := apiClient.CreateAutomationV2(context.Background(), automation, true)
 	if err != nil {
 		t.Fatalf("CreateAutomationV2 with applyOnExisting=true failed: %v", err)
 	}
@@ -354,7 +355,7 @@ func TestAutomationsV2_UpdateAutomationV2(t *testing.T) {
 	})}
 
 	apiClient := api_client.APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	automation, err := apiClient.UpdateAutomationV2("test-id-123", updateRequest)
+	automation, err := apiClient.UpdateAutomationV2(context.Background(), "test-id-123", updateRequest)
 	if err != nil {
 		t.Fatalf("UpdateAutomationV2 failed: %v", err)
 	}
@@ -390,7 +391,7 @@ func TestAutomationsV2_DeleteAutomationV2(t *testing.T) {
 	})}
 
 	apiClient := api_client.APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	err := apiClient.DeleteAutomationV2("test-id-123")
+	err := apiClient.DeleteAutomationV2(context.Background(), "test-id-123")
 	if err != nil {
 		t.Fatalf("DeleteAutomationV2 failed: %v", err)
 	}
@@ -493,7 +494,7 @@ func TestAutomationsV2_GetAutomationV2DecodesPriority(t *testing.T) {
 	})}
 
 	apiClient := api_client.APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	automation, err := apiClient.GetAutomationV2("test-id-123")
+	automation, err := apiClient.GetAutomationV2(context.Background(), "test-id-123")
 	if err != nil {
 		t.Fatalf("GetAutomationV2 failed: %v", err)
 	}
@@ -542,7 +543,7 @@ func TestAutomationsV2_SetAutomationV2Priority(t *testing.T) {
 	})}
 
 	apiClient := api_client.APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	automation, err := apiClient.SetAutomationV2Priority("test-id-123", 3)
+	automation, err := apiClient.SetAutomationV2Priority(context.Background(), "test-id-123", 3)
 	if err != nil {
 		t.Fatalf("SetAutomationV2Priority failed: %v", err)
 	}
@@ -577,7 +578,7 @@ func TestAutomationsV2_SetAutomationV2PriorityClamped(t *testing.T) {
 	})}
 
 	apiClient := api_client.APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	automation, err := apiClient.SetAutomationV2Priority("test-id-123", 50)
+	automation, err := apiClient.SetAutomationV2Priority(context.Background(), "test-id-123", 50)
 	if err != nil {
 		t.Fatalf("SetAutomationV2Priority failed: %v", err)
 	}
@@ -619,7 +620,7 @@ func TestAutomationsV2_ListAutomationsV2Paginates(t *testing.T) {
 	})}
 
 	apiClient := api_client.APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	automations, err := apiClient.ListAutomationsV2()
+	automations, err := apiClient.ListAutomationsV2(context.Background())
 	if err != nil {
 		t.Fatalf("ListAutomationsV2 failed: %v", err)
 	}
@@ -651,7 +652,7 @@ func TestAutomationsV2_ListAutomationsV2StopsOnEmptyPage(t *testing.T) {
 	})}
 
 	apiClient := api_client.APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	automations, err := apiClient.ListAutomationsV2()
+	automations, err := apiClient.ListAutomationsV2(context.Background())
 	if err != nil {
 		t.Fatalf("ListAutomationsV2 failed: %v", err)
 	}
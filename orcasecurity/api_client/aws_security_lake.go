@@ -0,0 +1,50 @@
+package api_client
+
+import "context"
+
+const AwsSecurityLakeServiceName = "aws_security_lake"
+
+// AwsSecurityLakeConfig mirrors the "config" block of the aws_security_lake external_service/config
+// payload. Orca assumes the custom source's provider role (presenting ExternalID) and writes OCSF
+// findings under S3Location.
+type AwsSecurityLakeConfig struct {
+	S3Location string `json:"s3_location,omitempty"`
+	Region     string `json:"region,omitempty"`
+	RoleArn    string `json:"role_arn,omitempty"`
+	ExternalID string `json:"external_id,omitempty"`
+}
+
+type AwsSecurityLakeExternalServiceConfig = ConfigEnvelope[AwsSecurityLakeConfig]
+
+func (client *APIClient) CreateAwsSecurityLakeConfig(ctx context.Context, payload AwsSecurityLakeExternalServiceConfig) (*AwsSecurityLakeExternalServiceConfig, error) {
+	return CreateExternalServiceConfig[AwsSecurityLakeConfig](ctx, client, AwsSecurityLakeServiceName, payload)
+}
+
+func (client *APIClient) GetAwsSecurityLakeConfig(ctx context.Context, templateName string) (*AwsSecurityLakeExternalServiceConfig, error) {
+	return GetExternalServiceConfig[AwsSecurityLakeConfig](ctx, client, AwsSecurityLakeServiceName, templateName, nil)
+}
+
+func (client *APIClient) ListAwsSecurityLakeConfigs(ctx context.Context) ([]AwsSecurityLakeExternalServiceConfig, error) {
+	return ListExternalServiceConfigs[AwsSecurityLakeConfig](ctx, client, AwsSecurityLakeServiceName, nil)
+}
+
+func (client *APIClient) UpdateAwsSecurityLakeConfig(ctx context.Context, templateName string, payload AwsSecurityLakeExternalServiceConfig) (*AwsSecurityLakeExternalServiceConfig, error) {
+	cfg := map[string]interface{}{}
+	if payload.Config.S3Location != "" {
+		cfg["s3_location"] = payload.Config.S3Location
+	}
+	if payload.Config.Region != "" {
+		cfg["region"] = payload.Config.Region
+	}
+	if payload.Config.RoleArn != "" {
+		cfg["role_arn"] = payload.Config.RoleArn
+	}
+	if payload.Config.ExternalID != "" {
+		cfg["external_id"] = payload.Config.ExternalID
+	}
+	return UpdateExternalServiceConfig[AwsSecurityLakeConfig](ctx, client, AwsSecurityLakeServiceName, templateName, BuildUpdateBody(payload, cfg, false))
+}
+
+func (client *APIClient) DeleteAwsSecurityLakeConfig(ctx context.Context, templateName string) error {
+	return DeleteExternalServiceConfig(ctx, client, AwsSecurityLakeServiceName, templateName)
+}
@@ -0,0 +1,49 @@
+package api_client
+
+import "context"
+
+const AwsSnsServiceName = "aws_sns"
+
+// AwsSnsConfig mirrors the "config" block of the aws_sns external_service/config payload. Orca
+// assumes RoleArn (presenting ExternalID) to publish to the topic.
+type AwsSnsConfig struct {
+	TopicArn   string `json:"topic_arn,omitempty"`
+	Region     string `json:"region,omitempty"`
+	RoleArn    string `json:"role_arn,omitempty"`
+	ExternalID string `json:"external_id,omitempty"`
+}
+
+type AwsSnsExternalServiceConfig = ConfigEnvelope[AwsSnsConfig]
+
+func (client *APIClient) CreateAwsSnsConfig(ctx context.Context, payload AwsSnsExternalServiceConfig) (*AwsSnsExternalServiceConfig, error) {
+	return CreateExternalServiceConfig[AwsSnsConfig](ctx, client, AwsSnsServiceName, payload)
+}
+
+func (client *APIClient) GetAwsSnsConfig(ctx context.Context, templateName string) (*AwsSnsExternalServiceConfig, error) {
+	return GetExternalServiceConfig[AwsSnsConfig](ctx, client, AwsSnsServiceName, templateName, nil)
+}
+
+func (client *APIClient) ListAwsSnsConfigs(ctx context.Context) ([]AwsSnsExternalServiceConfig, error) {
+	return ListExternalServiceConfigs[AwsSnsConfig](ctx, client, AwsSnsServiceName, nil)
+}
+
+func (client *APIClient) UpdateAwsSnsConfig(ctx context.Context, templateName string, payload AwsSnsExternalServiceConfig) (*AwsSnsExternalServiceConfig, error) {
+	cfg := map[string]interface{}{}
+	if payload.Config.TopicArn != "" {
+		cfg["topic_arn"] = payload.Config.TopicArn
+	}
+	if payload.Config.Region != "" {
+		cfg["region"] = payload.Config.Region
+	}
+	if payload.Config.RoleArn != "" {
+		cfg["role_arn"] = payload.Config.RoleArn
+	}
+	if payload.Config.ExternalID != "" {
+		cfg["external_id"] = payload.Config.ExternalID
+	}
+	return UpdateExternalServiceConfig[AwsSnsConfig](ctx, client, AwsSnsServiceName, templateName, BuildUpdateBody(payload, cfg, false))
+}
+
+func (client *APIClient) DeleteAwsSnsConfig(ctx context.Context, templateName string) error {
+	return DeleteExternalServiceConfig(ctx, client, AwsSnsServiceName, templateName)
+}
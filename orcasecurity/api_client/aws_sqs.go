@@ -0,0 +1,49 @@
+package api_client
+
+import "context"
+
+const AwsSqsServiceName = "aws_sqs"
+
+// AwsSqsConfig mirrors the "config" block of the aws_sqs external_service/config payload. Orca
+// assumes RoleArn (presenting ExternalID) to send messages to the queue.
+type AwsSqsConfig struct {
+	QueueArn   string `json:"queue_arn,omitempty"`
+	Region     string `json:"region,omitempty"`
+	RoleArn    string `json:"role_arn,omitempty"`
+	ExternalID string `json:"external_id,omitempty"`
+}
+
+type AwsSqsExternalServiceConfig = ConfigEnvelope[AwsSqsConfig]
+
+func (client *APIClient) CreateAwsSqsConfig(ctx context.Context, payload AwsSqsExternalServiceConfig) (*AwsSqsExternalServiceConfig, error) {
+	return CreateExternalServiceConfig[AwsSqsConfig](ctx, client, AwsSqsServiceName, payload)
+}
+
+func (client *APIClient) GetAwsSqsConfig(ctx context.Context, templateName string) (*AwsSqsExternalServiceConfig, error) {
+	return GetExternalServiceConfig[AwsSqsConfig](ctx, client, AwsSqsServiceName, templateName, nil)
+}
+
+func (client *APIClient) ListAwsSqsConfigs(ctx context.Context) ([]AwsSqsExternalServiceConfig, error) {
+	return ListExternalServiceConfigs[AwsSqsConfig](ctx, client, AwsSqsServiceName, nil)
+}
+
+func (client *APIClient) UpdateAwsSqsConfig(ctx context.Context, templateName string, payload AwsSqsExternalServiceConfig) (*AwsSqsExternalServiceConfig, error) {
+	cfg := map[string]interface{}{}
+	if payload.Config.QueueArn != "" {
+		cfg["queue_arn"] = payload.Config.QueueArn
+	}
+	if payload.Config.Region != "" {
+		cfg["region"] = payload.Config.Region
+	}
+	if payload.Config.RoleArn != "" {
+		cfg["role_arn"] = payload.Config.RoleArn
+	}
+	if payload.Config.ExternalID != "" {
+		cfg["external_id"] = payload.Config.ExternalID
+	}
+	return UpdateExternalServiceConfig[AwsSqsConfig](ctx, client, AwsSqsServiceName, templateName, BuildUpdateBody(payload, cfg, false))
+}
+
+func (client *APIClient) DeleteAwsSqsConfig(ctx context.Context, templateName string) error {
+	return DeleteExternalServiceConfig(ctx, client, AwsSqsServiceName, templateName)
+}
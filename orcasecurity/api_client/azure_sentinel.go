@@ -1,5 +1,7 @@
 package api_client
 
+import "context"
+
 const AzureSentinelServiceName = "azure_sentinel"
 
 type AzureSentinelConfig struct {
@@ -10,15 +12,19 @@ type AzureSentinelConfig struct {
 
 type AzureSentinelExternalServiceConfig = ConfigEnvelope[AzureSentinelConfig]
 
-func (client *APIClient) CreateAzureSentinelConfig(payload AzureSentinelExternalServiceConfig) (*AzureSentinelExternalServiceConfig, error) {
-	return CreateExternalServiceConfig[AzureSentinelConfig](client, AzureSentinelServiceName, payload)
+func (client *APIClient) CreateAzureSentinelConfig(ctx context.Context, payload AzureSentinelExternalServiceConfig) (*AzureSentinelExternalServiceConfig, error) {
+	return CreateExternalServiceConfig[AzureSentinelConfig](ctx, client, AzureSentinelServiceName, payload)
+}
+
+func (client *APIClient) GetAzureSentinelConfig(ctx context.Context, templateName string) (*AzureSentinelExternalServiceConfig, error) {
+	return GetExternalServiceConfig[AzureSentinelConfig](ctx, client, AzureSentinelServiceName, templateName, nil)
 }
 
-func (client *APIClient) GetAzureSentinelConfig(templateName string) (*AzureSentinelExternalServiceConfig, error) {
-	return GetExternalServiceConfig[AzureSentinelConfig](client, AzureSentinelServiceName, templateName, nil)
+func (client *APIClient) ListAzureSentinelConfigs(ctx context.Context) ([]AzureSentinelExternalServiceConfig, error) {
+	return ListExternalServiceConfigs[AzureSentinelConfig](ctx, client, AzureSentinelServiceName, nil)
 }
 
-func (client *APIClient) UpdateAzureSentinelConfig(templateName string, payload AzureSentinelExternalServiceConfig) (*AzureSentinelExternalServiceConfig, error) {
+func (client *APIClient) UpdateAzureSentinelConfig(ctx context.Context, templateName string, payload AzureSentinelExternalServiceConfig) (*AzureSentinelExternalServiceConfig, error) {
 	cfg := map[string]interface{}{}
 	if payload.Config.LogType != "" {
 		cfg["log_type"] = payload.Config.LogType
@@ -29,9 +35,9 @@ func (client *APIClient) UpdateAzureSentinelConfig(templateName string, payload
 	if payload.Config.WorkspaceID != "" {
 		cfg["workspace_id"] = payload.Config.WorkspaceID
 	}
-	return UpdateExternalServiceConfig[AzureSentinelConfig](client, AzureSentinelServiceName, templateName, BuildUpdateBody(payload, cfg, true))
+	return UpdateExternalServiceConfig[AzureSentinelConfig](ctx, client, AzureSentinelServiceName, templateName, BuildUpdateBody(payload, cfg, true))
 }
 
-func (client *APIClient) DeleteAzureSentinelConfig(templateName string) error {
-	return DeleteExternalServiceConfig(client, AzureSentinelServiceName, templateName)
+func (client *APIClient) DeleteAzureSentinelConfig(ctx context.Context, templateName string) error {
+	return DeleteExternalServiceConfig(ctx, client, AzureSentinelServiceName, templateName)
 }
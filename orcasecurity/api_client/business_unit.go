@@ -1,6 +1,7 @@
 package api_client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -38,8 +39,8 @@ type businessUnitAPIResponseType struct {
 	Data BusinessUnit `json:"data"`
 }
 
-func (client *APIClient) GetBusinessUnit(businessUnitID string) (*BusinessUnit, error) {
-	resp, err := client.Get(fmt.Sprintf("/api/filters/%s", businessUnitID))
+func (client *APIClient) GetBusinessUnit(ctx context.Context, businessUnitID string) (*BusinessUnit, error) {
+	resp, err := client.GetContext(ctx, fmt.Sprintf("/api/filters/%s", businessUnitID))
 	if err != nil {
 		return nil, err
 	}
@@ -56,13 +57,26 @@ func (client *APIClient) GetBusinessUnit(businessUnitID string) (*BusinessUnit,
 	return &response.Data, nil
 }
 
-func (client *APIClient) DoesBusinessUnitExist(id string) (bool, error) {
-	resp, _ := client.Head(fmt.Sprintf("/api/filters/%s", id))
+// ListBusinessUnits returns every business unit in the organization (GET /api/filters).
+func (client *APIClient) ListBusinessUnits(ctx context.Context) ([]BusinessUnit, error) {
+	resp, err := client.GetContext(ctx, "/api/filters")
+	if err != nil {
+		return nil, err
+	}
+	units, err := readData[[]BusinessUnit](resp)
+	if err != nil {
+		return nil, fmt.Errorf("parse business unit list: %w", err)
+	}
+	return *units, nil
+}
+
+func (client *APIClient) DoesBusinessUnitExist(ctx context.Context, id string) (bool, error) {
+	resp, _ := client.HeadContext(ctx, fmt.Sprintf("/api/filters/%s", id))
 	return resp.StatusCode() == 200, nil
 }
 
-func (client *APIClient) CreateBusinessUnit(business_units BusinessUnit) (*BusinessUnit, error) {
-	resp, err := client.Post("/api/filters", business_units)
+func (client *APIClient) CreateBusinessUnit(ctx context.Context, business_units BusinessUnit) (*BusinessUnit, error) {
+	resp, err := client.PostContext(ctx, "/api/filters", business_units)
 	if err != nil {
 		return nil, err
 	}
@@ -75,8 +89,8 @@ func (client *APIClient) CreateBusinessUnit(business_units BusinessUnit) (*Busin
 	return &response.Data, nil
 }
 
-func (client *APIClient) UpdateBusinessUnit(ID string, data BusinessUnit) (*BusinessUnit, error) {
-	resp, err := client.Put(fmt.Sprintf("/api/filters/%s", ID), data)
+func (client *APIClient) UpdateBusinessUnit(ctx context.Context, ID string, data BusinessUnit) (*BusinessUnit, error) {
+	resp, err := client.PutContext(ctx, fmt.Sprintf("/api/filters/%s", ID), data)
 	if err != nil {
 		return nil, err
 	}
@@ -89,7 +103,7 @@ func (client *APIClient) UpdateBusinessUnit(ID string, data BusinessUnit) (*Busi
 	return &response.Data, nil
 }
 
-func (client *APIClient) DeleteBusinessUnit(ID string) error {
-	_, err := client.Delete(fmt.Sprintf("/api/filters/%s", ID))
+func (client *APIClient) DeleteBusinessUnit(ctx context.Context, ID string) error {
+	_, err := client.DeleteContext(ctx, fmt.Sprintf("/api/filters/%s", ID))
 	return err
 }
@@ -0,0 +1,50 @@
+package api_client
+
+import "context"
+
+const ChronicleServiceName = "chronicle"
+
+// ChronicleConfig mirrors the "config" block of the chronicle external_service/config payload.
+// ServiceAccountJSON is the Google service account key Orca authenticates to the ingestion API
+// with; Orca keeps it in the secret store.
+type ChronicleConfig struct {
+	CustomerID         string `json:"customer_id,omitempty"`
+	Region             string `json:"region,omitempty"`
+	LogType            string `json:"log_type,omitempty"`
+	ServiceAccountJSON string `json:"service_account_json,omitempty"`
+}
+
+type ChronicleExternalServiceConfig = ConfigEnvelope[ChronicleConfig]
+
+func (client *APIClient) CreateChronicleConfig(ctx context.Context, payload ChronicleExternalServiceConfig) (*ChronicleExternalServiceConfig, error) {
+	return CreateExternalServiceConfig[ChronicleConfig](ctx, client, ChronicleServiceName, payload)
+}
+
+func (client *APIClient) GetChronicleConfig(ctx context.Context, templateName string) (*ChronicleExternalServiceConfig, error) {
+	return GetExternalServiceConfig[ChronicleConfig](ctx, client, ChronicleServiceName, templateName, nil)
+}
+
+func (client *APIClient) ListChronicleConfigs(ctx context.Context) ([]ChronicleExternalServiceConfig, error) {
+	return ListExternalServiceConfigs[ChronicleConfig](ctx, client, ChronicleServiceName, nil)
+}
+
+func (client *APIClient) UpdateChronicleConfig(ctx context.Context, templateName string, payload ChronicleExternalServiceConfig) (*ChronicleExternalServiceConfig, error) {
+	cfg := map[string]interface{}{}
+	if payload.Config.CustomerID != "" {
+		cfg["customer_id"] = payload.Config.CustomerID
+	}
+	if payload.Config.Region != "" {
+		cfg["region"] = payload.Config.Region
+	}
+	if payload.Config.LogType != "" {
+		cfg["log_type"] = payload.Config.LogType
+	}
+	if payload.Config.ServiceAccountJSON != "" {
+		cfg["service_account_json"] = payload.Config.ServiceAccountJSON
+	}
+	return UpdateExternalServiceConfig[ChronicleConfig](ctx, client, ChronicleServiceName, templateName, BuildUpdateBody(payload, cfg, false))
+}
+
+func (client *APIClient) DeleteChronicleConfig(ctx context.Context, templateName string) error {
+	return DeleteExternalServiceConfig(ctx, client, ChronicleServiceName, templateName)
+}
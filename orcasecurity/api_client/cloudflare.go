@@ -1,5 +1,7 @@
 package api_client
 
+import "context"
+
 const CloudflareServiceName = "cloudflare"
 
 type CloudflareConfig struct {
@@ -8,22 +10,26 @@ type CloudflareConfig struct {
 
 type CloudflareExternalServiceConfig = ConfigEnvelope[CloudflareConfig]
 
-func (client *APIClient) CreateCloudflareConfig(payload CloudflareExternalServiceConfig) (*CloudflareExternalServiceConfig, error) {
-	return CreateExternalServiceConfig[CloudflareConfig](client, CloudflareServiceName, payload)
+func (client *APIClient) CreateCloudflareConfig(ctx context.Context, payload CloudflareExternalServiceConfig) (*CloudflareExternalServiceConfig, error) {
+	return CreateExternalServiceConfig[CloudflareConfig](ctx, client, CloudflareServiceName, payload)
+}
+
+func (client *APIClient) GetCloudflareConfig(ctx context.Context, templateName string) (*CloudflareExternalServiceConfig, error) {
+	return GetExternalServiceConfig[CloudflareConfig](ctx, client, CloudflareServiceName, templateName, nil)
 }
 
-func (client *APIClient) GetCloudflareConfig(templateName string) (*CloudflareExternalServiceConfig, error) {
-	return GetExternalServiceConfig[CloudflareConfig](client, CloudflareServiceName, templateName, nil)
+func (client *APIClient) ListCloudflareConfigs(ctx context.Context) ([]CloudflareExternalServiceConfig, error) {
+	return ListExternalServiceConfigs[CloudflareConfig](ctx, client, CloudflareServiceName, nil)
 }
 
-func (client *APIClient) UpdateCloudflareConfig(templateName string, payload CloudflareExternalServiceConfig) (*CloudflareExternalServiceConfig, error) {
+func (client *APIClient) UpdateCloudflareConfig(ctx context.Context, templateName string, payload CloudflareExternalServiceConfig) (*CloudflareExternalServiceConfig, error) {
 	cfg := map[string]interface{}{}
 	if payload.Config.APIToken != "" {
 		cfg["api_token"] = payload.Config.APIToken
 	}
-	return UpdateExternalServiceConfig[CloudflareConfig](client, CloudflareServiceName, templateName, BuildUpdateBody(payload, cfg, false))
+	return UpdateExternalServiceConfig[CloudflareConfig](ctx, client, CloudflareServiceName, templateName, BuildUpdateBody(payload, cfg, false))
 }
 
-func (client *APIClient) DeleteCloudflareConfig(templateName string) error {
-	return DeleteExternalServiceConfig(client, CloudflareServiceName, templateName)
+func (client *APIClient) DeleteCloudflareConfig(ctx context.Context, templateName string) error {
+	return DeleteExternalServiceConfig(ctx, client, CloudflareServiceName, templateName)
 }
@@ -0,0 +1,273 @@
+package api_client
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// credentialRefreshSkew renews a credential this long before it expires so
+	// a request is never sent with a token that lapses on the wire.
+	credentialRefreshSkew = time.Minute
+	// tokenFileRecheck is how long a token file without an expiry is trusted
+	// before it is read again, so rotation by an external agent is picked up.
+	tokenFileRecheck = time.Minute
+	// credentialHelperTTL applies to helper output that carries no expiry.
+	credentialHelperTTL = 5 * time.Minute
+
+	jwtBearerAssertionType = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+)
+
+// CredentialSource supplies the Authorization header for each request in
+// place of the static APIToken.
+type CredentialSource interface {
+	// Authorization returns the full header value, e.g. "Bearer eyJ...".
+	Authorization(ctx context.Context) (string, error)
+	// Invalidate drops any cached credential after the API answered 401. It
+	// reports whether the next Authorization call may return a different one.
+	Invalidate() bool
+}
+
+// fetchFunc obtains a fresh header value. A zero expiry means it never expires.
+type fetchFunc func(ctx context.Context) (authorization string, expiresAt time.Time, err error)
+
+// cachedCredential serializes refreshes and reuses the last credential until
+// credentialRefreshSkew before its expiry.
+type cachedCredential struct {
+	fetch fetchFunc
+
+	mu            sync.Mutex
+	authorization string
+	expiresAt     time.Time
+}
+
+func (c *cachedCredential) Authorization(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.authorization != "" && (c.expiresAt.IsZero() || time.Until(c.expiresAt) > credentialRefreshSkew) {
+		return c.authorization, nil
+	}
+	authorization, expiresAt, err := c.fetch(ctx)
+	if err != nil {
+		return "", err
+	}
+	c.authorization, c.expiresAt = authorization, expiresAt
+	return authorization, nil
+}
+
+func (c *cachedCredential) Invalidate() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.authorization = ""
+	return true
+}
+
+// NewTokenFileCredentials reads the token from path, re-reading it when it
+// expires (JWT "exp" claim) or every tokenFileRecheck otherwise.
+func NewTokenFileCredentials(path string) CredentialSource {
+	return &cachedCredential{fetch: func(context.Context) (string, time.Time, error) {
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			return "", time.Time{}, fmt.Errorf("read API token file: %w", err)
+		}
+		token := strings.TrimSpace(string(raw))
+		if token == "" {
+			return "", time.Time{}, fmt.Errorf("API token file %s is empty", path)
+		}
+		expiresAt, ok := jwtExpiry(token)
+		if !ok {
+			expiresAt = time.Now().Add(tokenFileRecheck + credentialRefreshSkew)
+		}
+		return authorizationFor(token, ""), expiresAt, nil
+	}}
+}
+
+// NewCredentialHelper runs argv to obtain a token. The helper prints either
+// the bare token or a JSON object:
+//
+//	{"token": "...", "token_type": "Bearer", "expires_at": "2024-01-01T00:00:00Z"}
+//
+// where "expires_in" (seconds) may replace "expires_at" and both, like
+// "token_type", are optional.
+func NewCredentialHelper(argv []string) CredentialSource {
+	return &cachedCredential{fetch: func(ctx context.Context) (string, time.Time, error) {
+		if len(argv) == 0 {
+			return "", time.Time{}, errors.New("credential helper command is empty")
+		}
+		var stdout, stderr bytes.Buffer
+		cmd := exec.CommandContext(ctx, argv[0], argv[1:]...)
+		cmd.Stdout, cmd.Stderr = &stdout, &stderr
+		if err := cmd.Run(); err != nil {
+			return "", time.Time{}, fmt.Errorf("credential helper %s failed: %w: %s", argv[0], err, strings.TrimSpace(stderr.String()))
+		}
+		return parseHelperOutput(stdout.Bytes())
+	}}
+}
+
+func parseHelperOutput(out []byte) (string, time.Time, error) {
+	out = bytes.TrimSpace(out)
+	var parsed struct {
+		Token     string `json:"token"`
+		TokenType string `json:"token_type"`
+		ExpiresAt string `json:"expires_at"`
+		ExpiresIn int64  `json:"expires_in"`
+	}
+	token := string(out)
+	var expiresAt time.Time
+	if len(out) > 0 && out[0] == '{' {
+		if err := json.Unmarshal(out, &parsed); err != nil {
+			return "", time.Time{}, fmt.Errorf("parse credential helper output: %w", err)
+		}
+		token = parsed.Token
+		switch {
+		case parsed.ExpiresAt != "":
+			t, err := time.Parse(time.RFC3339, parsed.ExpiresAt)
+			if err != nil {
+				return "", time.Time{}, fmt.Errorf("parse credential helper expires_at: %w", err)
+			}
+			expiresAt = t
+		case parsed.ExpiresIn > 0:
+			expiresAt = time.Now().Add(time.Duration(parsed.ExpiresIn) * time.Second)
+		}
+	}
+	if token == "" {
+		return "", time.Time{}, errors.New("credential helper returned no token")
+	}
+	if expiresAt.IsZero() {
+		var ok bool
+		if expiresAt, ok = jwtExpiry(token); !ok {
+			expiresAt = time.Now().Add(credentialHelperTTL)
+		}
+	}
+	return authorizationFor(token, parsed.TokenType), expiresAt, nil
+}
+
+// OAuthConfig configures the OAuth 2.0 client-credentials grant. The client
+// authenticates with either ClientSecret or an OIDC workload-identity JWT
+// (RFC 7523 client assertion) from ClientAssertion or ClientAssertionFile;
+// the file is re-read on every exchange so a CI-rotated token is picked up.
+type OAuthConfig struct {
+	TokenURL            string
+	ClientID            string
+	ClientSecret        string
+	ClientAssertion     string
+	ClientAssertionFile string
+	Scope               string
+}
+
+// NewOAuthCredentials exchanges cfg for short-lived bearer tokens over
+// httpClient, so token requests share the API's proxy and TLS settings.
+func NewOAuthCredentials(httpClient *http.Client, cfg OAuthConfig) CredentialSource {
+	return &cachedCredential{fetch: func(ctx context.Context) (string, time.Time, error) {
+		form := url.Values{"grant_type": {"client_credentials"}, "client_id": {cfg.ClientID}}
+		if cfg.Scope != "" {
+			form.Set("scope", cfg.Scope)
+		}
+		switch {
+		case cfg.ClientSecret != "":
+			form.Set("client_secret", cfg.ClientSecret)
+		default:
+			assertion, err := clientAssertion(cfg)
+			if err != nil {
+				return "", time.Time{}, err
+			}
+			form.Set("client_assertion_type", jwtBearerAssertionType)
+			form.Set("client_assertion", assertion)
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, cfg.TokenURL, strings.NewReader(form.Encode()))
+		if err != nil {
+			return "", time.Time{}, fmt.Errorf("build OAuth token request: %w", err)
+		}
+		req.Header.Set("content-type", "application/x-www-form-urlencoded")
+		req.Header.Set("accept", "application/json")
+		res, err := httpClient.Do(req)
+		if err != nil {
+			return "", time.Time{}, fmt.Errorf("OAuth token request failed: %w", err)
+		}
+		body, err := readResponseBody(res)
+		if err != nil {
+			return "", time.Time{}, fmt.Errorf("read OAuth token response: %w", err)
+		}
+
+		var parsed struct {
+			AccessToken      string `json:"access_token"`
+			TokenType        string `json:"token_type"`
+			ExpiresIn        int64  `json:"expires_in"`
+			Error            string `json:"error"`
+			ErrorDescription string `json:"error_description"`
+		}
+		_ = json.Unmarshal(body, &parsed)
+		if res.StatusCode >= 400 || parsed.AccessToken == "" {
+			msg := strings.TrimSpace(firstNonEmpty(parsed.ErrorDescription, parsed.Error, string(body)))
+			return "", time.Time{}, fmt.Errorf("OAuth token request returned status %d: %s", res.StatusCode, msg)
+		}
+		var expiresAt time.Time
+		if parsed.ExpiresIn > 0 {
+			expiresAt = time.Now().Add(time.Duration(parsed.ExpiresIn) * time.Second)
+		} else if exp, ok := jwtExpiry(parsed.AccessToken); ok {
+			expiresAt = exp
+		}
+		return authorizationFor(parsed.AccessToken, firstNonEmpty(parsed.TokenType, "Bearer")), expiresAt, nil
+	}}
+}
+
+func clientAssertion(cfg OAuthConfig) (string, error) {
+	if cfg.ClientAssertionFile == "" {
+		if cfg.ClientAssertion == "" {
+			return "", errors.New("OAuth requires a client secret or an OIDC token")
+		}
+		return cfg.ClientAssertion, nil
+	}
+	raw, err := os.ReadFile(cfg.ClientAssertionFile)
+	if err != nil {
+		return "", fmt.Errorf("read OIDC token file: %w", err)
+	}
+	return strings.TrimSpace(string(raw)), nil
+}
+
+// authorizationFor builds the header value for token. Without an explicit
+// type, JWTs are sent as Bearer tokens and anything else as an Orca API token.
+func authorizationFor(token, tokenType string) string {
+	switch {
+	case strings.EqualFold(tokenType, "bearer"):
+		return "Bearer " + token
+	case tokenType != "":
+		return tokenType + " " + token
+	}
+	if _, ok := jwtExpiry(token); ok || strings.Count(token, ".") == 2 {
+		return "Bearer " + token
+	}
+	return "Token " + token
+}
+
+// jwtExpiry returns the "exp" claim of a JWT. The signature is not checked:
+// the expiry only schedules a refresh, it is not trusted for anything else.
+func jwtExpiry(token string) (time.Time, bool) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}, false
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return time.Time{}, false
+	}
+	var claims struct {
+		Exp int64 `json:"exp"`
+	}
+	if json.Unmarshal(payload, &claims) != nil || claims.Exp == 0 {
+		return time.Time{}, false
+	}
+	return time.Unix(claims.Exp, 0), true
+}
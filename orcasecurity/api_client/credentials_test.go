@@ -0,0 +1,157 @@
+package api_client
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func testJWT(exp time.Time) string {
+	payload, _ := json.Marshal(map[string]int64{"exp": exp.Unix()})
+	return "eyJhbGciOiJub25lIn0." + base64.RawURLEncoding.EncodeToString(payload) + ".sig"
+}
+
+func TestAuthorizationFor(t *testing.T) {
+	jwt := testJWT(time.Now().Add(time.Hour))
+	cases := map[string]struct{ token, tokenType, want string }{
+		"orca token":    {"abc123", "", "Token abc123"},
+		"jwt":           {jwt, "", "Bearer " + jwt},
+		"explicit type": {"abc123", "bearer", "Bearer abc123"},
+	}
+	for name, tc := range cases {
+		if got := authorizationFor(tc.token, tc.tokenType); got != tc.want {
+			t.Errorf("%s: got %q, want %q", name, got, tc.want)
+		}
+	}
+}
+
+func TestTokenFileCredentials_RereadsExpiredToken(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	// Expires inside the refresh skew, so every call re-reads the file.
+	first := testJWT(time.Now().Add(30 * time.Second))
+	if err := os.WriteFile(path, []byte(first+"\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	src := NewTokenFileCredentials(path)
+
+	got, err := src.Authorization(context.Background())
+	if err != nil || got != "Bearer "+first {
+		t.Fatalf("Authorization = %q, %v", got, err)
+	}
+	second := testJWT(time.Now().Add(time.Hour))
+	if err := os.WriteFile(path, []byte(second), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := src.Authorization(context.Background()); got != "Bearer "+second {
+		t.Fatalf("expected the rotated token, got %q", got)
+	}
+}
+
+func TestParseHelperOutput(t *testing.T) {
+	auth, exp, err := parseHelperOutput([]byte(`{"token": "t1", "token_type": "Bearer", "expires_in": 600}`))
+	if err != nil || auth != "Bearer t1" || time.Until(exp) < 9*time.Minute {
+		t.Fatalf("JSON output: %q, %s, %v", auth, exp, err)
+	}
+	auth, exp, err = parseHelperOutput([]byte("plain-token\n"))
+	if err != nil || auth != "Token plain-token" || time.Until(exp) > credentialHelperTTL {
+		t.Fatalf("raw output: %q, %s, %v", auth, exp, err)
+	}
+	if _, _, err := parseHelperOutput([]byte(`{"expires_in": 5}`)); err == nil {
+		t.Fatal("expected an error for output without a token")
+	}
+}
+
+func TestCredentialHelper_RunsCommand(t *testing.T) {
+	src := NewCredentialHelper([]string{"sh", "-c", `echo '{"token": "from-helper"}'`})
+	got, err := src.Authorization(context.Background())
+	if err != nil || got != "Token from-helper" {
+		t.Fatalf("Authorization = %q, %v", got, err)
+	}
+
+	failing := NewCredentialHelper([]string{"sh", "-c", "echo denied >&2; exit 3"})
+	if _, err := failing.Authorization(context.Background()); err == nil || !strings.Contains(err.Error(), "denied") {
+		t.Fatalf("expected helper stderr in the error, got %v", err)
+	}
+}
+
+func TestOAuthCredentials_ExchangesAndCaches(t *testing.T) {
+	var exchanges atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		if r.Form.Get("grant_type") != "client_credentials" || r.Form.Get("client_id") != "cid" {
+			t.Errorf("unexpected form: %v", r.Form)
+		}
+		if r.Form.Get("client_assertion_type") != jwtBearerAssertionType || r.Form.Get("client_assertion") != "oidc-jwt" {
+			t.Errorf("expected the OIDC token as client assertion, got %v", r.Form)
+		}
+		n := exchanges.Add(1)
+		_, _ = fmt.Fprintf(w, `{"access_token": "at-%d", "token_type": "bearer", "expires_in": 3600}`, n)
+	}))
+	defer srv.Close()
+
+	src := NewOAuthCredentials(srv.Client(), OAuthConfig{TokenURL: srv.URL, ClientID: "cid", ClientAssertion: "oidc-jwt"})
+	for i := 0; i < 3; i++ {
+		if got, err := src.Authorization(context.Background()); err != nil || got != "Bearer at-1" {
+			t.Fatalf("Authorization = %q, %v", got, err)
+		}
+	}
+	src.Invalidate()
+	if got, _ := src.Authorization(context.Background()); got != "Bearer at-2" {
+		t.Fatalf("expected a fresh token after Invalidate, got %q", got)
+	}
+}
+
+func TestOAuthCredentials_ReportsTokenEndpointError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"error": "invalid_client", "error_description": "unknown client"}`))
+	}))
+	defer srv.Close()
+
+	src := NewOAuthCredentials(srv.Client(), OAuthConfig{TokenURL: srv.URL, ClientID: "cid", ClientSecret: "s"})
+	if _, err := src.Authorization(context.Background()); err == nil || !strings.Contains(err.Error(), "unknown client") {
+		t.Fatalf("expected the token endpoint's error description, got %v", err)
+	}
+}
+
+// rotatingCredentials hands out a new token after each Invalidate.
+type rotatingCredentials struct{ n atomic.Int32 }
+
+func (r *rotatingCredentials) Authorization(context.Context) (string, error) {
+	return fmt.Sprintf("Bearer t%d", r.n.Load()), nil
+}
+
+func (r *rotatingCredentials) Invalidate() bool {
+	r.n.Add(1)
+	return true
+}
+
+func TestAPIClient_RefreshesCredentialsOnUnauthorized(t *testing.T) {
+	var seen []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = append(seen, r.Header.Get("authorization"))
+		if r.Header.Get("authorization") != "Bearer t1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	c := &APIClient{APIEndpoint: srv.URL, HTTPClient: srv.Client(), Credentials: &rotatingCredentials{}}
+	if _, err := c.Get("/api/x"); err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if strings.Join(seen, ",") != "Bearer t0,Bearer t1" {
+		t.Fatalf("expected one refresh after the 401, got %v", seen)
+	}
+}
@@ -0,0 +1,44 @@
+package api_client
+
+import "context"
+
+const CriblServiceName = "cribl"
+
+// CriblConfig mirrors the "config" block of the cribl external_service/config payload: a Cribl
+// Stream HTTP source URL and the auth token configured on that source.
+type CriblConfig struct {
+	URL                 string `json:"url,omitempty"`
+	Token               string `json:"token,omitempty"`
+	AllowSelfSignedCert bool   `json:"allow_self_signed_cert"`
+}
+
+type CriblExternalServiceConfig = ConfigEnvelope[CriblConfig]
+
+func (client *APIClient) CreateCriblConfig(ctx context.Context, payload CriblExternalServiceConfig) (*CriblExternalServiceConfig, error) {
+	return CreateExternalServiceConfig[CriblConfig](ctx, client, CriblServiceName, payload)
+}
+
+func (client *APIClient) GetCriblConfig(ctx context.Context, templateName string) (*CriblExternalServiceConfig, error) {
+	return GetExternalServiceConfig[CriblConfig](ctx, client, CriblServiceName, templateName, nil)
+}
+
+func (client *APIClient) ListCriblConfigs(ctx context.Context) ([]CriblExternalServiceConfig, error) {
+	return ListExternalServiceConfigs[CriblConfig](ctx, client, CriblServiceName, nil)
+}
+
+func (client *APIClient) UpdateCriblConfig(ctx context.Context, templateName string, payload CriblExternalServiceConfig) (*CriblExternalServiceConfig, error) {
+	cfg := map[string]interface{}{
+		"allow_self_signed_cert": payload.Config.AllowSelfSignedCert,
+	}
+	if payload.Config.URL != "" {
+		cfg["url"] = payload.Config.URL
+	}
+	if payload.Config.Token != "" {
+		cfg["token"] = payload.Config.Token
+	}
+	return UpdateExternalServiceConfig[CriblConfig](ctx, client, CriblServiceName, templateName, BuildUpdateBody(payload, cfg, false))
+}
+
+func (client *APIClient) DeleteCriblConfig(ctx context.Context, templateName string) error {
+	return DeleteExternalServiceConfig(ctx, client, CriblServiceName, templateName)
+}
@@ -1,6 +1,7 @@
 package api_client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -58,8 +59,8 @@ type customComplianceFrameworkReadAPIResponse struct {
 
 const customComplianceFrameworkBasePath = "/api/compliance/frameworks"
 
-func (client *APIClient) GetCustomComplianceFramework(id string) (*CustomComplianceFrameworkReadResponse, error) {
-	resp, err := client.Get(fmt.Sprintf(customComplianceFrameworkBasePath+"/%s", id))
+func (client *APIClient) GetCustomComplianceFramework(ctx context.Context, id string) (*CustomComplianceFrameworkReadResponse, error) {
+	resp, err := client.GetContext(ctx, fmt.Sprintf(customComplianceFrameworkBasePath+"/%s", id))
 	if resp.StatusCode() == 400 || resp.StatusCode() == 500 {
 		return nil, nil
 	}
@@ -75,8 +76,8 @@ func (client *APIClient) GetCustomComplianceFramework(id string) (*CustomComplia
 	return &response.Data, nil
 }
 
-func (client *APIClient) CreateCustomComplianceFramework(data CustomComplianceFrameworkCreateRequest) (*CustomComplianceFrameworkWriteResponse, error) {
-	resp, err := client.Post(customComplianceFrameworkBasePath, data)
+func (client *APIClient) CreateCustomComplianceFramework(ctx context.Context, data CustomComplianceFrameworkCreateRequest) (*CustomComplianceFrameworkWriteResponse, error) {
+	resp, err := client.PostContext(ctx, customComplianceFrameworkBasePath, data)
 	if err != nil {
 		return nil, err
 	}
@@ -89,8 +90,8 @@ func (client *APIClient) CreateCustomComplianceFramework(data CustomComplianceFr
 	return &response.Data, nil
 }
 
-func (client *APIClient) UpdateCustomComplianceFramework(id string, data CustomComplianceFrameworkUpdateRequest) (*CustomComplianceFrameworkWriteResponse, error) {
-	resp, err := client.Put(fmt.Sprintf(customComplianceFrameworkBasePath+"/%s", id), data)
+func (client *APIClient) UpdateCustomComplianceFramework(ctx context.Context, id string, data CustomComplianceFrameworkUpdateRequest) (*CustomComplianceFrameworkWriteResponse, error) {
+	resp, err := client.PutContext(ctx, fmt.Sprintf(customComplianceFrameworkBasePath+"/%s", id), data)
 	if err != nil {
 		return nil, err
 	}
@@ -103,7 +104,7 @@ func (client *APIClient) UpdateCustomComplianceFramework(id string, data CustomC
 	return &response.Data, nil
 }
 
-func (client *APIClient) DeleteCustomComplianceFramework(id string) error {
-	_, err := client.Delete(fmt.Sprintf(customComplianceFrameworkBasePath+"/%s", id))
+func (client *APIClient) DeleteCustomComplianceFramework(ctx context.Context, id string) error {
+	_, err := client.DeleteContext(ctx, fmt.Sprintf(customComplianceFrameworkBasePath+"/%s", id))
 	return err
 }
@@ -1,6 +1,7 @@
 package api_client
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -31,13 +32,13 @@ type customDashboardAPIResponseType struct {
 	Data CustomDashboard `json:"data"`
 }
 
-func (client *APIClient) DoesCustomDashboardExist(id string) (bool, error) {
-	resp, _ := client.Head(fmt.Sprintf("/api/user_preferences/%s", id))
+func (client *APIClient) DoesCustomDashboardExist(ctx context.Context, id string) (bool, error) {
+	resp, _ := client.HeadContext(ctx, fmt.Sprintf("/api/user_preferences/%s", id))
 	return resp.StatusCode() == 200, nil
 }
 
-func (client *APIClient) GetCustomDashboard(id string) (*CustomDashboard, error) {
-	resp, err := client.Get(fmt.Sprintf("/api/user_preferences/%s", id))
+func (client *APIClient) GetCustomDashboard(ctx context.Context, id string) (*CustomDashboard, error) {
+	resp, err := client.GetContext(ctx, fmt.Sprintf("/api/user_preferences/%s", id))
 	if resp.StatusCode() == 400 || resp.StatusCode() == 500 {
 		return nil, nil
 	}
@@ -55,8 +56,8 @@ func (client *APIClient) GetCustomDashboard(id string) (*CustomDashboard, error)
 	return &customDashboard, nil
 }
 
-func (client *APIClient) CreateCustomDashboard(data CustomDashboard) (*CustomDashboard, error) {
-	resp, err := client.Post("/api/user_preferences", data)
+func (client *APIClient) CreateCustomDashboard(ctx context.Context, data CustomDashboard) (*CustomDashboard, error) {
+	resp, err := client.PostContext(ctx, "/api/user_preferences", data)
 	if err != nil {
 		return nil, err
 	}
@@ -70,8 +71,8 @@ func (client *APIClient) CreateCustomDashboard(data CustomDashboard) (*CustomDas
 	return &response.Data, nil
 }
 
-func (client *APIClient) UpdateCustomDashboard(data CustomDashboard) (*CustomDashboard, error) {
-	resp, err := client.Put(fmt.Sprintf("/api/user_preferences/%s", data.ID), data)
+func (client *APIClient) UpdateCustomDashboard(ctx context.Context, data CustomDashboard) (*CustomDashboard, error) {
+	resp, err := client.PutContext(ctx, fmt.Sprintf("/api/user_preferences/%s", data.ID), data)
 	if err != nil {
 		return nil, err
 	}
@@ -84,7 +85,7 @@ func (client *APIClient) UpdateCustomDashboard(data CustomDashboard) (*CustomDas
 	return &response.Data, nil
 }
 
-func (client *APIClient) DeleteCustomDashboard(id string) error {
-	_, err := client.Delete(fmt.Sprintf("/api/user_preferences/%s", id))
+func (client *APIClient) DeleteCustomDashboard(ctx context.Context, id string) error {
+	_, err := client.DeleteContext(ctx, fmt.Sprintf("/api/user_preferences/%s", id))
 	return err
 }
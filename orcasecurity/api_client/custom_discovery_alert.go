@@ -1,10 +1,8 @@
 package api_client
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
-	"net/http"
-	"strings"
 )
 
 type customDiscoveryAlertAPIResponseType struct {
@@ -40,27 +38,24 @@ type CustomDiscoveryAlertRemediationText struct {
 	Text      string `json:"custom_text"`
 }
 
-func (client *APIClient) DoesCustomDiscoveryAlertExist(id string) (bool, error) {
-	resp, err := client.Head(fmt.Sprintf("/api/sonar/rules/%s", id))
-	if resp.StatusCode() == 404 || resp.StatusCode() == 500 {
+func (client *APIClient) DoesCustomDiscoveryAlertExist(ctx context.Context, id string) (bool, error) {
+	// resp is nil when the request never completed (cancelled, deadline exceeded, auth
+	// failure), so look only at err. A 5xx is reported rather than read as a deletion.
+	_, err := client.HeadContext(ctx, fmt.Sprintf("/api/sonar/rules/%s", id))
+	if IsNotFound(err) {
 		return false, nil
 	}
-
-	// some other error
 	if err != nil {
 		return false, err
 	}
 	return true, nil
 }
 
-func (client *APIClient) GetCustomDiscoveryAlert(id string) (*CustomDiscoveryAlert, error) {
+func (client *APIClient) GetCustomDiscoveryAlert(ctx context.Context, id string) (*CustomDiscoveryAlert, error) {
 	type responseType struct {
 		Data CustomDiscoveryAlert `json:"data"`
 	}
-	resp, err := client.Get(fmt.Sprintf("/api/sonar/rules/%s", id))
-	if resp.StatusCode() == 400 || resp.StatusCode() == 500 {
-		return nil, nil
-	}
+	resp, err := client.GetContext(ctx, fmt.Sprintf("/api/sonar/rules/%s", id))
 	if err != nil {
 		return nil, err
 	}
@@ -72,9 +67,9 @@ func (client *APIClient) GetCustomDiscoveryAlert(id string) (*CustomDiscoveryAle
 	alert := response.Data
 
 	// add remediation value
-	remediation, err := client.GetCustomDiscoveryAlertRemediationText(alert.RuleType)
+	remediation, err := client.GetCustomDiscoveryAlertRemediationText(ctx, alert.RuleType)
 	if err != nil {
-		return nil, fmt.Errorf("error fetching remediation text: %s", err.Error())
+		return nil, fmt.Errorf("error fetching remediation text: %w", err)
 	}
 	alert.RemediationText = &CustomDiscoveryAlertRemediationText{
 		AlertType: remediation.AlertType,
@@ -85,8 +80,8 @@ func (client *APIClient) GetCustomDiscoveryAlert(id string) (*CustomDiscoveryAle
 	return &alert, nil
 }
 
-func (client *APIClient) CreateCustomDiscoveryAlert(data CustomDiscoveryAlert) (*CustomDiscoveryAlert, error) {
-	resp, err := client.Post("/api/sonar/rules", data)
+func (client *APIClient) CreateCustomDiscoveryAlert(ctx context.Context, data CustomDiscoveryAlert) (*CustomDiscoveryAlert, error) {
+	resp, err := client.PostContext(ctx, "/api/sonar/rules", data)
 	if err != nil {
 		return nil, err
 	}
@@ -101,16 +96,16 @@ func (client *APIClient) CreateCustomDiscoveryAlert(data CustomDiscoveryAlert) (
 	// set remediation
 	if data.RemediationText != nil {
 		data.RemediationText.AlertType = alert.RuleType
-		if err = client.SetCustomRemediationText(*data.RemediationText); err != nil {
-			return nil, fmt.Errorf("remediation text create failed: %s", err.Error())
+		if err = client.SetCustomRemediationText(ctx, *data.RemediationText); err != nil {
+			return nil, fmt.Errorf("remediation text create failed: %w", err)
 		}
 	}
 
 	return alert, nil
 }
 
-func (client *APIClient) UpdateCustomDiscoveryAlert(id string, data CustomDiscoveryAlert) (*CustomDiscoveryAlert, error) {
-	resp, err := client.Put(fmt.Sprintf("/api/sonar/rules/%s", id), data)
+func (client *APIClient) UpdateCustomDiscoveryAlert(ctx context.Context, id string, data CustomDiscoveryAlert) (*CustomDiscoveryAlert, error) {
+	resp, err := client.PutContext(ctx, fmt.Sprintf("/api/sonar/rules/%s", id), data)
 	if err != nil {
 		return nil, err
 	}
@@ -122,27 +117,27 @@ func (client *APIClient) UpdateCustomDiscoveryAlert(id string, data CustomDiscov
 
 	// update remediation
 	if data.RemediationText == nil {
-		if err = client.DeleteCustomRemediationText(CustomDiscoveryAlertRemediationText{
+		if err = client.DeleteCustomRemediationText(ctx, CustomDiscoveryAlertRemediationText{
 			AlertType: data.RuleType,
 		}); err != nil {
-			return nil, fmt.Errorf("remediation text delete failed: %s", err.Error())
+			return nil, fmt.Errorf("remediation text delete failed: %w", err)
 		}
 	} else {
-		if err = client.SetCustomRemediationText(*data.RemediationText); err != nil {
-			return nil, fmt.Errorf("remediation text update failed: %s", err.Error())
+		if err = client.SetCustomRemediationText(ctx, *data.RemediationText); err != nil {
+			return nil, fmt.Errorf("remediation text update failed: %w", err)
 		}
 	}
 
 	return &response.Data, err
 }
-func (client *APIClient) DeleteCustomDiscoveryAlert(id string) error {
-	_, err := client.Delete(fmt.Sprintf("/api/sonar/rules/%s", id))
+func (client *APIClient) DeleteCustomDiscoveryAlert(ctx context.Context, id string) error {
+	_, err := client.DeleteContext(ctx, fmt.Sprintf("/api/sonar/rules/%s", id))
 	return err
 }
 
-func (client *APIClient) GetCustomDiscoveryAlertRemediationText(ruleType string) (*CustomDiscoveryAlertRemediationText, error) {
-	resp, err := client.Get(fmt.Sprintf("/api/alerts/custom_remediation_text?alert_type=%s", ruleType))
-	if resp.StatusCode() == 404 {
+func (client *APIClient) GetCustomDiscoveryAlertRemediationText(ctx context.Context, ruleType string) (*CustomDiscoveryAlertRemediationText, error) {
+	resp, err := client.GetContext(ctx, fmt.Sprintf("/api/alerts/custom_remediation_text?alert_type=%s", ruleType))
+	if IsNotFound(err) {
 		return &CustomDiscoveryAlertRemediationText{}, nil
 	}
 
@@ -157,49 +152,26 @@ func (client *APIClient) GetCustomDiscoveryAlertRemediationText(ruleType string)
 	return &response, err
 }
 
-func (client *APIClient) SetCustomRemediationText(data CustomDiscoveryAlertRemediationText) error {
-	resp, err := client.Put("/api/alerts/custom_remediation_text", data)
-	if resp.StatusCode() == 404 {
-		_, err = client.Post("/api/alerts/custom_remediation_text", data)
+func (client *APIClient) SetCustomRemediationText(ctx context.Context, data CustomDiscoveryAlertRemediationText) error {
+	resp, err := client.PutContext(ctx, "/api/alerts/custom_remediation_text", data)
+	if resp != nil && resp.StatusCode() == 404 {
+		_, err = client.PostContext(ctx, "/api/alerts/custom_remediation_text", data)
 	}
 	return err
 }
-func (client *APIClient) DeleteCustomRemediationText(data CustomDiscoveryAlertRemediationText) error {
-	payload, err := json.Marshal(&data)
-	if err != nil {
-		return err
-	}
-
-	req, err := http.NewRequest(
-		"DELETE",
-		fmt.Sprintf("%s/api/alerts/custom_remediation_text", client.APIEndpoint),
-		strings.NewReader(string(payload)),
-	)
-	if err != nil {
-		return err
-	}
-
-	resp, err := client.roundTripWithRetry(*req)
-	if err != nil {
-		return err
-	}
-
-	if resp.StatusCode() == 404 {
+func (client *APIClient) DeleteCustomRemediationText(ctx context.Context, data CustomDiscoveryAlertRemediationText) error {
+	_, err := client.DeleteWithBodyContext(ctx, "/api/alerts/custom_remediation_text", data)
+	if IsNotFound(err) {
 		return nil
 	}
-
-	if !resp.IsOk() {
-		return fmt.Errorf("operation failed")
-	}
-
-	return nil
+	return err
 }
 
-func (client *APIClient) GetAlertCategories() ([]string, error) {
+func (client *APIClient) GetAlertCategories(ctx context.Context) ([]string, error) {
 	type responseType struct {
 		Data []string `json:"data"`
 	}
-	res, err := client.Get("/api/alerts/catalog/category")
+	res, err := client.GetContext(ctx, "/api/alerts/catalog/category")
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,7 @@
 package api_client
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -23,13 +24,13 @@ type customRoleAPIResponseType struct {
 	Data CustomRole `json:"data"`
 }
 
-func (client *APIClient) DoesCustomRoleExist(id string) (bool, error) {
-	resp, _ := client.Head(fmt.Sprintf("/api/rbac/roles/%s", id))
+func (client *APIClient) DoesCustomRoleExist(ctx context.Context, id string) (bool, error) {
+	resp, _ := client.HeadContext(ctx, fmt.Sprintf("/api/rbac/roles/%s", id))
 	return resp.StatusCode() == 200, nil
 }
 
-func (client *APIClient) GetCustomRole(id string) (*CustomRole, error) {
-	resp, err := client.Get(fmt.Sprintf("/api/rbac/roles/%s", id))
+func (client *APIClient) GetCustomRole(ctx context.Context, id string) (*CustomRole, error) {
+	resp, err := client.GetContext(ctx, fmt.Sprintf("/api/rbac/roles/%s", id))
 	if resp.StatusCode() == 400 || resp.StatusCode() == 500 {
 		return nil, nil
 	}
@@ -47,8 +48,8 @@ func (client *APIClient) GetCustomRole(id string) (*CustomRole, error) {
 	return &customRole, nil
 }
 
-func (client *APIClient) CreateCustomRole(data CustomRole) (*CustomRole, error) {
-	resp, err := client.Post("/api/rbac/roles", data)
+func (client *APIClient) CreateCustomRole(ctx context.Context, data CustomRole) (*CustomRole, error) {
+	resp, err := client.PostContext(ctx, "/api/rbac/roles", data)
 	if err != nil {
 		return nil, err
 	}
@@ -61,8 +62,8 @@ func (client *APIClient) CreateCustomRole(data CustomRole) (*CustomRole, error)
 	return &response.Data, nil
 }
 
-func (client *APIClient) UpdateCustomRole(data CustomRole) (*CustomRole, error) {
-	resp, err := client.Put(fmt.Sprintf("/api/rbac/roles/%s", data.ID), data)
+func (client *APIClient) UpdateCustomRole(ctx context.Context, data CustomRole) (*CustomRole, error) {
+	resp, err := client.PutContext(ctx, fmt.Sprintf("/api/rbac/roles/%s", data.ID), data)
 	if err != nil {
 		return nil, err
 	}
@@ -75,7 +76,7 @@ func (client *APIClient) UpdateCustomRole(data CustomRole) (*CustomRole, error)
 	return &response.Data, nil
 }
 
-func (client *APIClient) DeleteCustomRole(id string) error {
-	_, err := client.Delete(fmt.Sprintf("/api/rbac/roles/%s", id))
+func (client *APIClient) DeleteCustomRole(ctx context.Context, id string) error {
+	_, err := client.DeleteContext(ctx, fmt.Sprintf("/api/rbac/roles/%s", id))
 	return err
 }
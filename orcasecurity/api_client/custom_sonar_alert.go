@@ -1,10 +1,8 @@
 package api_client
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
-	"net/http"
-	"strings"
 )
 
 type CustomSonarAlertComplianceFramework struct {
@@ -36,27 +34,24 @@ type CustomAlert struct {
 	RemediationText      *CustomSonarAlertRemediationText      // managed in a separate API call
 }
 
-func (client *APIClient) DoesCustomSonarAlertExist(id string) (bool, error) {
-	resp, err := client.Head(fmt.Sprintf("/api/sonar/rules/%s", id))
-	if resp.StatusCode() == 404 || resp.StatusCode() == 500 {
+func (client *APIClient) DoesCustomSonarAlertExist(ctx context.Context, id string) (bool, error) {
+	// resp is nil when the request never completed (cancelled, deadline exceeded, auth
+	// failure), so look only at err. A 5xx is reported rather than read as a deletion.
+	_, err := client.HeadContext(ctx, fmt.Sprintf("/api/sonar/rules/%s", id))
+	if IsNotFound(err) {
 		return false, nil
 	}
-
-	// some other error
 	if err != nil {
 		return false, err
 	}
 	return true, nil
 }
 
-func (client *APIClient) GetCustomSonarAlert(id string) (*CustomAlert, error) {
+func (client *APIClient) GetCustomSonarAlert(ctx context.Context, id string) (*CustomAlert, error) {
 	type responseType struct {
 		Data CustomAlert `json:"data"`
 	}
-	resp, err := client.Get(fmt.Sprintf("/api/sonar/rules/%s", id))
-	if resp.StatusCode() == 400 || resp.StatusCode() == 500 {
-		return nil, nil
-	}
+	resp, err := client.GetContext(ctx, fmt.Sprintf("/api/sonar/rules/%s", id))
 	if err != nil {
 		return nil, err
 	}
@@ -68,9 +63,9 @@ func (client *APIClient) GetCustomSonarAlert(id string) (*CustomAlert, error) {
 	alert := response.Data
 
 	// add remediation value
-	remediation, err := client.GetCustomSonarAlertRemediationText(alert.RuleType)
+	remediation, err := client.GetCustomSonarAlertRemediationText(ctx, alert.RuleType)
 	if err != nil {
-		return nil, fmt.Errorf("error fetching remediation text: %s", err.Error())
+		return nil, fmt.Errorf("error fetching remediation text: %w", err)
 	}
 	alert.RemediationText = &CustomSonarAlertRemediationText{
 		AlertType: remediation.AlertType,
@@ -81,12 +76,37 @@ func (client *APIClient) GetCustomSonarAlert(id string) (*CustomAlert, error) {
 	return &alert, nil
 }
 
-func (client *APIClient) CreateCustomSonarAlert(data CustomAlert) (*CustomAlert, error) {
+// ListCustomSonarAlerts returns the organization's custom Sonar alerts (GET
+// /api/sonar/rules?custom=true). Rules the server reports as built-in are dropped in case the
+// filter is ignored. Remediation text lives behind a separate per-alert call and is left nil.
+func (client *APIClient) ListCustomSonarAlerts(ctx context.Context) ([]CustomAlert, error) {
+	resp, err := client.GetContext(ctx, "/api/sonar/rules?custom=true")
+	if err != nil {
+		return nil, err
+	}
+	type listedAlert struct {
+		CustomAlert
+		Custom bool `json:"custom"`
+	}
+	listed, err := readData[[]listedAlert](resp)
+	if err != nil {
+		return nil, fmt.Errorf("parse custom sonar alert list: %w", err)
+	}
+	alerts := make([]CustomAlert, 0, len(*listed))
+	for _, a := range *listed {
+		if a.Custom {
+			alerts = append(alerts, a.CustomAlert)
+		}
+	}
+	return alerts, nil
+}
+
+func (client *APIClient) CreateCustomSonarAlert(ctx context.Context, data CustomAlert) (*CustomAlert, error) {
 	type responseType struct {
 		Data CustomAlert `json:"data"`
 	}
 
-	resp, err := client.Post("/api/sonar/rules", data)
+	resp, err := client.PostContext(ctx, "/api/sonar/rules", data)
 	if err != nil {
 		return nil, err
 	}
@@ -101,19 +121,19 @@ func (client *APIClient) CreateCustomSonarAlert(data CustomAlert) (*CustomAlert,
 	// set remediation
 	if data.RemediationText != nil {
 		data.RemediationText.AlertType = alert.RuleType
-		if err = client.SetCustomSonarAlertRemediationText(*data.RemediationText); err != nil {
-			return nil, fmt.Errorf("remediation text create failed: %s", err.Error())
+		if err = client.SetCustomSonarAlertRemediationText(ctx, *data.RemediationText); err != nil {
+			return nil, fmt.Errorf("remediation text create failed: %w", err)
 		}
 	}
 
 	return alert, nil
 }
 
-func (client *APIClient) UpdateCustomSonarAlert(id string, data CustomAlert) (*CustomAlert, error) {
+func (client *APIClient) UpdateCustomSonarAlert(ctx context.Context, id string, data CustomAlert) (*CustomAlert, error) {
 	type responseType struct {
 		Data CustomAlert `json:"data"`
 	}
-	resp, err := client.Put(fmt.Sprintf("/api/sonar/rules/%s", id), data)
+	resp, err := client.PutContext(ctx, fmt.Sprintf("/api/sonar/rules/%s", id), data)
 	if err != nil {
 		return nil, err
 	}
@@ -125,27 +145,28 @@ func (client *APIClient) UpdateCustomSonarAlert(id string, data CustomAlert) (*C
 
 	// update remediation
 	if data.RemediationText == nil {
-		if err = client.DeleteCustomSonarAlertRemediationText(CustomSonarAlertRemediationText{
+		if err = client.DeleteCustomSonarAlertRemediationText(ctx, CustomSonarAlertRemediationText{
 			AlertType: data.RuleType,
 		}); err != nil {
-			return nil, fmt.Errorf("remediation text delete failed: %s", err.Error())
+			return nil, fmt.Errorf("remediation text delete failed: %w", err)
 		}
 	} else {
-		if err = client.SetCustomSonarAlertRemediationText(*data.RemediationText); err != nil {
-			return nil, fmt.Errorf("remediation text update failed: %s", err.Error())
+		if err = client.SetCustomSonarAlertRemediationText(ctx, *data.RemediationText); err != nil {
+			return nil, fmt.Errorf("remediation text update failed: %w", err)
 		}
 	}
 
 	return &response.Data, err
 }
-func (client *APIClient) DeleteCustomSonarAlert(id string) error {
-	_, err := client.Delete(fmt.Sprintf("/api/sonar/rules/%s", id))
+
+func (client *APIClient) DeleteCustomSonarAlert(ctx context.Context, id string) error {
+	_, err := client.DeleteContext(ctx, fmt.Sprintf("/api/sonar/rules/%s", id))
 	return err
 }
 
-func (client *APIClient) GetCustomSonarAlertRemediationText(ruleType string) (*CustomSonarAlertRemediationText, error) {
-	resp, err := client.Get(fmt.Sprintf("/api/alerts/custom_remediation_text?alert_type=%s", ruleType))
-	if resp.StatusCode() == 404 {
+func (client *APIClient) GetCustomSonarAlertRemediationText(ctx context.Context, ruleType string) (*CustomSonarAlertRemediationText, error) {
+	resp, err := client.GetContext(ctx, fmt.Sprintf("/api/alerts/custom_remediation_text?alert_type=%s", ruleType))
+	if IsNotFound(err) {
 		return &CustomSonarAlertRemediationText{}, nil
 	}
 
@@ -160,49 +181,26 @@ func (client *APIClient) GetCustomSonarAlertRemediationText(ruleType string) (*C
 	return &response, err
 }
 
-func (client *APIClient) SetCustomSonarAlertRemediationText(data CustomSonarAlertRemediationText) error {
-	resp, err := client.Put("/api/alerts/custom_remediation_text", data)
-	if resp.StatusCode() == 404 {
-		_, err = client.Post("/api/alerts/custom_remediation_text", data)
+func (client *APIClient) SetCustomSonarAlertRemediationText(ctx context.Context, data CustomSonarAlertRemediationText) error {
+	resp, err := client.PutContext(ctx, "/api/alerts/custom_remediation_text", data)
+	if resp != nil && resp.StatusCode() == 404 {
+		_, err = client.PostContext(ctx, "/api/alerts/custom_remediation_text", data)
 	}
 	return err
 }
-func (client *APIClient) DeleteCustomSonarAlertRemediationText(data CustomSonarAlertRemediationText) error {
-	payload, err := json.Marshal(&data)
-	if err != nil {
-		return err
-	}
-
-	req, err := http.NewRequest(
-		"DELETE",
-		fmt.Sprintf("%s/api/alerts/custom_remediation_text", client.APIEndpoint),
-		strings.NewReader(string(payload)),
-	)
-	if err != nil {
-		return err
-	}
-
-	resp, err := client.roundTripWithRetry(*req)
-	if err != nil {
-		return err
-	}
-
-	if resp.StatusCode() == 404 {
+func (client *APIClient) DeleteCustomSonarAlertRemediationText(ctx context.Context, data CustomSonarAlertRemediationText) error {
+	_, err := client.DeleteWithBodyContext(ctx, "/api/alerts/custom_remediation_text", data)
+	if IsNotFound(err) {
 		return nil
 	}
-
-	if !resp.IsOk() {
-		return fmt.Errorf("operation failed")
-	}
-
-	return nil
+	return err
 }
 
-func (client *APIClient) GetSonarAlertCategories() ([]string, error) {
+func (client *APIClient) GetSonarAlertCategories(ctx context.Context) ([]string, error) {
 	type responseType struct {
 		Data []string `json:"data"`
 	}
-	res, err := client.Get("/api/alerts/catalog/category")
+	res, err := client.GetContext(ctx, "/api/alerts/catalog/category")
 	if err != nil {
 		return nil, err
 	}
@@ -1,8 +1,11 @@
 package api_client_test
 
 import (
+	"context"
+	"errors"
 	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
 	"strings"
 	"terraform-provider-orcasecurity/orcasecurity/api_client"
 	"testing"
@@ -18,7 +21,7 @@ func TestCustomAlert_DoesCustomSonarAlertExist(t *testing.T) {
 	})}
 
 	apiClient := api_client.APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	exists, err := apiClient.DoesCustomSonarAlertExist("1")
+	exists, err := apiClient.DoesCustomSonarAlertExist(context.Background(), "1")
 	if err != nil {
 		t.Error(err)
 	}
@@ -37,7 +40,7 @@ func TestAutomations_DoesCustomSonarAlertExist404(t *testing.T) {
 	})}
 
 	apiClient := api_client.APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	exists, err := apiClient.DoesCustomSonarAlertExist("1")
+	exists, err := apiClient.DoesCustomSonarAlertExist(context.Background(), "1")
 	if err != nil {
 		t.Error(err)
 	}
@@ -47,6 +50,8 @@ func TestAutomations_DoesCustomSonarAlertExist404(t *testing.T) {
 
 }
 
+// A server error is reported rather than read as a deletion, which would silently drop the
+// alert from state.
 func TestAutomations_DoesCustomSonarAlertExist500(t *testing.T) {
 	httpClient := &http.Client{Transport: api_client.RoundTripFunc(func(req *http.Request) *http.Response {
 		return &http.Response{
@@ -56,13 +61,69 @@ func TestAutomations_DoesCustomSonarAlertExist500(t *testing.T) {
 		}
 	})}
 
-	apiClient := api_client.APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	exists, err := apiClient.DoesCustomSonarAlertExist("1")
-	if err != nil {
-		t.Error(err)
+	apiClient := api_client.APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient, MaxRetryAttempts: 1}
+	exists, err := apiClient.DoesCustomSonarAlertExist(context.Background(), "1")
+	if err == nil {
+		t.Error("expected an error for a 500 response")
 	}
 	if exists {
-		t.Error("automation expected to be absent, but it exists")
+		t.Error("custom alert reported as existing on a 500 response")
 	}
+}
+
+// A request that never completes has no response; the existence checks must return the
+// error instead of dereferencing it.
+func TestCustomAlert_ExistCancelledContext(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request sent despite the cancelled context")
+	}))
+	defer server.Close()
+	apiClient := api_client.APIClient{APIEndpoint: server.URL, APIToken: "secret", HTTPClient: server.Client()}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
 
+	for name, exist := range map[string]func(context.Context, string) (bool, error){
+		"sonar":     apiClient.DoesCustomSonarAlertExist,
+		"discovery": apiClient.DoesCustomDiscoveryAlertExist,
+	} {
+		t.Run(name, func(t *testing.T) {
+			exists, err := exist(ctx, "1")
+			if !errors.Is(err, context.Canceled) {
+				t.Errorf("err = %v, want context.Canceled", err)
+			}
+			if exists {
+				t.Error("alert reported as existing")
+			}
+		})
+	}
+}
+
+func TestCustomSonarAlert_DeleteRemediationText(t *testing.T) {
+	type ctxKey struct{}
+	var gotMethod, gotBody string
+	var gotCtx context.Context
+	httpClient := &http.Client{Transport: api_client.RoundTripFunc(func(req *http.Request) *http.Response {
+		gotMethod = req.Method
+		gotCtx = req.Context()
+		body, _ := ioutil.ReadAll(req.Body)
+		gotBody = string(body)
+		return &http.Response{
+			StatusCode: 404,
+			Body:       ioutil.NopCloser(strings.NewReader(`{}`)),
+			Request:    req,
+		}
+	})}
+
+	apiClient := api_client.APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
+	ctx := context.WithValue(context.Background(), ctxKey{}, "caller")
+	err := apiClient.DeleteCustomSonarAlertRemediationText(ctx, api_client.CustomSonarAlertRemediationText{AlertType: "orca-custom-1"})
+	if err != nil {
+		t.Errorf("404 must be treated as already deleted, got %v", err)
+	}
+	if gotMethod != http.MethodDelete || !strings.Contains(gotBody, `"orca-custom-1"`) {
+		t.Errorf("sent %s %s", gotMethod, gotBody)
+	}
+	if gotCtx == nil || gotCtx.Value(ctxKey{}) != "caller" {
+		t.Error("request was not bound to the caller's context")
+	}
 }
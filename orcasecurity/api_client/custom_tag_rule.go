@@ -1,6 +1,7 @@
 package api_client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -90,12 +91,12 @@ func newCustomTagRuleRequest(data CustomTagRule) (*customTagRuleRequest, error)
 	return &request, nil
 }
 
-func (client *APIClient) GetCustomTagRule(id string) (*CustomTagRule, error) {
+func (client *APIClient) GetCustomTagRule(ctx context.Context, id string) (*CustomTagRule, error) {
 	type responseType struct {
 		Data customTagRuleResponse `json:"data"`
 	}
 
-	resp, err := client.Get(fmt.Sprintf(customTagRuleAPIPathTemplate, id))
+	resp, err := client.GetContext(ctx, fmt.Sprintf(customTagRuleAPIPathTemplate, id))
 	if resp != nil && resp.StatusCode() == 404 {
 		return nil, nil
 	}
@@ -110,7 +111,7 @@ func (client *APIClient) GetCustomTagRule(id string) (*CustomTagRule, error) {
 	return response.Data.toCustomTagRule()
 }
 
-func (client *APIClient) CreateCustomTagRule(data CustomTagRule) (*CustomTagRule, error) {
+func (client *APIClient) CreateCustomTagRule(ctx context.Context, data CustomTagRule) (*CustomTagRule, error) {
 	type responseDataType struct {
 		TagsRuleID string `json:"tags_rule_id"`
 	}
@@ -123,7 +124,7 @@ func (client *APIClient) CreateCustomTagRule(data CustomTagRule) (*CustomTagRule
 		return nil, err
 	}
 
-	resp, err := client.Post(customTagRulesAPIPath, request)
+	resp, err := client.PostContext(ctx, customTagRulesAPIPath, request)
 	if err != nil {
 		return nil, err
 	}
@@ -133,10 +134,10 @@ func (client *APIClient) CreateCustomTagRule(data CustomTagRule) (*CustomTagRule
 		return nil, err
 	}
 
-	return client.GetCustomTagRule(response.Data.TagsRuleID)
+	return client.GetCustomTagRule(ctx, response.Data.TagsRuleID)
 }
 
-func (client *APIClient) UpdateCustomTagRule(id string, data CustomTagRule) (*CustomTagRule, error) {
+func (client *APIClient) UpdateCustomTagRule(ctx context.Context, id string, data CustomTagRule) (*CustomTagRule, error) {
 	type responseType struct {
 		Data customTagRuleResponse `json:"data"`
 	}
@@ -146,7 +147,7 @@ func (client *APIClient) UpdateCustomTagRule(id string, data CustomTagRule) (*Cu
 		return nil, err
 	}
 
-	resp, err := client.Put(fmt.Sprintf(customTagRuleAPIPathTemplate, id), request)
+	resp, err := client.PutContext(ctx, fmt.Sprintf(customTagRuleAPIPathTemplate, id), request)
 	if err != nil {
 		return nil, err
 	}
@@ -158,7 +159,7 @@ func (client *APIClient) UpdateCustomTagRule(id string, data CustomTagRule) (*Cu
 	return response.Data.toCustomTagRule()
 }
 
-func (client *APIClient) DeleteCustomTagRule(id string) error {
-	_, err := client.Delete(fmt.Sprintf(customTagRuleAPIPathTemplate, id))
+func (client *APIClient) DeleteCustomTagRule(ctx context.Context, id string) error {
+	_, err := client.DeleteContext(ctx, fmt.Sprintf(customTagRuleAPIPathTemplate, id))
 	return err
 }
@@ -1,6 +1,7 @@
 package api_client_test
 
 import (
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -34,7 +35,7 @@ func TestCustomTagRule_GetStringRule(t *testing.T) {
 		}
 	})
 
-	rule, err := apiClient.GetCustomTagRule("rule-1")
+	rule, err := apiClient.GetCustomTagRule(context.Background(), "rule-1")
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -73,7 +74,7 @@ func TestCustomTagRule_GetJsonRule(t *testing.T) {
 		}
 	})
 
-	rule, err := apiClient.GetCustomTagRule("rule-2")
+	rule, err := apiClient.GetCustomTagRule(context.Background(), "rule-2")
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -99,7 +100,7 @@ func TestCustomTagRule_Get404(t *testing.T) {
 		}
 	})
 
-	rule, err := apiClient.GetCustomTagRule("missing")
+	rule, err := apiClient.GetCustomTagRule(context.Background(), "missing")
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -139,7 +140,7 @@ func TestCustomTagRule_CreateJsonRuleSendsRuleAsObject(t *testing.T) {
 		}
 	})
 
-	rule, err := apiClient.CreateCustomTagRule(api_client.CustomTagRule{
+	rule, err := apiClient.CreateCustomTagRule(context.Background(), api_client.CustomTagRule{
 		Name:     "my json rule",
 		Tags:     map[string]string{"exposure": "public"},
 		Rule:     `{"models": ["AwsEc2Instance"], "type": "object_set"}`,
@@ -193,7 +194,7 @@ func TestCustomTagRule_CreateStringRuleSendsRuleAsString(t *testing.T) {
 		}
 	})
 
-	_, err := apiClient.CreateCustomTagRule(api_client.CustomTagRule{
+	_, err := apiClient.CreateCustomTagRule(context.Background(), api_client.CustomTagRule{
 		Name:     "my rule",
 		Tags:     map[string]string{"exposure": "public"},
 		Rule:     "AwsEc2Instance with PublicIps",
@@ -223,7 +224,7 @@ func TestCustomTagRule_CreateInvalidJsonRuleFailsBeforeRequest(t *testing.T) {
 		}
 	})
 
-	_, err := apiClient.CreateCustomTagRule(api_client.CustomTagRule{
+	_, err := apiClient.CreateCustomTagRule(context.Background(), api_client.CustomTagRule{
 		Name:     "broken",
 		Tags:     map[string]string{"a": "b"},
 		Rule:     "this is not json",
@@ -256,7 +257,7 @@ func TestCustomTagRule_UpdateParsesJsonRuleResponse(t *testing.T) {
 		}
 	})
 
-	rule, err := apiClient.UpdateCustomTagRule("rule-1", api_client.CustomTagRule{
+	rule, err := apiClient.UpdateCustomTagRule(context.Background(), "rule-1", api_client.CustomTagRule{
 		Name:     "updated",
 		Tags:     map[string]string{"exposure": "public"},
 		Rule:     `{"models": ["Vm"], "type": "object_set"}`,
@@ -1,6 +1,7 @@
 package api_client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -101,13 +102,13 @@ type customWidgetAPIResponseType struct {
 	Data CustomWidget `json:"data"`
 }
 
-func (client *APIClient) DoesCustomWidgetExist(id string) (bool, error) {
-	resp, _ := client.Head(fmt.Sprintf("/api/user_preferences/%s", id))
+func (client *APIClient) DoesCustomWidgetExist(ctx context.Context, id string) (bool, error) {
+	resp, _ := client.HeadContext(ctx, fmt.Sprintf("/api/user_preferences/%s", id))
 	return resp.StatusCode() == 200, nil
 }
 
-func (client *APIClient) GetCustomWidget(id string) (*CustomWidget, error) {
-	resp, err := client.Get(fmt.Sprintf("/api/user_preferences/%s", id))
+func (client *APIClient) GetCustomWidget(ctx context.Context, id string) (*CustomWidget, error) {
+	resp, err := client.GetContext(ctx, fmt.Sprintf("/api/user_preferences/%s", id))
 	if resp.StatusCode() == 400 || resp.StatusCode() == 500 {
 		return nil, nil
 	}
@@ -131,8 +132,8 @@ func (client *APIClient) GetCustomWidget(id string) (*CustomWidget, error) {
 	}, nil
 }
 
-func (client *APIClient) CreateCustomWidget(data CustomWidget) (*CustomWidget, error) {
-	resp, err := client.Post("/api/user_preferences", data)
+func (client *APIClient) CreateCustomWidget(ctx context.Context, data CustomWidget) (*CustomWidget, error) {
+	resp, err := client.PostContext(ctx, "/api/user_preferences", data)
 	if err != nil {
 		return nil, err
 	}
@@ -152,8 +153,8 @@ func (client *APIClient) CreateCustomWidget(data CustomWidget) (*CustomWidget, e
 	}, nil
 }
 
-func (client *APIClient) UpdateCustomWidget(data CustomWidget) (*CustomWidget, error) {
-	resp, err := client.Put(fmt.Sprintf("/api/user_preferences/%s", data.ID), data)
+func (client *APIClient) UpdateCustomWidget(ctx context.Context, data CustomWidget) (*CustomWidget, error) {
+	resp, err := client.PutContext(ctx, fmt.Sprintf("/api/user_preferences/%s", data.ID), data)
 	if err != nil {
 		return nil, err
 	}
@@ -166,8 +167,8 @@ func (client *APIClient) UpdateCustomWidget(data CustomWidget) (*CustomWidget, e
 	return &response.Data, nil
 }
 
-func (client *APIClient) DeleteCustomWidget(id string) error {
-	_, err := client.Delete(fmt.Sprintf("/api/user_preferences/%s", id))
+func (client *APIClient) DeleteCustomWidget(ctx context.Context, id string) error {
+	_, err := client.DeleteContext(ctx, fmt.Sprintf("/api/user_preferences/%s", id))
 	return err
 }
 
@@ -186,8 +187,8 @@ type userPreferencesResponse struct {
 
 // ListCustomWidgets fetches custom widget IDs and names via GET /api/user_preferences?view_type=customs_widgets.
 // Returns both organization-level and user-level custom widgets.
-func (client *APIClient) ListCustomWidgets() ([]CustomWidgetSummary, error) {
-	resp, err := client.Get("/api/user_preferences?view_type=customs_widgets")
+func (client *APIClient) ListCustomWidgets(ctx context.Context) ([]CustomWidgetSummary, error) {
+	resp, err := client.GetContext(ctx, "/api/user_preferences?view_type=customs_widgets")
 	if err != nil {
 		return nil, err
 	}
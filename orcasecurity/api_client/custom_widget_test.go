@@ -1,6 +1,7 @@
 package api_client
 
 import (
+	"context"
 	"io/ioutil"
 	"net/http"
 	"strings"
@@ -33,7 +34,7 @@ func TestAPIClientListCustomWidgets(t *testing.T) {
 	})}
 
 	apiClient := APIClient{APIEndpoint: testAPIEndpoint, APIToken: "secret", HTTPClient: httpClient}
-	widgets, err := apiClient.ListCustomWidgets()
+	widgets, err := apiClient.ListCustomWidgets(context.Background())
 	if err != nil {
 		t.Fatalf(errMsgExpectedNoError, err)
 	}
@@ -77,7 +78,7 @@ func TestAPIClientListCustomWidgetsDeduplicates(t *testing.T) {
 	})}
 
 	apiClient := APIClient{APIEndpoint: testAPIEndpoint, APIToken: "secret", HTTPClient: httpClient}
-	widgets, err := apiClient.ListCustomWidgets()
+	widgets, err := apiClient.ListCustomWidgets(context.Background())
 	if err != nil {
 		t.Fatalf(errMsgExpectedNoError, err)
 	}
@@ -110,7 +111,7 @@ func TestAPIClientListCustomWidgetsEmpty(t *testing.T) {
 	})}
 
 	apiClient := APIClient{APIEndpoint: testAPIEndpoint, APIToken: "secret", HTTPClient: httpClient}
-	widgets, err := apiClient.ListCustomWidgets()
+	widgets, err := apiClient.ListCustomWidgets(context.Background())
 	if err != nil {
 		t.Fatalf(errMsgExpectedNoError, err)
 	}
@@ -1,6 +1,7 @@
 package api_client
 
 import (
+	"context"
 	"fmt"
 	"sync"
 )
@@ -53,8 +54,8 @@ type DataDetectionRule struct {
 // resource Read can RemoveResource on remote drift.
 // NOTE: unlike the list endpoint, retrieve responses carry the {status,data}
 // envelope.
-func (client *APIClient) GetDataDetectionRule(id string) (*DataDetectionRule, error) {
-	resp, err := client.Get(fmt.Sprintf("%s/%s", scanConfigRulesBasePath, id))
+func (client *APIClient) GetDataDetectionRule(ctx context.Context, id string) (*DataDetectionRule, error) {
+	resp, err := client.GetContext(ctx, fmt.Sprintf("%s/%s", scanConfigRulesBasePath, id))
 	if resp != nil && resp.StatusCode() == 404 {
 		return nil, nil
 	}
@@ -74,11 +75,11 @@ func (client *APIClient) GetDataDetectionRule(id string) (*DataDetectionRule, er
 
 // CreateDataDetectionRule creates a rule via PUT on the collection
 // (this is how the API works — not a mistake) and returns the new rule id.
-func (client *APIClient) CreateDataDetectionRule(data DataDetectionRule) (string, error) {
+func (client *APIClient) CreateDataDetectionRule(ctx context.Context, data DataDetectionRule) (string, error) {
 	dataDetectionRuleMutationLock.Lock()
 	defer dataDetectionRuleMutationLock.Unlock()
 
-	resp, err := client.Put(scanConfigRulesBasePath, data)
+	resp, err := client.PutContext(ctx, scanConfigRulesBasePath, data)
 	if err != nil {
 		return "", err
 	}
@@ -100,7 +101,7 @@ func (client *APIClient) CreateDataDetectionRule(data DataDetectionRule) (string
 // data.ID must carry the rule_id of the rule being updated. Because bulk
 // update only touches keys present in the payload, all mutable list fields
 // are always serialized (see DataDetectionRule).
-func (client *APIClient) UpdateDataDetectionRule(data DataDetectionRule) error {
+func (client *APIClient) UpdateDataDetectionRule(ctx context.Context, data DataDetectionRule) error {
 	if data.ID == "" {
 		return fmt.Errorf("rule update: rule_id is required")
 	}
@@ -111,14 +112,14 @@ func (client *APIClient) UpdateDataDetectionRule(data DataDetectionRule) error {
 		RulesToUpdate []DataDetectionRule `json:"rules_to_update"`
 	}{RulesToUpdate: []DataDetectionRule{data}}
 
-	_, err := client.Post(scanConfigBulkRulesPath, payload)
+	_, err := client.PostContext(ctx, scanConfigBulkRulesPath, payload)
 	return err
 }
 
-func (client *APIClient) DeleteDataDetectionRule(id string) error {
+func (client *APIClient) DeleteDataDetectionRule(ctx context.Context, id string) error {
 	dataDetectionRuleMutationLock.Lock()
 	defer dataDetectionRuleMutationLock.Unlock()
 
-	_, err := client.Delete(fmt.Sprintf("%s/%s", scanConfigRulesBasePath, id))
+	_, err := client.DeleteContext(ctx, fmt.Sprintf("%s/%s", scanConfigRulesBasePath, id))
 	return err
 }
@@ -1,6 +1,7 @@
 package api_client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -24,7 +25,7 @@ func TestCreateDataDetectionRule_UsesPUTOnCollection(t *testing.T) {
 	})}
 
 	client := APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	ruleID, err := client.CreateDataDetectionRule(DataDetectionRule{
+	ruleID, err := client.CreateDataDetectionRule(context.Background(), DataDetectionRule{
 		Name:                  "tf rule",
 		Feature:               "DSPM Scanning",
 		Action:                "scan",
@@ -79,11 +80,11 @@ func TestDataDetectionRuleMutations_AreSerialized(t *testing.T) {
 			defer wg.Done()
 			switch i % 3 {
 			case 0:
-				_, _ = client.CreateDataDetectionRule(DataDetectionRule{Name: fmt.Sprintf("rule %d", i), Feature: "DSPM Scanning", Action: "scan"})
+				_, _ = client.CreateDataDetectionRule(context.Background(), DataDetectionRule{Name: fmt.Sprintf("rule %d", i), Feature: "DSPM Scanning", Action: "scan"})
 			case 1:
-				_ = client.UpdateDataDetectionRule(DataDetectionRule{ID: fmt.Sprintf("rule-%d", i), Name: fmt.Sprintf("rule %d", i), Feature: "DSPM Scanning", Action: "scan"})
+				_ = client.UpdateDataDetectionRule(context.Background(), DataDetectionRule{ID: fmt.Sprintf("rule-%d", i), Name: fmt.Sprintf("rule %d", i), Feature: "DSPM Scanning", Action: "scan"})
 			default:
-				_ = client.DeleteDataDetectionRule(fmt.Sprintf("rule-%d", i))
+				_ = client.DeleteDataDetectionRule(context.Background(), fmt.Sprintf("rule-%d", i))
 			}
 		}(i)
 	}
@@ -103,7 +104,7 @@ func TestCreateDataDetectionRule_MissingRuleID(t *testing.T) {
 	})}
 
 	client := APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	_, err := client.CreateDataDetectionRule(DataDetectionRule{Name: "tf rule", Feature: "DSPM Scanning", Action: "scan"})
+	_, err := client.CreateDataDetectionRule(context.Background(), DataDetectionRule{Name: "tf rule", Feature: "DSPM Scanning", Action: "scan"})
 	if err == nil {
 		t.Fatal("expected error when response has no rule_id")
 	}
@@ -119,7 +120,7 @@ func TestGetDataDetectionRule_Envelope(t *testing.T) {
 	})}
 
 	client := APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	rule, err := client.GetDataDetectionRule("rule-9")
+	rule, err := client.GetDataDetectionRule(context.Background(), "rule-9")
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -146,7 +147,7 @@ func TestGetDataDetectionRule_NotFound(t *testing.T) {
 	})}
 
 	client := APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	rule, err := client.GetDataDetectionRule("missing")
+	rule, err := client.GetDataDetectionRule(context.Background(), "missing")
 	if err != nil {
 		t.Fatalf("expected nil error on 404 so the resource can RemoveResource, got: %v", err)
 	}
@@ -167,7 +168,7 @@ func TestUpdateDataDetectionRule_UsesBulkRules(t *testing.T) {
 	})}
 
 	client := APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	err := client.UpdateDataDetectionRule(DataDetectionRule{
+	err := client.UpdateDataDetectionRule(context.Background(), DataDetectionRule{
 		ID:                    "rule-9",
 		Name:                  "tf rule renamed",
 		Feature:               "DSPM Scanning",
@@ -193,7 +194,7 @@ func TestDeleteDataDetectionRule(t *testing.T) {
 	})}
 
 	client := APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	if err := client.DeleteDataDetectionRule("rule-9"); err != nil {
+	if err := client.DeleteDataDetectionRule(context.Background(), "rule-9"); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 }
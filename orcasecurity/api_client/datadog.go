@@ -0,0 +1,53 @@
+package api_client
+
+import "context"
+
+const DatadogServiceName = "datadog"
+
+// DatadogConfig mirrors the "config" block of the datadog external_service/config payload.
+// Site is the Datadog site domain (e.g. datadoghq.eu) that selects the intake region.
+type DatadogConfig struct {
+	Site    string   `json:"site,omitempty"`
+	APIKey  string   `json:"api_key,omitempty"`
+	Tags    []string `json:"tags,omitempty"`
+	Service string   `json:"service,omitempty"`
+	Source  string   `json:"source,omitempty"`
+}
+
+type DatadogExternalServiceConfig = ConfigEnvelope[DatadogConfig]
+
+func (client *APIClient) CreateDatadogConfig(ctx context.Context, payload DatadogExternalServiceConfig) (*DatadogExternalServiceConfig, error) {
+	return CreateExternalServiceConfig[DatadogConfig](ctx, client, DatadogServiceName, payload)
+}
+
+func (client *APIClient) GetDatadogConfig(ctx context.Context, templateName string) (*DatadogExternalServiceConfig, error) {
+	return GetExternalServiceConfig[DatadogConfig](ctx, client, DatadogServiceName, templateName, nil)
+}
+
+func (client *APIClient) ListDatadogConfigs(ctx context.Context) ([]DatadogExternalServiceConfig, error) {
+	return ListExternalServiceConfigs[DatadogConfig](ctx, client, DatadogServiceName, nil)
+}
+
+func (client *APIClient) UpdateDatadogConfig(ctx context.Context, templateName string, payload DatadogExternalServiceConfig) (*DatadogExternalServiceConfig, error) {
+	// tags, service and source are always sent so clearing them in configuration clears them in
+	// Orca; an empty api_key is omitted so the API keeps the value already in SSM.
+	cfg := map[string]interface{}{
+		"tags":    payload.Config.Tags,
+		"service": payload.Config.Service,
+		"source":  payload.Config.Source,
+	}
+	if payload.Config.Tags == nil {
+		cfg["tags"] = []string{}
+	}
+	if payload.Config.Site != "" {
+		cfg["site"] = payload.Config.Site
+	}
+	if payload.Config.APIKey != "" {
+		cfg["api_key"] = payload.Config.APIKey
+	}
+	return UpdateExternalServiceConfig[DatadogConfig](ctx, client, DatadogServiceName, templateName, BuildUpdateBody(payload, cfg, false))
+}
+
+func (client *APIClient) DeleteDatadogConfig(ctx context.Context, templateName string) error {
+	return DeleteExternalServiceConfig(ctx, client, DatadogServiceName, templateName)
+}
@@ -1,6 +1,7 @@
 package api_client
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -22,8 +23,8 @@ type discoveryViewAPIResponseType struct {
 	Data DiscoveryView `json:"data"`
 }
 
-func (client *APIClient) DoesDiscoveryViewExist(id string) (bool, error) {
-	resp, err := client.Head(fmt.Sprintf("/api/user_preferences/%s", id))
+func (client *APIClient) DoesDiscoveryViewExist(ctx context.Context, id string) (bool, error) {
+	resp, err := client.HeadContext(ctx, fmt.Sprintf("/api/user_preferences/%s", id))
 	if resp != nil && resp.StatusCode() == 404 {
 		return false, nil
 	}
@@ -36,8 +37,8 @@ func (client *APIClient) DoesDiscoveryViewExist(id string) (bool, error) {
 	return resp.StatusCode() == 200, nil
 }
 
-func (client *APIClient) GetDiscoveryView(id string) (*DiscoveryView, error) {
-	resp, err := client.Get(fmt.Sprintf("/api/user_preferences/%s", id))
+func (client *APIClient) GetDiscoveryView(ctx context.Context, id string) (*DiscoveryView, error) {
+	resp, err := client.GetContext(ctx, fmt.Sprintf("/api/user_preferences/%s", id))
 	if resp != nil && resp.StatusCode() == 404 {
 		return nil, nil
 	}
@@ -55,8 +56,8 @@ func (client *APIClient) GetDiscoveryView(id string) (*DiscoveryView, error) {
 	return &discoveryView, nil
 }
 
-func (client *APIClient) CreateDiscoveryView(data DiscoveryView) (*DiscoveryView, error) {
-	resp, err := client.Post("/api/user_preferences", data)
+func (client *APIClient) CreateDiscoveryView(ctx context.Context, data DiscoveryView) (*DiscoveryView, error) {
+	resp, err := client.PostContext(ctx, "/api/user_preferences", data)
 	if err != nil {
 		return nil, err
 	}
@@ -69,8 +70,8 @@ func (client *APIClient) CreateDiscoveryView(data DiscoveryView) (*DiscoveryView
 	return &response.Data, nil
 }
 
-func (client *APIClient) UpdateDiscoveryView(data DiscoveryView) (*DiscoveryView, error) {
-	resp, err := client.Put(fmt.Sprintf("/api/user_preferences/%s", data.ID), data)
+func (client *APIClient) UpdateDiscoveryView(ctx context.Context, data DiscoveryView) (*DiscoveryView, error) {
+	resp, err := client.PutContext(ctx, fmt.Sprintf("/api/user_preferences/%s", data.ID), data)
 	if err != nil {
 		return nil, err
 	}
@@ -83,8 +84,8 @@ func (client *APIClient) UpdateDiscoveryView(data DiscoveryView) (*DiscoveryView
 	return &response.Data, nil
 }
 
-func (client *APIClient) DeleteDiscoveryView(id string) error {
-	resp, err := client.Delete(fmt.Sprintf("/api/user_preferences/%s", id))
+func (client *APIClient) DeleteDiscoveryView(ctx context.Context, id string) error {
+	resp, err := client.DeleteContext(ctx, fmt.Sprintf("/api/user_preferences/%s", id))
 	if resp != nil && resp.StatusCode() == 404 {
 		return nil
 	}
@@ -1,6 +1,7 @@
 package api_client
 
 import (
+	"context"
 	"io"
 	"net/http"
 	"strings"
@@ -44,7 +45,7 @@ func TestDoesDiscoveryViewExist_Found(t *testing.T) {
 	})}
 
 	apiClient := newTestAPIClient(httpClient)
-	exists, err := apiClient.DoesDiscoveryViewExist(testDiscoveryViewID)
+	exists, err := apiClient.DoesDiscoveryViewExist(context.Background(), testDiscoveryViewID)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -63,7 +64,7 @@ func TestDoesDiscoveryViewExist_NotFound(t *testing.T) {
 	})}
 
 	apiClient := newTestAPIClient(httpClient)
-	exists, err := apiClient.DoesDiscoveryViewExist("invalid-id")
+	exists, err := apiClient.DoesDiscoveryViewExist(context.Background(), "invalid-id")
 	if err != nil {
 		t.Fatalf("expected no error on 404 so the resource can be removed from state, got: %v", err)
 	}
@@ -85,7 +86,7 @@ func TestGetDiscoveryView_Found(t *testing.T) {
 	})}
 
 	apiClient := newTestAPIClient(httpClient)
-	view, err := apiClient.GetDiscoveryView(testDiscoveryViewID)
+	view, err := apiClient.GetDiscoveryView(context.Background(), testDiscoveryViewID)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -110,7 +111,7 @@ func TestGetDiscoveryView_NotFound(t *testing.T) {
 	})}
 
 	apiClient := newTestAPIClient(httpClient)
-	view, err := apiClient.GetDiscoveryView("invalid-id")
+	view, err := apiClient.GetDiscoveryView(context.Background(), "invalid-id")
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -132,7 +133,7 @@ func TestDeleteDiscoveryView_AlreadyDeleted(t *testing.T) {
 	})}
 
 	apiClient := newTestAPIClient(httpClient)
-	if err := apiClient.DeleteDiscoveryView(testDiscoveryViewID); err != nil {
+	if err := apiClient.DeleteDiscoveryView(context.Background(), testDiscoveryViewID); err != nil {
 		t.Fatalf("expected no error when deleting an already-deleted view, got: %v", err)
 	}
 }
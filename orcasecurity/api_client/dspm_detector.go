@@ -1,6 +1,7 @@
 package api_client
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 )
@@ -65,8 +66,8 @@ func readDSPMDetectorData(resp *APIResponse) (*DSPMDetector, error) {
 
 // GetDSPMDetector retrieves one detector. Returns (nil, nil) on 404 so the
 // resource Read can RemoveResource on remote drift.
-func (client *APIClient) GetDSPMDetector(id string) (*DSPMDetector, error) {
-	resp, err := client.Get(fmt.Sprintf("%s/%s", dspmDetectorBasePath, id))
+func (client *APIClient) GetDSPMDetector(ctx context.Context, id string) (*DSPMDetector, error) {
+	resp, err := client.GetContext(ctx, fmt.Sprintf("%s/%s", dspmDetectorBasePath, id))
 	if resp != nil && resp.StatusCode() == 404 {
 		return nil, nil
 	}
@@ -77,7 +78,7 @@ func (client *APIClient) GetDSPMDetector(id string) (*DSPMDetector, error) {
 }
 
 // ListDSPMDetectors lists detectors, optionally filtered by title/category/sub_category.
-func (client *APIClient) ListDSPMDetectors(filters DSPMDetectorListFilters) ([]DSPMDetector, error) {
+func (client *APIClient) ListDSPMDetectors(ctx context.Context, filters DSPMDetectorListFilters) ([]DSPMDetector, error) {
 	query := url.Values{}
 	if filters.Title != "" {
 		query.Set("title", filters.Title)
@@ -93,7 +94,7 @@ func (client *APIClient) ListDSPMDetectors(filters DSPMDetectorListFilters) ([]D
 		path = fmt.Sprintf("%s?%s", path, query.Encode())
 	}
 
-	resp, err := client.Get(path)
+	resp, err := client.GetContext(ctx, path)
 	if err != nil {
 		return nil, err
 	}
@@ -104,23 +105,23 @@ func (client *APIClient) ListDSPMDetectors(filters DSPMDetectorListFilters) ([]D
 	return *detectors, nil
 }
 
-func (client *APIClient) CreateDSPMDetector(data DSPMDetector) (*DSPMDetector, error) {
-	resp, err := client.Post(dspmDetectorBasePath, data)
+func (client *APIClient) CreateDSPMDetector(ctx context.Context, data DSPMDetector) (*DSPMDetector, error) {
+	resp, err := client.PostContext(ctx, dspmDetectorBasePath, data)
 	if err != nil {
 		return nil, err
 	}
 	return readDSPMDetectorData(resp)
 }
 
-func (client *APIClient) UpdateDSPMDetector(id string, data DSPMDetector) (*DSPMDetector, error) {
-	resp, err := client.Put(fmt.Sprintf("%s/%s", dspmDetectorBasePath, id), data)
+func (client *APIClient) UpdateDSPMDetector(ctx context.Context, id string, data DSPMDetector) (*DSPMDetector, error) {
+	resp, err := client.PutContext(ctx, fmt.Sprintf("%s/%s", dspmDetectorBasePath, id), data)
 	if err != nil {
 		return nil, err
 	}
 	return readDSPMDetectorData(resp)
 }
 
-func (client *APIClient) DeleteDSPMDetector(id string) error {
-	_, err := client.Delete(fmt.Sprintf("%s/%s", dspmDetectorBasePath, id))
+func (client *APIClient) DeleteDSPMDetector(ctx context.Context, id string) error {
+	_, err := client.DeleteContext(ctx, fmt.Sprintf("%s/%s", dspmDetectorBasePath, id))
 	return err
 }
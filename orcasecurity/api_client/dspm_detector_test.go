@@ -1,6 +1,7 @@
 package api_client
 
 import (
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -18,7 +19,7 @@ func TestGetDSPMDetector(t *testing.T) {
 	})}
 
 	client := APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	detector, err := client.GetDSPMDetector("det-1")
+	detector, err := client.GetDSPMDetector(context.Background(), "det-1")
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -57,7 +58,7 @@ func TestGetDSPMDetector_NotFound(t *testing.T) {
 	})}
 
 	client := APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	detector, err := client.GetDSPMDetector("missing")
+	detector, err := client.GetDSPMDetector(context.Background(), "missing")
 	if err != nil {
 		t.Fatalf("expected nil error on 404 so the resource can RemoveResource, got: %v", err)
 	}
@@ -90,7 +91,7 @@ func TestCreateDSPMDetector(t *testing.T) {
 	})}
 
 	client := APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	detector, err := client.CreateDSPMDetector(DSPMDetector{
+	detector, err := client.CreateDSPMDetector(context.Background(), DSPMDetector{
 		Title:       "My Detector",
 		Details:     "desc",
 		Category:    "PII",
@@ -122,7 +123,7 @@ func TestUpdateDSPMDetector(t *testing.T) {
 	})}
 
 	client := APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	detector, err := client.UpdateDSPMDetector("det-1", DSPMDetector{Title: "Renamed"})
+	detector, err := client.UpdateDSPMDetector(context.Background(), "det-1", DSPMDetector{Title: "Renamed"})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -161,7 +162,7 @@ func TestDeleteDSPMDetector(t *testing.T) {
 	})}
 
 	client := APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	if err := client.DeleteDSPMDetector("det-1"); err != nil {
+	if err := client.DeleteDSPMDetector(context.Background(), "det-1"); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 }
@@ -183,7 +184,7 @@ func TestListDSPMDetectors(t *testing.T) {
 	})}
 
 	client := APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	detectors, err := client.ListDSPMDetectors(DSPMDetectorListFilters{Title: "My Detector", Category: "PII"})
+	detectors, err := client.ListDSPMDetectors(context.Background(), DSPMDetectorListFilters{Title: "My Detector", Category: "PII"})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -1,6 +1,7 @@
 package api_client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -76,8 +77,8 @@ func readDSPMPolicyData(resp *APIResponse) (*DSPMPolicy, error) {
 
 // GetDSPMPolicy retrieves one policy. Returns (nil, nil) on 404 so the
 // resource Read can RemoveResource on remote drift.
-func (client *APIClient) GetDSPMPolicy(id string) (*DSPMPolicy, error) {
-	resp, err := client.Get(fmt.Sprintf("%s/%s", dspmPolicyBasePath, id))
+func (client *APIClient) GetDSPMPolicy(ctx context.Context, id string) (*DSPMPolicy, error) {
+	resp, err := client.GetContext(ctx, fmt.Sprintf("%s/%s", dspmPolicyBasePath, id))
 	if resp != nil && resp.StatusCode() == 404 {
 		return nil, nil
 	}
@@ -87,23 +88,23 @@ func (client *APIClient) GetDSPMPolicy(id string) (*DSPMPolicy, error) {
 	return readDSPMPolicyData(resp)
 }
 
-func (client *APIClient) CreateDSPMPolicy(data DSPMPolicy) (*DSPMPolicy, error) {
-	resp, err := client.Post(dspmPolicyBasePath, data)
+func (client *APIClient) CreateDSPMPolicy(ctx context.Context, data DSPMPolicy) (*DSPMPolicy, error) {
+	resp, err := client.PostContext(ctx, dspmPolicyBasePath, data)
 	if err != nil {
 		return nil, err
 	}
 	return readDSPMPolicyData(resp)
 }
 
-func (client *APIClient) UpdateDSPMPolicy(id string, data DSPMPolicy) (*DSPMPolicy, error) {
-	resp, err := client.Put(fmt.Sprintf("%s/%s", dspmPolicyBasePath, id), data)
+func (client *APIClient) UpdateDSPMPolicy(ctx context.Context, id string, data DSPMPolicy) (*DSPMPolicy, error) {
+	resp, err := client.PutContext(ctx, fmt.Sprintf("%s/%s", dspmPolicyBasePath, id), data)
 	if err != nil {
 		return nil, err
 	}
 	return readDSPMPolicyData(resp)
 }
 
-func (client *APIClient) DeleteDSPMPolicy(id string) error {
-	_, err := client.Delete(fmt.Sprintf("%s/%s", dspmPolicyBasePath, id))
+func (client *APIClient) DeleteDSPMPolicy(ctx context.Context, id string) error {
+	_, err := client.DeleteContext(ctx, fmt.Sprintf("%s/%s", dspmPolicyBasePath, id))
 	return err
 }
@@ -1,6 +1,7 @@
 package api_client
 
 import (
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -18,7 +19,7 @@ func TestGetDSPMPolicy(t *testing.T) {
 	})}
 
 	client := APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	policy, err := client.GetDSPMPolicy("pol-1")
+	policy, err := client.GetDSPMPolicy(context.Background(), "pol-1")
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -45,7 +46,7 @@ func TestGetDSPMPolicy_NotFound(t *testing.T) {
 	})}
 
 	client := APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	policy, err := client.GetDSPMPolicy("missing")
+	policy, err := client.GetDSPMPolicy(context.Background(), "missing")
 	if err != nil {
 		t.Fatalf("expected nil error on 404 so the resource can RemoveResource, got: %v", err)
 	}
@@ -65,7 +66,7 @@ func TestCreateDSPMPolicy(t *testing.T) {
 	})}
 
 	client := APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	policy, err := client.CreateDSPMPolicy(DSPMPolicy{
+	policy, err := client.CreateDSPMPolicy(context.Background(), DSPMPolicy{
 		Name:             "PII policy",
 		Description:      "desc",
 		Feature:          "DSPM Scanning",
@@ -91,7 +92,7 @@ func TestUpdateDSPMPolicy(t *testing.T) {
 	})}
 
 	client := APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	policy, err := client.UpdateDSPMPolicy("pol-1", DSPMPolicy{
+	policy, err := client.UpdateDSPMPolicy(context.Background(), "pol-1", DSPMPolicy{
 		Name:             "Renamed",
 		Description:      "desc",
 		Feature:          "DSPM Scanning",
@@ -117,7 +118,7 @@ func TestDeleteDSPMPolicy(t *testing.T) {
 	})}
 
 	client := APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	if err := client.DeleteDSPMPolicy("pol-1"); err != nil {
+	if err := client.DeleteDSPMPolicy(context.Background(), "pol-1"); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 }
@@ -133,7 +134,7 @@ func TestGetDSPMPolicy_EmptyDictTags(t *testing.T) {
 	})}
 
 	client := APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	policy, err := client.GetDSPMPolicy("pol-1")
+	policy, err := client.GetDSPMPolicy(context.Background(), "pol-1")
 	if err != nil {
 		t.Fatalf("unexpected error decoding tags:{}: %v", err)
 	}
@@ -1,6 +1,7 @@
 package api_client
 
 import (
+	"context"
 	"io"
 	"net/http"
 	"strings"
@@ -64,7 +65,7 @@ func TestGetDSPMPolicy_MissingIDIsError(t *testing.T) {
 		}
 	})}
 	client := APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	if _, err := client.GetDSPMPolicy("pol-1"); err == nil {
+	if _, err := client.GetDSPMPolicy(context.Background(), "pol-1"); err == nil {
 		t.Fatal("expected error when the decoded policy has no id")
 	}
 }
@@ -77,7 +78,7 @@ func TestGetDSPMDetector_MissingIDIsError(t *testing.T) {
 		}
 	})}
 	client := APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	if _, err := client.GetDSPMDetector("det-1"); err == nil {
+	if _, err := client.GetDSPMDetector(context.Background(), "det-1"); err == nil {
 		t.Fatal("expected error when the decoded detector has no id")
 	}
 }
@@ -1,6 +1,7 @@
 package api_client
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 )
@@ -47,9 +48,9 @@ func configListURL(serviceName, templateName string) string {
 
 // CreateExternalServiceConfig POSTs a new config and decodes the envelope back. The serviceName
 // is pinned on the payload before sending so callers can leave ServiceName empty.
-func CreateExternalServiceConfig[C any](client *APIClient, serviceName string, payload ConfigEnvelope[C]) (*ConfigEnvelope[C], error) {
+func CreateExternalServiceConfig[C any](ctx context.Context, client *APIClient, serviceName string, payload ConfigEnvelope[C]) (*ConfigEnvelope[C], error) {
 	payload.ServiceName = serviceName
-	resp, err := client.Post(externalServiceConfigPath, payload)
+	resp, err := client.PostContext(ctx, externalServiceConfigPath, payload)
 	if err != nil {
 		return nil, err
 	}
@@ -66,8 +67,8 @@ func CreateExternalServiceConfig[C any](client *APIClient, serviceName string, p
 // GetExternalServiceConfig fetches the config list for templateName and returns the first
 // entry the optional filter accepts. A nil filter returns the first entry. Returns (nil, nil)
 // when no entry matches — callers treat that as a deleted-out-of-band signal.
-func GetExternalServiceConfig[C any](client *APIClient, serviceName, templateName string, filter func(*ConfigEnvelope[C]) bool) (*ConfigEnvelope[C], error) {
-	resp, err := client.Get(configListURL(serviceName, templateName))
+func GetExternalServiceConfig[C any](ctx context.Context, client *APIClient, serviceName, templateName string, filter func(*ConfigEnvelope[C]) bool) (*ConfigEnvelope[C], error) {
+	resp, err := client.GetContext(ctx, configListURL(serviceName, templateName))
 	if err != nil {
 		if resp != nil && resp.StatusCode() == 404 {
 			return nil, nil
@@ -86,11 +87,37 @@ func GetExternalServiceConfig[C any](client *APIClient, serviceName, templateNam
 	return nil, nil
 }
 
+// ListExternalServiceConfigs returns every serviceName config the optional filter accepts. A
+// 404 is treated as an empty list.
+func ListExternalServiceConfigs[C any](ctx context.Context, client *APIClient, serviceName string, filter func(*ConfigEnvelope[C]) bool) ([]ConfigEnvelope[C], error) {
+	resp, err := client.GetContext(ctx, fmt.Sprintf("%s?service_name=%s", externalServiceConfigPath, serviceName))
+	if err != nil {
+		if resp != nil && resp.StatusCode() == 404 {
+			return nil, nil
+		}
+		return nil, err
+	}
+	response := configListResponse[C]{}
+	if err := resp.ReadJSON(&response); err != nil {
+		return nil, fmt.Errorf("failed to decode %s list response: %w", serviceName, err)
+	}
+	if filter == nil {
+		return response.Data, nil
+	}
+	var out []ConfigEnvelope[C]
+	for i := range response.Data {
+		if filter(&response.Data[i]) {
+			out = append(out, response.Data[i])
+		}
+	}
+	return out, nil
+}
+
 // UpdateExternalServiceConfig PUTs a partial body. Callers compose the body via BuildUpdateBody
 // so each integration controls which secret fields it forwards (empty secrets are omitted so
 // the Orca API keeps the value already in SSM).
-func UpdateExternalServiceConfig[C any](client *APIClient, serviceName, templateName string, body map[string]interface{}) (*ConfigEnvelope[C], error) {
-	resp, err := client.Put(configResourceURL(serviceName, templateName), body)
+func UpdateExternalServiceConfig[C any](ctx context.Context, client *APIClient, serviceName, templateName string, body map[string]interface{}) (*ConfigEnvelope[C], error) {
+	resp, err := client.PutContext(ctx, configResourceURL(serviceName, templateName), body)
 	if err != nil {
 		return nil, err
 	}
@@ -105,8 +132,8 @@ func UpdateExternalServiceConfig[C any](client *APIClient, serviceName, template
 }
 
 // DeleteExternalServiceConfig issues a DELETE for the config and discards the response body.
-func DeleteExternalServiceConfig(client *APIClient, serviceName, templateName string) error {
-	_, err := client.Delete(configResourceURL(serviceName, templateName))
+func DeleteExternalServiceConfig(ctx context.Context, client *APIClient, serviceName, templateName string) error {
+	_, err := client.DeleteContext(ctx, configResourceURL(serviceName, templateName))
 	return err
 }
 
@@ -0,0 +1,45 @@
+package api_client
+
+import "context"
+
+const GcpPubSubServiceName = "gcp_pub_sub"
+
+// GcpPubSubConfig mirrors the "config" block of the gcp_pub_sub external_service/config payload.
+// ServiceAccountJSON is the key Orca publishes with; Orca keeps it in the secret store.
+type GcpPubSubConfig struct {
+	ProjectID          string `json:"project_id,omitempty"`
+	TopicID            string `json:"topic_id,omitempty"`
+	ServiceAccountJSON string `json:"service_account_json,omitempty"`
+}
+
+type GcpPubSubExternalServiceConfig = ConfigEnvelope[GcpPubSubConfig]
+
+func (client *APIClient) CreateGcpPubSubConfig(ctx context.Context, payload GcpPubSubExternalServiceConfig) (*GcpPubSubExternalServiceConfig, error) {
+	return CreateExternalServiceConfig[GcpPubSubConfig](ctx, client, GcpPubSubServiceName, payload)
+}
+
+func (client *APIClient) GetGcpPubSubConfig(ctx context.Context, templateName string) (*GcpPubSubExternalServiceConfig, error) {
+	return GetExternalServiceConfig[GcpPubSubConfig](ctx, client, GcpPubSubServiceName, templateName, nil)
+}
+
+func (client *APIClient) ListGcpPubSubConfigs(ctx context.Context) ([]GcpPubSubExternalServiceConfig, error) {
+	return ListExternalServiceConfigs[GcpPubSubConfig](ctx, client, GcpPubSubServiceName, nil)
+}
+
+func (client *APIClient) UpdateGcpPubSubConfig(ctx context.Context, templateName string, payload GcpPubSubExternalServiceConfig) (*GcpPubSubExternalServiceConfig, error) {
+	cfg := map[string]interface{}{}
+	if payload.Config.ProjectID != "" {
+		cfg["project_id"] = payload.Config.ProjectID
+	}
+	if payload.Config.TopicID != "" {
+		cfg["topic_id"] = payload.Config.TopicID
+	}
+	if payload.Config.ServiceAccountJSON != "" {
+		cfg["service_account_json"] = payload.Config.ServiceAccountJSON
+	}
+	return UpdateExternalServiceConfig[GcpPubSubConfig](ctx, client, GcpPubSubServiceName, templateName, BuildUpdateBody(payload, cfg, false))
+}
+
+func (client *APIClient) DeleteGcpPubSubConfig(ctx context.Context, templateName string) error {
+	return DeleteExternalServiceConfig(ctx, client, GcpPubSubServiceName, templateName)
+}
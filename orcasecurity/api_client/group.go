@@ -1,6 +1,7 @@
 package api_client
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -19,13 +20,13 @@ type groupAPIResponseType struct {
 	Data Group `json:"data"`
 }
 
-func (client *APIClient) DoesGroupExist(id string) (bool, error) {
-	resp, _ := client.Head(fmt.Sprintf("/api/rbac/group/%s", id))
+func (client *APIClient) DoesGroupExist(ctx context.Context, id string) (bool, error) {
+	resp, _ := client.HeadContext(ctx, fmt.Sprintf("/api/rbac/group/%s", id))
 	return resp.StatusCode() == 200, nil
 }
 
-func (client *APIClient) GetGroup(id string) (*Group, error) {
-	resp, err := client.Get(fmt.Sprintf("/api/rbac/group/%s", id))
+func (client *APIClient) GetGroup(ctx context.Context, id string) (*Group, error) {
+	resp, err := client.GetContext(ctx, fmt.Sprintf("/api/rbac/group/%s", id))
 	if resp.StatusCode() == 400 || resp.StatusCode() == 500 {
 		return nil, nil
 	}
@@ -43,8 +44,22 @@ func (client *APIClient) GetGroup(id string) (*Group, error) {
 	return &group, nil
 }
 
-func (client *APIClient) CreateGroup(data Group) (*Group, error) {
-	resp, err := client.Post("/api/rbac/group", data)
+// ListGroups returns every RBAC group in the organization (GET /api/rbac/group). The list
+// endpoint reports member counts only, so Users is always empty.
+func (client *APIClient) ListGroups(ctx context.Context) ([]Group, error) {
+	resp, err := client.GetContext(ctx, "/api/rbac/group")
+	if err != nil {
+		return nil, err
+	}
+	groups, err := readData[[]Group](resp)
+	if err != nil {
+		return nil, fmt.Errorf("parse group list: %w", err)
+	}
+	return *groups, nil
+}
+
+func (client *APIClient) CreateGroup(ctx context.Context, data Group) (*Group, error) {
+	resp, err := client.PostContext(ctx, "/api/rbac/group", data)
 	if err != nil {
 		return nil, err
 	}
@@ -57,8 +72,8 @@ func (client *APIClient) CreateGroup(data Group) (*Group, error) {
 	return &response.Data, nil
 }
 
-func (client *APIClient) UpdateGroup(data Group) (*Group, error) {
-	resp, err := client.Put(fmt.Sprintf("/api/rbac/group/%s", data.ID), data)
+func (client *APIClient) UpdateGroup(ctx context.Context, data Group) (*Group, error) {
+	resp, err := client.PutContext(ctx, fmt.Sprintf("/api/rbac/group/%s", data.ID), data)
 	if err != nil {
 		return nil, err
 	}
@@ -71,17 +86,17 @@ func (client *APIClient) UpdateGroup(data Group) (*Group, error) {
 	return &response.Data, nil
 }
 
-func (client *APIClient) DeleteGroup(id string) error {
-	_, err := client.Delete(fmt.Sprintf("/api/rbac/group/%s", id))
+func (client *APIClient) DeleteGroup(ctx context.Context, id string) error {
+	_, err := client.DeleteContext(ctx, fmt.Sprintf("/api/rbac/group/%s", id))
 	return err
 }
 
 // Create ignores users; membership is set via POST /api/rbac/group/{id}/users (no-op when empty).
-func (client *APIClient) AddGroupUsers(groupID string, userIDs []string) error {
+func (client *APIClient) AddGroupUsers(ctx context.Context, groupID string, userIDs []string) error {
 	if len(userIDs) == 0 {
 		return nil
 	}
-	_, err := client.Post(
+	_, err := client.PostContext(ctx,
 		fmt.Sprintf("/api/rbac/group/%s/users", groupID),
 		map[string][]string{"user_ids": userIDs},
 	)
@@ -89,11 +104,11 @@ func (client *APIClient) AddGroupUsers(groupID string, userIDs []string) error {
 }
 
 // RemoveGroupUsers removes members via DELETE /api/rbac/group/{id}/users (no-op when empty).
-func (client *APIClient) RemoveGroupUsers(groupID string, userIDs []string) error {
+func (client *APIClient) RemoveGroupUsers(ctx context.Context, groupID string, userIDs []string) error {
 	if len(userIDs) == 0 {
 		return nil
 	}
-	_, err := client.DeleteWithBody(
+	_, err := client.DeleteWithBodyContext(ctx,
 		fmt.Sprintf("/api/rbac/group/%s/users", groupID),
 		map[string][]string{"user_ids": userIDs},
 	)
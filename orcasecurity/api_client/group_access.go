@@ -1,5 +1,7 @@
 package api_client
 
+import "context"
+
 const apiRBACGroupAccessPath = "/api/rbac/access/group"
 
 var groupAccessEndpoint = rbacAccessEndpoint{
@@ -46,8 +48,8 @@ func groupAccessFromRecord(r rbacAccessRecord) GroupAccess {
 }
 
 // CreateGroupAccess assigns a role to a group with optional cloud account, Shift Left, or user filter (e.g. business unit) scope.
-func (client *APIClient) CreateGroupAccess(data GroupAccess) (*GroupAccess, error) {
-	id, err := client.createAccess(groupAccessEndpoint, data.toRecord())
+func (client *APIClient) CreateGroupAccess(ctx context.Context, data GroupAccess) (*GroupAccess, error) {
+	id, err := client.createAccess(ctx, groupAccessEndpoint, data.toRecord())
 	if err != nil {
 		return nil, err
 	}
@@ -57,8 +59,8 @@ func (client *APIClient) CreateGroupAccess(data GroupAccess) (*GroupAccess, erro
 }
 
 // ListGroupAccessForGroup returns the assignments whose nested group id equals groupID.
-func (client *APIClient) ListGroupAccessForGroup(groupID string) ([]GroupAccess, error) {
-	records, err := client.listAccessForOwner(groupAccessEndpoint, groupID)
+func (client *APIClient) ListGroupAccessForGroup(ctx context.Context, groupID string) ([]GroupAccess, error) {
+	records, err := client.listAccessForOwner(ctx, groupAccessEndpoint, groupID)
 	if err != nil {
 		return nil, err
 	}
@@ -70,8 +72,8 @@ func (client *APIClient) ListGroupAccessForGroup(groupID string) ([]GroupAccess,
 }
 
 // FindGroupAccess resolves an assignment by id (preferred) or role+scope fallback; nil when nothing matches.
-func (client *APIClient) FindGroupAccess(assignmentID string, want GroupAccess) (*GroupAccess, error) {
-	rec, err := client.findAccess(groupAccessEndpoint, assignmentID, want.toRecord())
+func (client *APIClient) FindGroupAccess(ctx context.Context, assignmentID string, want GroupAccess) (*GroupAccess, error) {
+	rec, err := client.findAccess(ctx, groupAccessEndpoint, assignmentID, want.toRecord())
 	if err != nil || rec == nil {
 		return nil, err
 	}
@@ -80,8 +82,8 @@ func (client *APIClient) FindGroupAccess(assignmentID string, want GroupAccess)
 }
 
 // UpdateGroupAccess updates an existing assignment (id carried in the body).
-func (client *APIClient) UpdateGroupAccess(data GroupAccess) (*GroupAccess, error) {
-	rec, err := client.updateAccess(groupAccessEndpoint, data.toRecord())
+func (client *APIClient) UpdateGroupAccess(ctx context.Context, data GroupAccess) (*GroupAccess, error) {
+	rec, err := client.updateAccess(ctx, groupAccessEndpoint, data.toRecord())
 	if err != nil {
 		return nil, err
 	}
@@ -90,6 +92,6 @@ func (client *APIClient) UpdateGroupAccess(data GroupAccess) (*GroupAccess, erro
 }
 
 // DeleteGroupAccess removes a group–role assignment (id carried in the body).
-func (client *APIClient) DeleteGroupAccess(id string) error {
-	return client.deleteAccess(groupAccessEndpoint, id)
+func (client *APIClient) DeleteGroupAccess(ctx context.Context, id string) error {
+	return client.deleteAccess(ctx, groupAccessEndpoint, id)
 }
@@ -1,6 +1,7 @@
 package api_client
 
 import (
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -49,7 +50,7 @@ func TestListGroupAccessForGroup_FiltersByNestedGroupID(t *testing.T) {
 		APIToken:    "tok",
 		HTTPClient:  srv.Client(),
 	}
-	got, err := c.ListGroupAccessForGroup(targetGroupID)
+	got, err := c.ListGroupAccessForGroup(context.Background(), targetGroupID)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -92,7 +93,7 @@ func TestListGroupAccessForGroup_PagesUntilTotalItems(t *testing.T) {
 	defer srv.Close()
 
 	c := &APIClient{APIEndpoint: srv.URL, APIToken: "tok", HTTPClient: srv.Client()}
-	got, err := c.ListGroupAccessForGroup(targetGroupID)
+	got, err := c.ListGroupAccessForGroup(context.Background(), targetGroupID)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -133,7 +134,7 @@ func TestFindGroupAccess_MatchesByScopeAcrossPages(t *testing.T) {
 		CloudAccounts:     []string{},
 		ShiftleftProjects: []string{},
 	}
-	got, err := c.FindGroupAccess("stale-id", want)
+	got, err := c.FindGroupAccess(context.Background(), "stale-id", want)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -156,7 +157,7 @@ func TestFindGroupAccess_ScansAllWhenGroupUnknown(t *testing.T) {
 	defer srv.Close()
 
 	c := &APIClient{APIEndpoint: srv.URL, APIToken: "tok", HTTPClient: srv.Client()}
-	got, err := c.FindGroupAccess("asg-import", GroupAccess{})
+	got, err := c.FindGroupAccess(context.Background(), "asg-import", GroupAccess{})
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -172,7 +173,7 @@ func TestFindGroupAccess_NotFoundReturnsNil(t *testing.T) {
 	defer srv.Close()
 
 	c := &APIClient{APIEndpoint: srv.URL, APIToken: "tok", HTTPClient: srv.Client()}
-	got, err := c.FindGroupAccess("gone", GroupAccess{GroupID: "g1", RoleID: "r1"})
+	got, err := c.FindGroupAccess(context.Background(), "gone", GroupAccess{GroupID: "g1", RoleID: "r1"})
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -205,7 +206,7 @@ func TestCreateGroupAccess_ParsesWrappedDataID(t *testing.T) {
 		APIToken:    "tok",
 		HTTPClient:  srv.Client(),
 	}
-	got, err := c.CreateGroupAccess(GroupAccess{
+	got, err := c.CreateGroupAccess(context.Background(), GroupAccess{
 		GroupID:           "g1",
 		RoleID:            "r1",
 		AllCloudAccounts:  false,
@@ -247,7 +248,7 @@ func TestUpdateGroupAccess_UsesCollectionPathAndReReads(t *testing.T) {
 	defer srv.Close()
 
 	c := &APIClient{APIEndpoint: srv.URL, APIToken: "tok", HTTPClient: srv.Client()}
-	got, err := c.UpdateGroupAccess(GroupAccess{ID: "asg-1", GroupID: "g1", RoleID: "r2"})
+	got, err := c.UpdateGroupAccess(context.Background(), GroupAccess{ID: "asg-1", GroupID: "g1", RoleID: "r2"})
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -261,7 +262,7 @@ func TestUpdateGroupAccess_UsesCollectionPathAndReReads(t *testing.T) {
 
 func TestUpdateGroupAccess_RequiresID(t *testing.T) {
 	c := &APIClient{APIEndpoint: "http://unused", APIToken: "t", HTTPClient: http.DefaultClient}
-	_, err := c.UpdateGroupAccess(GroupAccess{})
+	_, err := c.UpdateGroupAccess(context.Background(), GroupAccess{})
 	if err == nil || !strings.Contains(err.Error(), "id is required") {
 		t.Fatalf("expected id required error, got %v", err)
 	}
@@ -280,7 +281,7 @@ func TestDeleteGroupAccess_UsesBodyNotByID(t *testing.T) {
 	defer srv.Close()
 
 	c := &APIClient{APIEndpoint: srv.URL, APIToken: "tok", HTTPClient: srv.Client()}
-	if err := c.DeleteGroupAccess("asg-1"); err != nil {
+	if err := c.DeleteGroupAccess(context.Background(), "asg-1"); err != nil {
 		t.Fatal(err)
 	}
 	if gotMethod != http.MethodDelete || gotPath != apiRBACGroupAccessPath {
@@ -302,7 +303,7 @@ func TestDeleteGroupAccess_404Ignored(t *testing.T) {
 		APIToken:    "tok",
 		HTTPClient:  srv.Client(),
 	}
-	if err := c.DeleteGroupAccess("gone"); err != nil {
+	if err := c.DeleteGroupAccess(context.Background(), "gone"); err != nil {
 		t.Fatal(err)
 	}
 }
@@ -1,6 +1,7 @@
 package api_client
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -18,8 +19,8 @@ type JiraCloudResource struct {
 	URL  string `json:"url"`
 }
 
-func (client *APIClient) ListJiraCloudResources() ([]JiraCloudResource, error) {
-	resp, err := client.Get(jiraCloudResourcesPath)
+func (client *APIClient) ListJiraCloudResources(ctx context.Context) ([]JiraCloudResource, error) {
+	resp, err := client.GetContext(ctx, jiraCloudResourcesPath)
 	if err != nil {
 		return nil, err
 	}
@@ -36,8 +37,8 @@ func (client *APIClient) ListJiraCloudResources() ([]JiraCloudResource, error) {
 	return response.Data.Resources, nil
 }
 
-func (client *APIClient) GetJiraCloudResourceByName(name string) (*JiraCloudResource, error) {
-	all, err := client.ListJiraCloudResources()
+func (client *APIClient) GetJiraCloudResourceByName(ctx context.Context, name string) (*JiraCloudResource, error) {
+	all, err := client.ListJiraCloudResources(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,7 @@
 package api_client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -46,10 +47,10 @@ type jiraCloudListResponse struct {
 	Data   []JiraCloudTemplate `json:"data"`
 }
 
-func (client *APIClient) CreateJiraCloudTemplate(payload JiraCloudTemplate) (*JiraCloudTemplate, error) {
+func (client *APIClient) CreateJiraCloudTemplate(ctx context.Context, payload JiraCloudTemplate) (*JiraCloudTemplate, error) {
 	payload.ServiceName = JiraCloudServiceName
 
-	resp, err := client.Post("/api/external_service/config", payload)
+	resp, err := client.PostContext(ctx, "/api/external_service/config", payload)
 	if err != nil {
 		return nil, err
 	}
@@ -64,12 +65,12 @@ func (client *APIClient) CreateJiraCloudTemplate(payload JiraCloudTemplate) (*Ji
 	return &response.Data, nil
 }
 
-func (client *APIClient) GetJiraCloudTemplate(templateName string) (*JiraCloudTemplate, error) {
+func (client *APIClient) GetJiraCloudTemplate(ctx context.Context, templateName string) (*JiraCloudTemplate, error) {
 	path := fmt.Sprintf(
 		"/api/external_service/config?service_name=%s&template_name=%s",
 		JiraCloudServiceName, url.QueryEscape(templateName),
 	)
-	resp, err := client.Get(path)
+	resp, err := client.GetContext(ctx, path)
 	if err != nil {
 		if resp != nil && resp.StatusCode() == 404 {
 			return nil, nil
@@ -87,7 +88,23 @@ func (client *APIClient) GetJiraCloudTemplate(templateName string) (*JiraCloudTe
 	return &response.Data[0], nil
 }
 
-func (client *APIClient) UpdateJiraCloudTemplate(templateName string, payload JiraCloudTemplate) (*JiraCloudTemplate, error) {
+func (client *APIClient) ListJiraCloudTemplates(ctx context.Context) ([]JiraCloudTemplate, error) {
+	resp, err := client.GetContext(ctx, "/api/external_service/config?service_name="+JiraCloudServiceName)
+	if err != nil {
+		if resp != nil && resp.StatusCode() == 404 {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	response := jiraCloudListResponse{}
+	if err := resp.ReadJSON(&response); err != nil {
+		return nil, fmt.Errorf("failed to decode Jira Cloud list response: %w", err)
+	}
+	return response.Data, nil
+}
+
+func (client *APIClient) UpdateJiraCloudTemplate(ctx context.Context, templateName string, payload JiraCloudTemplate) (*JiraCloudTemplate, error) {
 	path := fmt.Sprintf(
 		"/api/external_service/config/%s?template=%s",
 		JiraCloudServiceName, url.QueryEscape(templateName),
@@ -101,7 +118,7 @@ func (client *APIClient) UpdateJiraCloudTemplate(templateName string, payload Ji
 	// business_units intentionally omitted — Orca rejects updates with
 	// "You can't change business units". Modelled as RequiresReplace on the Terraform side.
 
-	resp, err := client.Put(path, body)
+	resp, err := client.PutContext(ctx, path, body)
 	if err != nil {
 		return nil, err
 	}
@@ -116,11 +133,11 @@ func (client *APIClient) UpdateJiraCloudTemplate(templateName string, payload Ji
 	return &response.Data, nil
 }
 
-func (client *APIClient) DeleteJiraCloudTemplate(templateName string) error {
+func (client *APIClient) DeleteJiraCloudTemplate(ctx context.Context, templateName string) error {
 	path := fmt.Sprintf(
 		"/api/external_service/config/%s?template=%s",
 		JiraCloudServiceName, url.QueryEscape(templateName),
 	)
-	_, err := client.Delete(path)
+	_, err := client.DeleteContext(ctx, path)
 	return err
 }
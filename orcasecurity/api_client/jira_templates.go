@@ -1,6 +1,7 @@
 package api_client
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 )
@@ -12,8 +13,8 @@ type JiraTemplate struct {
 	TemplateName string `json:"template_name"`
 }
 
-func (client *APIClient) GetJiraTemplateByName(name string) (*JiraTemplate, error) {
-	resp, err := client.Get(
+func (client *APIClient) GetJiraTemplateByName(ctx context.Context, name string) (*JiraTemplate, error) {
+	resp, err := client.GetContext(ctx,
 		fmt.Sprintf("/api/external_service/config?service_name=%s&template_name=%s",
 			JiraServiceConfigName, url.QueryEscape(name),
 		),
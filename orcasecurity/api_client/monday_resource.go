@@ -1,6 +1,7 @@
 package api_client
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -34,11 +35,11 @@ type mondayResourceSingleResponse struct {
 	Data   MondayResource `json:"data"`
 }
 
-func (client *APIClient) CreateMondayResource(payload MondayResource) (*MondayResource, error) {
+func (client *APIClient) CreateMondayResource(ctx context.Context, payload MondayResource) (*MondayResource, error) {
 	payload.ServiceName = MondayResourceServiceName
 	payload.Type = MondayResourceType
 
-	resp, err := client.Post("/api/external_service/resources", payload)
+	resp, err := client.PostContext(ctx, "/api/external_service/resources", payload)
 	if err != nil {
 		return nil, err
 	}
@@ -61,8 +62,8 @@ func (client *APIClient) CreateMondayResource(payload MondayResource) (*MondayRe
 // ListMondayResources returns every external_service/resources entry filed under
 // service_name=monday in the caller's organisation. Orca exposes no name filter on this
 // endpoint, so callers match by name client-side.
-func (client *APIClient) ListMondayResources() ([]MondayResource, error) {
-	resp, err := client.Get(fmt.Sprintf("/api/external_service/resources?service_name=%s", MondayResourceServiceName))
+func (client *APIClient) ListMondayResources(ctx context.Context) ([]MondayResource, error) {
+	resp, err := client.GetContext(ctx, fmt.Sprintf("/api/external_service/resources?service_name=%s", MondayResourceServiceName))
 	if err != nil {
 		return nil, err
 	}
@@ -77,8 +78,8 @@ func (client *APIClient) ListMondayResources() ([]MondayResource, error) {
 	return wrapped.Data, nil
 }
 
-func (client *APIClient) GetMondayResourceByName(name string) (*MondayResource, error) {
-	all, err := client.ListMondayResources()
+func (client *APIClient) GetMondayResourceByName(ctx context.Context, name string) (*MondayResource, error) {
+	all, err := client.ListMondayResources(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -97,8 +98,8 @@ func (client *APIClient) GetMondayResourceByName(name string) (*MondayResource,
 	return &matches[0], nil
 }
 
-func (client *APIClient) GetMondayResource(id string) (*MondayResource, error) {
-	resp, err := client.Get(fmt.Sprintf("/api/external_service/resources/%s", id))
+func (client *APIClient) GetMondayResource(ctx context.Context, id string) (*MondayResource, error) {
+	resp, err := client.GetContext(ctx, fmt.Sprintf("/api/external_service/resources/%s", id))
 	if err != nil {
 		if resp != nil && resp.StatusCode() == 404 {
 			return nil, nil
@@ -119,11 +120,11 @@ func (client *APIClient) GetMondayResource(id string) (*MondayResource, error) {
 	return &response.Data, nil
 }
 
-func (client *APIClient) UpdateMondayResource(id string, payload MondayResource) (*MondayResource, error) {
+func (client *APIClient) UpdateMondayResource(ctx context.Context, id string, payload MondayResource) (*MondayResource, error) {
 	payload.ServiceName = MondayResourceServiceName
 	payload.Type = MondayResourceType
 
-	resp, err := client.Put(fmt.Sprintf("/api/external_service/resources/%s", id), payload)
+	resp, err := client.PutContext(ctx, fmt.Sprintf("/api/external_service/resources/%s", id), payload)
 	if err != nil {
 		return nil, err
 	}
@@ -141,7 +142,7 @@ func (client *APIClient) UpdateMondayResource(id string, payload MondayResource)
 	return &response.Data, nil
 }
 
-func (client *APIClient) DeleteMondayResource(id string) error {
-	_, err := client.Delete(fmt.Sprintf("/api/external_service/resources/%s", id))
+func (client *APIClient) DeleteMondayResource(ctx context.Context, id string) error {
+	_, err := client.DeleteContext(ctx, fmt.Sprintf("/api/external_service/resources/%s", id))
 	return err
 }
@@ -1,6 +1,7 @@
 package api_client
 
 import (
+	"context"
 	"encoding/json"
 )
 
@@ -22,24 +23,28 @@ type MondayTemplateConfig struct {
 // Monday OAuth resource id.
 type MondayTemplate = ConfigEnvelope[MondayTemplateConfig]
 
-func (client *APIClient) CreateMondayTemplate(payload MondayTemplate) (*MondayTemplate, error) {
-	return CreateExternalServiceConfig[MondayTemplateConfig](client, MondayServiceName, payload)
+func (client *APIClient) CreateMondayTemplate(ctx context.Context, payload MondayTemplate) (*MondayTemplate, error) {
+	return CreateExternalServiceConfig[MondayTemplateConfig](ctx, client, MondayServiceName, payload)
 }
 
-func (client *APIClient) GetMondayTemplate(templateName string) (*MondayTemplate, error) {
-	return GetExternalServiceConfig[MondayTemplateConfig](client, MondayServiceName, templateName, nil)
+func (client *APIClient) GetMondayTemplate(ctx context.Context, templateName string) (*MondayTemplate, error) {
+	return GetExternalServiceConfig[MondayTemplateConfig](ctx, client, MondayServiceName, templateName, nil)
 }
 
-func (client *APIClient) UpdateMondayTemplate(templateName string, payload MondayTemplate) (*MondayTemplate, error) {
+func (client *APIClient) ListMondayTemplates(ctx context.Context) ([]MondayTemplate, error) {
+	return ListExternalServiceConfigs[MondayTemplateConfig](ctx, client, MondayServiceName, nil)
+}
+
+func (client *APIClient) UpdateMondayTemplate(ctx context.Context, templateName string, payload MondayTemplate) (*MondayTemplate, error) {
 	// business_units intentionally omitted — Orca rejects BU changes on update
 	// ("You can't change business units"); modelled as RequiresReplace on the Terraform side.
 	body := BuildUpdateBody(payload, payload.Config, false)
 	if payload.Resource != "" {
 		body["resource"] = payload.Resource
 	}
-	return UpdateExternalServiceConfig[MondayTemplateConfig](client, MondayServiceName, templateName, body)
+	return UpdateExternalServiceConfig[MondayTemplateConfig](ctx, client, MondayServiceName, templateName, body)
 }
 
-func (client *APIClient) DeleteMondayTemplate(templateName string) error {
-	return DeleteExternalServiceConfig(client, MondayServiceName, templateName)
+func (client *APIClient) DeleteMondayTemplate(ctx context.Context, templateName string) error {
+	return DeleteExternalServiceConfig(ctx, client, MondayServiceName, templateName)
 }
@@ -1,6 +1,7 @@
 package api_client_test
 
 import (
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -26,7 +27,7 @@ func TestMondayTemplate_CreateSendsServiceNameResourceAndConfig(t *testing.T) {
 		}
 	})
 
-	out, err := apiClient.CreateMondayTemplate(api_client.MondayTemplate{
+	out, err := apiClient.CreateMondayTemplate(context.Background(), api_client.MondayTemplate{
 		TemplateName: "t1",
 		Resource:     "res-1",
 		IsEnabled:    true,
@@ -66,7 +67,7 @@ func TestMondayTemplate_UpdateOmitsBusinessUnitsAndKeepsResource(t *testing.T) {
 		}
 	})
 
-	_, err := apiClient.UpdateMondayTemplate("t1", api_client.MondayTemplate{
+	_, err := apiClient.UpdateMondayTemplate(context.Background(), "t1", api_client.MondayTemplate{
 		Resource:      "res-1",
 		IsEnabled:     true,
 		IsDefault:     false,
@@ -93,7 +94,7 @@ func TestMondayTemplate_GetReturnsFirstEntry(t *testing.T) {
 		}
 	})
 
-	out, err := apiClient.GetMondayTemplate("t1")
+	out, err := apiClient.GetMondayTemplate(context.Background(), "t1")
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -0,0 +1,46 @@
+package api_client
+
+import (
+	"context"
+	"encoding/json"
+)
+
+const MsTeamsServiceName = "ms_teams"
+
+// MsTeamsTemplateConfig mirrors the "config" block of the ms_teams external_service/config
+// payload. The incoming webhook URL is stored in Orca's secret store and stripped from GET
+// responses; mapping is kept as json.RawMessage so the message layout passes through verbatim.
+type MsTeamsTemplateConfig struct {
+	WebhookURL string          `json:"webhook_url,omitempty"`
+	Mapping    json.RawMessage `json:"mapping,omitempty"`
+}
+
+type MsTeamsTemplate = ConfigEnvelope[MsTeamsTemplateConfig]
+
+func (client *APIClient) CreateMsTeamsTemplate(ctx context.Context, payload MsTeamsTemplate) (*MsTeamsTemplate, error) {
+	return CreateExternalServiceConfig[MsTeamsTemplateConfig](ctx, client, MsTeamsServiceName, payload)
+}
+
+func (client *APIClient) GetMsTeamsTemplate(ctx context.Context, templateName string) (*MsTeamsTemplate, error) {
+	return GetExternalServiceConfig[MsTeamsTemplateConfig](ctx, client, MsTeamsServiceName, templateName, nil)
+}
+
+func (client *APIClient) ListMsTeamsTemplates(ctx context.Context) ([]MsTeamsTemplate, error) {
+	return ListExternalServiceConfigs[MsTeamsTemplateConfig](ctx, client, MsTeamsServiceName, nil)
+}
+
+func (client *APIClient) UpdateMsTeamsTemplate(ctx context.Context, templateName string, payload MsTeamsTemplate) (*MsTeamsTemplate, error) {
+	// PUT body is partial. Omit an empty webhook_url so the API keeps the value already in SSM.
+	cfg := map[string]interface{}{}
+	if payload.Config.WebhookURL != "" {
+		cfg["webhook_url"] = payload.Config.WebhookURL
+	}
+	if payload.Config.Mapping != nil {
+		cfg["mapping"] = payload.Config.Mapping
+	}
+	return UpdateExternalServiceConfig[MsTeamsTemplateConfig](ctx, client, MsTeamsServiceName, templateName, BuildUpdateBody(payload, cfg, true))
+}
+
+func (client *APIClient) DeleteMsTeamsTemplate(ctx context.Context, templateName string) error {
+	return DeleteExternalServiceConfig(ctx, client, MsTeamsServiceName, templateName)
+}
@@ -1,5 +1,7 @@
 package api_client
 
+import "context"
+
 const OpsgenieServiceName = "opsgenie"
 
 type OpsgenieConfig struct {
@@ -8,23 +10,27 @@ type OpsgenieConfig struct {
 
 type OpsgenieExternalServiceConfig = ConfigEnvelope[OpsgenieConfig]
 
-func (client *APIClient) CreateOpsgenieConfig(payload OpsgenieExternalServiceConfig) (*OpsgenieExternalServiceConfig, error) {
-	return CreateExternalServiceConfig[OpsgenieConfig](client, OpsgenieServiceName, payload)
+func (client *APIClient) CreateOpsgenieConfig(ctx context.Context, payload OpsgenieExternalServiceConfig) (*OpsgenieExternalServiceConfig, error) {
+	return CreateExternalServiceConfig[OpsgenieConfig](ctx, client, OpsgenieServiceName, payload)
+}
+
+func (client *APIClient) GetOpsgenieConfig(ctx context.Context, templateName string) (*OpsgenieExternalServiceConfig, error) {
+	return GetExternalServiceConfig[OpsgenieConfig](ctx, client, OpsgenieServiceName, templateName, nil)
 }
 
-func (client *APIClient) GetOpsgenieConfig(templateName string) (*OpsgenieExternalServiceConfig, error) {
-	return GetExternalServiceConfig[OpsgenieConfig](client, OpsgenieServiceName, templateName, nil)
+func (client *APIClient) ListOpsgenieConfigs(ctx context.Context) ([]OpsgenieExternalServiceConfig, error) {
+	return ListExternalServiceConfigs[OpsgenieConfig](ctx, client, OpsgenieServiceName, nil)
 }
 
-func (client *APIClient) UpdateOpsgenieConfig(templateName string, payload OpsgenieExternalServiceConfig) (*OpsgenieExternalServiceConfig, error) {
+func (client *APIClient) UpdateOpsgenieConfig(ctx context.Context, templateName string, payload OpsgenieExternalServiceConfig) (*OpsgenieExternalServiceConfig, error) {
 	// PUT body is partial. Omit empty opsgenie_key so the API keeps the value already in SSM.
 	cfg := map[string]interface{}{}
 	if payload.Config.OpsgenieKey != "" {
 		cfg["opsgenie_key"] = payload.Config.OpsgenieKey
 	}
-	return UpdateExternalServiceConfig[OpsgenieConfig](client, OpsgenieServiceName, templateName, BuildUpdateBody(payload, cfg, true))
+	return UpdateExternalServiceConfig[OpsgenieConfig](ctx, client, OpsgenieServiceName, templateName, BuildUpdateBody(payload, cfg, true))
 }
 
-func (client *APIClient) DeleteOpsgenieConfig(templateName string) error {
-	return DeleteExternalServiceConfig(client, OpsgenieServiceName, templateName)
+func (client *APIClient) DeleteOpsgenieConfig(ctx context.Context, templateName string) error {
+	return DeleteExternalServiceConfig(ctx, client, OpsgenieServiceName, templateName)
 }
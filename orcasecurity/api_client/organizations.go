@@ -1,12 +1,20 @@
 package api_client
 
+import (
+	"context"
+	"slices"
+)
+
 type Organization struct {
 	ID   string `json:"organization_id"`
 	Name string `json:"organization_name"`
+	// Permissions are the actions the token may perform, as /api/user/action
+	// reports them.
+	Permissions []string `json:"permissions"`
 }
 
-func (client *APIClient) GetCurrentOrganization() (*Organization, error) {
-	resp, err := client.Get("/api/user/action")
+func (client *APIClient) GetCurrentOrganization(ctx context.Context) (*Organization, error) {
+	resp, err := client.GetContext(ctx, "/api/user/action")
 	if err != nil {
 		return nil, err
 	}
@@ -23,3 +31,13 @@ func (client *APIClient) GetCurrentOrganization() (*Organization, error) {
 
 	return &response.Data, nil
 }
+
+// HasPermission reports whether the token validated during Configure carries
+// permission p. known is false when validation was skipped or the API
+// reported no permissions; callers must then not treat p as missing.
+func (client *APIClient) HasPermission(p string) (has, known bool) {
+	if client.Organization == nil || len(client.Organization.Permissions) == 0 {
+		return false, false
+	}
+	return slices.Contains(client.Organization.Permissions, p), true
+}
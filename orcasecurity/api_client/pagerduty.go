@@ -1,5 +1,7 @@
 package api_client
 
+import "context"
+
 const PagerDutyServiceName = "pagerduty"
 
 type PagerDutyConfig struct {
@@ -10,23 +12,27 @@ type PagerDutyConfig struct {
 // type name and field access while CRUD plumbing flows through the shared helper.
 type PagerDutyExternalServiceConfig = ConfigEnvelope[PagerDutyConfig]
 
-func (client *APIClient) CreatePagerDutyConfig(payload PagerDutyExternalServiceConfig) (*PagerDutyExternalServiceConfig, error) {
-	return CreateExternalServiceConfig[PagerDutyConfig](client, PagerDutyServiceName, payload)
+func (client *APIClient) CreatePagerDutyConfig(ctx context.Context, payload PagerDutyExternalServiceConfig) (*PagerDutyExternalServiceConfig, error) {
+	return CreateExternalServiceConfig[PagerDutyConfig](ctx, client, PagerDutyServiceName, payload)
+}
+
+func (client *APIClient) GetPagerDutyConfig(ctx context.Context, templateName string) (*PagerDutyExternalServiceConfig, error) {
+	return GetExternalServiceConfig[PagerDutyConfig](ctx, client, PagerDutyServiceName, templateName, nil)
 }
 
-func (client *APIClient) GetPagerDutyConfig(templateName string) (*PagerDutyExternalServiceConfig, error) {
-	return GetExternalServiceConfig[PagerDutyConfig](client, PagerDutyServiceName, templateName, nil)
+func (client *APIClient) ListPagerDutyConfigs(ctx context.Context) ([]PagerDutyExternalServiceConfig, error) {
+	return ListExternalServiceConfigs[PagerDutyConfig](ctx, client, PagerDutyServiceName, nil)
 }
 
-func (client *APIClient) UpdatePagerDutyConfig(templateName string, payload PagerDutyExternalServiceConfig) (*PagerDutyExternalServiceConfig, error) {
+func (client *APIClient) UpdatePagerDutyConfig(ctx context.Context, templateName string, payload PagerDutyExternalServiceConfig) (*PagerDutyExternalServiceConfig, error) {
 	// PUT body is partial. Omit empty integration_key so the API keeps the value already in SSM.
 	cfg := map[string]interface{}{}
 	if payload.Config.IntegrationKey != "" {
 		cfg["integration_key"] = payload.Config.IntegrationKey
 	}
-	return UpdateExternalServiceConfig[PagerDutyConfig](client, PagerDutyServiceName, templateName, BuildUpdateBody(payload, cfg, false))
+	return UpdateExternalServiceConfig[PagerDutyConfig](ctx, client, PagerDutyServiceName, templateName, BuildUpdateBody(payload, cfg, false))
 }
 
-func (client *APIClient) DeletePagerDutyConfig(templateName string) error {
-	return DeleteExternalServiceConfig(client, PagerDutyServiceName, templateName)
+func (client *APIClient) DeletePagerDutyConfig(ctx context.Context, templateName string) error {
+	return DeleteExternalServiceConfig(ctx, client, PagerDutyServiceName, templateName)
 }
@@ -0,0 +1,80 @@
+package api_client
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// requestLimiter is shared by every goroutine using one APIClient, so
+// Terraform's -parallelism fans out over a single budget instead of each
+// resource pacing itself. It combines a token bucket (requests per second), a
+// semaphore (requests in flight) and a client-wide pause that a Retry-After
+// response extends, making all callers back off together.
+type requestLimiter struct {
+	tokens *rate.Limiter // nil: no per-second limit
+	slots  chan struct{} // nil: no in-flight limit
+
+	mu          sync.Mutex
+	pausedUntil time.Time
+}
+
+func newRequestLimiter(perSecond float64, concurrent int) *requestLimiter {
+	l := &requestLimiter{}
+	if perSecond > 0 {
+		l.tokens = rate.NewLimiter(rate.Limit(perSecond), int(math.Max(1, math.Ceil(perSecond))))
+	}
+	if concurrent > 0 {
+		l.slots = make(chan struct{}, concurrent)
+	}
+	return l
+}
+
+// acquire blocks until a request may start: any Retry-After pause has elapsed,
+// a token is available and an in-flight slot is free. The returned release
+// func frees the slot and must be called once the response body is read.
+func (l *requestLimiter) acquire(ctx context.Context) (release func(), err error) {
+	if l == nil {
+		return func() {}, nil
+	}
+	if err := sleepCtx(ctx, l.pauseRemaining()); err != nil {
+		return nil, err
+	}
+	if l.tokens != nil {
+		if err := l.tokens.Wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+	if l.slots == nil {
+		return func() {}, nil
+	}
+	select {
+	case l.slots <- struct{}{}:
+		return func() { <-l.slots }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// pause holds back every new request for d. Overlapping pauses keep the later
+// end time, never shortening one already in effect.
+func (l *requestLimiter) pause(d time.Duration) {
+	if l == nil || d <= 0 {
+		return
+	}
+	until := time.Now().Add(d)
+	l.mu.Lock()
+	if until.After(l.pausedUntil) {
+		l.pausedUntil = until
+	}
+	l.mu.Unlock()
+}
+
+func (l *requestLimiter) pauseRemaining() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return time.Until(l.pausedUntil)
+}
@@ -0,0 +1,97 @@
+package api_client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRequestLimiter_CapsInFlightRequests(t *testing.T) {
+	var inFlight, peak atomic.Int32
+	httpClient := &http.Client{Transport: RoundTripFunc(func(req *http.Request) *http.Response {
+		n := inFlight.Add(1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		inFlight.Add(-1)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(`{}`)),
+			Header:     make(http.Header),
+			Request:    req,
+		}
+	})}
+	c := &APIClient{APIEndpoint: retryTestAPIEndpoint, APIToken: "secret", HTTPClient: httpClient, limiter: newRequestLimiter(0, 2)}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.Get("/api/x"); err != nil {
+				t.Errorf("Get: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+	if got := peak.Load(); got > 2 {
+		t.Fatalf("peak in-flight = %d, want at most 2", got)
+	}
+}
+
+func TestRequestLimiter_RetryAfterPausesWholeClient(t *testing.T) {
+	httpClient := &http.Client{Transport: RoundTripFunc(func(req *http.Request) *http.Response {
+		h := make(http.Header)
+		h.Set("Retry-After", "30")
+		return &http.Response{
+			StatusCode: http.StatusTooManyRequests,
+			Body:       io.NopCloser(strings.NewReader(`slow down`)),
+			Header:     h,
+			Request:    req,
+		}
+	})}
+	c := &APIClient{
+		APIEndpoint: retryTestAPIEndpoint, APIToken: "secret", HTTPClient: httpClient,
+		MaxRetryAttempts: 1, limiter: newRequestLimiter(0, 0),
+	}
+
+	_, _ = c.Get("/api/x")
+	if got := c.limiter.pauseRemaining(); got < 29*time.Second {
+		t.Fatalf("pause remaining = %s, want about 30s after Retry-After", got)
+	}
+
+	// Another caller must now wait out the pause rather than hit the API.
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := c.limiter.acquire(ctx); err == nil {
+		t.Fatal("expected acquire to block until the context expired")
+	}
+}
+
+func TestRequestLimiter_PauseNeverShortens(t *testing.T) {
+	l := newRequestLimiter(0, 0)
+	l.pause(time.Minute)
+	l.pause(time.Second)
+	if got := l.pauseRemaining(); got < 59*time.Second {
+		t.Fatalf("pause remaining = %s, want the longer pause kept", got)
+	}
+}
+
+func TestRequestLimiter_NilIsUnlimited(t *testing.T) {
+	var l *requestLimiter
+	release, err := l.acquire(context.Background())
+	if err != nil {
+		t.Fatalf("acquire: %v", err)
+	}
+	release()
+	l.pause(time.Minute)
+}
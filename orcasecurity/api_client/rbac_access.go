@@ -1,13 +1,13 @@
 package api_client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"slices"
 	"sort"
 	"strconv"
-	"strings"
 )
 
 // The group and user RBAC access endpoints share identical role+scope semantics;
@@ -206,7 +206,7 @@ func parseAccessID(body []byte) string {
 
 // pageAllAccess returns every assignment in the collection, paging through the
 // offset paginator when the endpoint supports it.
-func (client *APIClient) pageAllAccess(ep rbacAccessEndpoint) ([]rbacAccessRecord, error) {
+func (client *APIClient) pageAllAccess(ctx context.Context, ep rbacAccessEndpoint) ([]rbacAccessRecord, error) {
 	var out []rbacAccessRecord
 	fetched := 0
 	for {
@@ -217,7 +217,7 @@ func (client *APIClient) pageAllAccess(ep rbacAccessEndpoint) ([]rbacAccessRecor
 			q.Set("start_at_index", strconv.Itoa(fetched))
 			path += "?" + q.Encode()
 		}
-		resp, err := client.Get(path)
+		resp, err := client.getCached(ctx, path)
 		if err != nil {
 			return nil, err
 		}
@@ -244,15 +244,15 @@ func (client *APIClient) pageAllAccess(ep rbacAccessEndpoint) ([]rbacAccessRecor
 }
 
 // listAccessForOwner returns the assignments whose nested owner id equals ownerID.
-func (client *APIClient) listAccessForOwner(ep rbacAccessEndpoint, ownerID string) ([]rbacAccessRecord, error) {
-	all, err := client.pageAllAccess(ep)
+func (client *APIClient) listAccessForOwner(ctx context.Context, ep rbacAccessEndpoint, ownerID string) ([]rbacAccessRecord, error) {
+	all, err := client.allAccess(ctx, ep)
 	if err != nil {
 		return nil, err
 	}
 	out := make([]rbacAccessRecord, 0, len(all))
 	for _, r := range all {
 		if r.OwnerID == ownerID {
-			out = append(out, r)
+			out = append(out, r.clone())
 		}
 	}
 	return out, nil
@@ -260,14 +260,14 @@ func (client *APIClient) listAccessForOwner(ep rbacAccessEndpoint, ownerID strin
 
 // findAccess resolves an assignment by scanning the collection: exact id match
 // first, then role+scope as a fallback for when the id changed server-side.
-func (client *APIClient) findAccess(ep rbacAccessEndpoint, assignmentID string, want rbacAccessRecord) (*rbacAccessRecord, error) {
-	list, err := client.pageAllAccess(ep)
+func (client *APIClient) findAccess(ctx context.Context, ep rbacAccessEndpoint, assignmentID string, want rbacAccessRecord) (*rbacAccessRecord, error) {
+	list, err := client.allAccess(ctx, ep)
 	if err != nil {
 		return nil, err
 	}
 	for _, item := range list {
 		if item.ID == assignmentID {
-			picked := item
+			picked := item.clone()
 			return &picked, nil
 		}
 	}
@@ -277,11 +277,16 @@ func (client *APIClient) findAccess(ep rbacAccessEndpoint, assignmentID string,
 	// was truly deleted — that trade-off is deliberate; do not "fix" it into a
 	// recreate, which is the re-creation bug WASP-1494 removed.
 	want.ID = assignmentID
-	return pickMatchingAccess(list, want.OwnerID, want), nil
+	picked := pickMatchingAccess(list, want.OwnerID, want)
+	if picked != nil {
+		*picked = picked.clone()
+	}
+	return picked, nil
 }
 
-func (client *APIClient) createAccess(ep rbacAccessEndpoint, rec rbacAccessRecord) (string, error) {
-	resp, err := client.Post(ep.path, rec.toWire(ep))
+func (client *APIClient) createAccess(ctx context.Context, ep rbacAccessEndpoint, rec rbacAccessRecord) (string, error) {
+	resp, err := client.PostContext(ctx, ep.path, rec.toWire(ep))
+	client.invalidateAccess(ep)
 	if err != nil {
 		return "", err
 	}
@@ -294,14 +299,16 @@ func (client *APIClient) createAccess(ep rbacAccessEndpoint, rec rbacAccessRecor
 
 // updateAccess PUTs the assignment (id in the body) then re-reads the canonical
 // row, since the PUT response nests owner/role rather than returning the flat shape.
-func (client *APIClient) updateAccess(ep rbacAccessEndpoint, rec rbacAccessRecord) (*rbacAccessRecord, error) {
+func (client *APIClient) updateAccess(ctx context.Context, ep rbacAccessEndpoint, rec rbacAccessRecord) (*rbacAccessRecord, error) {
 	if rec.ID == "" {
 		return nil, fmt.Errorf("update %s access: id is required", ep.ownerKey)
 	}
-	if _, err := client.Put(ep.path, rec.toWire(ep)); err != nil {
+	_, err := client.PutContext(ctx, ep.path, rec.toWire(ep))
+	client.invalidateAccess(ep)
+	if err != nil {
 		return nil, err
 	}
-	refreshed, err := client.findAccess(ep, rec.ID, rec)
+	refreshed, err := client.findAccess(ctx, ep, rec.ID, rec)
 	if err != nil {
 		return nil, err
 	}
@@ -313,9 +320,10 @@ func (client *APIClient) updateAccess(ep rbacAccessEndpoint, rec rbacAccessRecor
 
 // deleteAccess removes an assignment (id carried in the body); a 404 is treated
 // as already-gone.
-func (client *APIClient) deleteAccess(ep rbacAccessEndpoint, id string) error {
-	_, err := client.DeleteWithBody(ep.path, map[string]string{"id": id})
-	if err != nil && strings.Contains(err.Error(), "status: 404") {
+func (client *APIClient) deleteAccess(ctx context.Context, ep rbacAccessEndpoint, id string) error {
+	_, err := client.DeleteWithBodyContext(ctx, ep.path, map[string]string{"id": id})
+	client.invalidateAccess(ep)
+	if IsNotFound(err) {
 		return nil
 	}
 	return err
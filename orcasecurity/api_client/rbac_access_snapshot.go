@@ -0,0 +1,133 @@
+package api_client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// rbacAccessSnapshots holds one full listing per RBAC access collection for
+// the lifetime of the client. Every orcasecurity_group_access and
+// orcasecurity_user_access Read resolves its row from the snapshot, so
+// refreshing N assignments costs one collection scan instead of N.
+//
+// Concurrent Reads that miss share a single fetch. A write through
+// createAccess/updateAccess/deleteAccess discards the snapshot of its
+// collection; the next Read reloads it.
+type rbacAccessSnapshots struct {
+	mu      sync.Mutex
+	records map[string][]rbacAccessRecord // by endpoint path
+	// generation is bumped on every invalidation. Fetches are keyed by it so
+	// a Read that starts after a write never joins a pre-write fetch, and a
+	// pre-write fetch that finishes late is not stored.
+	generation map[string]uint64
+	flight     singleflight.Group
+}
+
+func newRBACAccessSnapshots() *rbacAccessSnapshots {
+	return &rbacAccessSnapshots{
+		records:    map[string][]rbacAccessRecord{},
+		generation: map[string]uint64{},
+	}
+}
+
+func (s *rbacAccessSnapshots) lookup(path string) ([]rbacAccessRecord, uint64, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	records, ok := s.records[path]
+	return records, s.generation[path], ok
+}
+
+func (s *rbacAccessSnapshots) store(path string, generation uint64, records []rbacAccessRecord) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.generation[path] == generation {
+		s.records[path] = records
+	}
+}
+
+func (s *rbacAccessSnapshots) invalidate(path string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.generation[path]++
+	delete(s.records, path)
+}
+
+// allAccess returns every assignment in ep's collection, from the snapshot
+// when one is loaded. The returned slice is shared and must not be modified;
+// hand records out through clone. A client without snapshots (built as a
+// struct literal) lists the collection on every call.
+func (client *APIClient) allAccess(ctx context.Context, ep rbacAccessEndpoint) ([]rbacAccessRecord, error) {
+	s := client.accessSnapshots
+	if s == nil {
+		return client.pageAllAccess(ctx, ep)
+	}
+	records, generation, ok := s.lookup(ep.path)
+	if ok {
+		return records, nil
+	}
+
+	key := fmt.Sprintf("%s#%d", ep.path, generation)
+	// The shared fetch must outlive any single caller: one Read being
+	// cancelled should not fail every other Read waiting on it. Each caller
+	// still stops waiting when its own ctx is done.
+	ch := s.flight.DoChan(key, func() (interface{}, error) {
+		fetchCtx, cancel := client.detachedContext(ctx)
+		defer cancel()
+		records, err := client.pageAllAccess(fetchCtx, ep)
+		if err != nil {
+			return nil, err
+		}
+		s.store(ep.path, generation, records)
+		return records, nil
+	})
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-ch:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		return res.Val.([]rbacAccessRecord), nil
+	}
+}
+
+// detachedContext keeps ctx's values but not its cancellation, so a shared
+// fetch survives the caller that started it. It is still bounded, by the
+// caller's remaining deadline or else DefaultTimeout, so a fetch nobody waits
+// for any more cannot keep retrying in the background indefinitely.
+func (client *APIClient) detachedContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	detached := context.WithoutCancel(ctx)
+	if deadline, ok := ctx.Deadline(); ok {
+		return context.WithDeadline(detached, deadline)
+	}
+	if client.DefaultTimeout > 0 {
+		return context.WithTimeout(detached, client.DefaultTimeout)
+	}
+	return detached, func() {}
+}
+
+// invalidateAccess discards ep's snapshot after a write, successful or not:
+// a failed or timed-out write may still have been applied server-side.
+func (client *APIClient) invalidateAccess(ep rbacAccessEndpoint) {
+	if client.accessSnapshots != nil {
+		client.accessSnapshots.invalidate(ep.path)
+	}
+}
+
+// clone copies r's slices so callers cannot alias snapshot storage.
+func (r rbacAccessRecord) clone() rbacAccessRecord {
+	r.CloudAccounts = cloneStrings(r.CloudAccounts)
+	r.ShiftleftProjects = cloneStrings(r.ShiftleftProjects)
+	r.UserFilters = cloneStrings(r.UserFilters)
+	return r
+}
+
+func cloneStrings(s []string) []string {
+	if s == nil {
+		return nil
+	}
+	return append([]string{}, s...)
+}
@@ -0,0 +1,133 @@
+package api_client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// snapshotTestServer serves one group-access row and counts list requests.
+// Lists block until release is closed so concurrent Reads overlap.
+func snapshotTestServer(t *testing.T, release <-chan struct{}) (*httptest.Server, *atomic.Int32) {
+	var lists atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			_, _ = w.Write([]byte(`{"data": {"id": "asg-1"}}`))
+			return
+		}
+		lists.Add(1)
+		<-release
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"total_items": 1,
+			"data": []map[string]interface{}{{
+				"id": "asg-1", "group": map[string]string{"id": "g1"},
+				"role": map[string]string{"id": "r1"}, "cloud_accounts": []map[string]string{{"id": "ca1"}},
+			}},
+		})
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &lists
+}
+
+func TestRBACAccessSnapshot_ConcurrentReadsShareOneFetch(t *testing.T) {
+	release := make(chan struct{})
+	srv, lists := snapshotTestServer(t, release)
+	c := &APIClient{APIEndpoint: srv.URL, APIToken: "tok", HTTPClient: srv.Client(), accessSnapshots: newRBACAccessSnapshots()}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			got, err := c.FindGroupAccess(context.Background(), "asg-1", GroupAccess{GroupID: "g1"})
+			if err != nil || got == nil || got.ID != "asg-1" {
+				t.Errorf("FindGroupAccess = %+v, %v", got, err)
+			}
+		}()
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if _, err := c.ListGroupAccessForGroup(context.Background(), "g1"); err != nil {
+		t.Fatal(err)
+	}
+	if got := lists.Load(); got != 1 {
+		t.Fatalf("collection listed %d times, want 1", got)
+	}
+}
+
+func TestRBACAccessSnapshot_WriteRefreshesCollection(t *testing.T) {
+	release := make(chan struct{})
+	close(release)
+	srv, lists := snapshotTestServer(t, release)
+	c := &APIClient{APIEndpoint: srv.URL, APIToken: "tok", HTTPClient: srv.Client(), accessSnapshots: newRBACAccessSnapshots()}
+	ctx := context.Background()
+
+	if _, err := c.ListGroupAccessForGroup(ctx, "g1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.DeleteGroupAccess(ctx, "asg-other"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.ListGroupAccessForGroup(ctx, "g1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.ListUserAccessForUser(ctx, "u1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.ListUserAccessForUser(ctx, "u1"); err != nil {
+		t.Fatal(err)
+	}
+	// group list, group list after the delete, one user list.
+	if got := lists.Load(); got != 3 {
+		t.Fatalf("collections listed %d times, want 3", got)
+	}
+}
+
+func TestRBACAccessSnapshot_RecordsAreNotAliased(t *testing.T) {
+	release := make(chan struct{})
+	close(release)
+	srv, _ := snapshotTestServer(t, release)
+	c := &APIClient{APIEndpoint: srv.URL, APIToken: "tok", HTTPClient: srv.Client(), accessSnapshots: newRBACAccessSnapshots()}
+
+	first, err := c.ListGroupAccessForGroup(context.Background(), "g1")
+	if err != nil || len(first) != 1 {
+		t.Fatalf("ListGroupAccessForGroup = %+v, %v", first, err)
+	}
+	first[0].CloudAccounts[0] = "mutated"
+
+	second, _ := c.ListGroupAccessForGroup(context.Background(), "g1")
+	if second[0].CloudAccounts[0] != "ca1" {
+		t.Fatalf("snapshot was modified through a returned record: %+v", second[0])
+	}
+}
+
+// The shared fetch ignores the caller's cancellation but keeps a deadline, so
+// it cannot run on unbounded once every waiter has given up.
+func TestRBACAccessSnapshot_DetachedFetchIsBounded(t *testing.T) {
+	c := &APIClient{DefaultTimeout: time.Minute}
+
+	parent, cancel := context.WithTimeout(context.Background(), time.Hour)
+	ctx, stop := c.detachedContext(parent)
+	defer stop()
+	cancel()
+	if ctx.Err() != nil {
+		t.Fatal("detached fetch was cancelled with its caller")
+	}
+	want, _ := parent.Deadline()
+	if got, ok := ctx.Deadline(); !ok || !got.Equal(want) {
+		t.Errorf("deadline = %v, %v; want the caller's %v", got, ok, want)
+	}
+
+	ctx, stop = c.detachedContext(context.Background())
+	defer stop()
+	if got, ok := ctx.Deadline(); !ok || time.Until(got) > time.Minute {
+		t.Errorf("deadline = %v, %v; want DefaultTimeout from now", got, ok)
+	}
+}
@@ -1,6 +1,7 @@
 package api_client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -17,8 +18,8 @@ type rbacRoleListAPIResponse struct {
 }
 
 // ListRBACRoles returns all assignable roles (GET /api/rbac/role).
-func (client *APIClient) ListRBACRoles() ([]RBACRole, error) {
-	resp, err := client.Get("/api/rbac/role")
+func (client *APIClient) ListRBACRoles(ctx context.Context) ([]RBACRole, error) {
+	resp, err := client.getCached(ctx, "/api/rbac/role")
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,7 @@
 package api_client
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -35,7 +36,7 @@ func TestListRBACRoles_Success(t *testing.T) {
 		APIToken:    "tok",
 		HTTPClient:  srv.Client(),
 	}
-	roles, err := c.ListRBACRoles()
+	roles, err := c.ListRBACRoles(context.Background())
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -55,7 +56,7 @@ func TestListRBACRoles_NonSuccessStatus(t *testing.T) {
 		APIToken:    "tok",
 		HTTPClient:  srv.Client(),
 	}
-	_, err := c.ListRBACRoles()
+	_, err := c.ListRBACRoles(context.Background())
 	if err == nil {
 		t.Fatal("expected error")
 	}
@@ -0,0 +1,31 @@
+package api_client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// ErrReadOnly is returned for every request other than GET or HEAD made through a client with
+// ReadOnly set. The request never leaves the provider.
+var ErrReadOnly = errors.New("the Orca Security provider is in read-only mode")
+
+type queryRequestKey struct{}
+
+// asQuery marks requests made with ctx as reads that happen to use POST (GraphQL queries, the
+// Sonar query parser), so they are still allowed on a read-only client.
+func asQuery(ctx context.Context) context.Context {
+	return context.WithValue(ctx, queryRequestKey{}, true)
+}
+
+// checkReadOnly refuses req when it could change anything in Orca.
+func (c *APIClient) checkReadOnly(req *http.Request) error {
+	if !c.ReadOnly || req.Method == http.MethodGet || req.Method == http.MethodHead {
+		return nil
+	}
+	if req.Context().Value(queryRequestKey{}) != nil {
+		return nil
+	}
+	return fmt.Errorf("%w: refusing %s %s. Unset read_only in the provider block (or ORCASECURITY_READ_ONLY) to allow changes", ErrReadOnly, req.Method, req.URL.Path)
+}
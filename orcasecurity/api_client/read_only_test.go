@@ -0,0 +1,71 @@
+package api_client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func readOnlyClient(sent *[]string) *APIClient {
+	return &APIClient{
+		APIEndpoint: "http://localhost",
+		APIToken:    "secret",
+		ReadOnly:    true,
+		HTTPClient: &http.Client{Transport: RoundTripFunc(func(req *http.Request) *http.Response {
+			*sent = append(*sent, req.Method+" "+req.URL.Path)
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body:       io.NopCloser(strings.NewReader(`{"data": {"valid": true}}`)),
+			}
+		})},
+	}
+}
+
+func TestReadOnly_RefusesWrites(t *testing.T) {
+	var sent []string
+	client := readOnlyClient(&sent)
+	ctx := context.Background()
+
+	writes := map[string]func() error{
+		"create": func() error { _, err := client.CreateGroup(ctx, Group{Name: "g"}); return err },
+		"update": func() error { _, err := client.UpdateGroup(ctx, Group{ID: "g1", Name: "g"}); return err },
+		"delete": func() error { return client.DeleteGroup(ctx, "g1") },
+		"remediation text": func() error {
+			return client.SetCustomSonarAlertRemediationText(ctx, CustomSonarAlertRemediationText{AlertType: "t"})
+		},
+		"remediation text delete": func() error {
+			return client.DeleteCustomSonarAlertRemediationText(ctx, CustomSonarAlertRemediationText{AlertType: "t"})
+		},
+	}
+	for name, write := range writes {
+		if err := write(); !errors.Is(err, ErrReadOnly) {
+			t.Errorf("%s: err = %v, want ErrReadOnly", name, err)
+		}
+	}
+	if len(sent) != 0 {
+		t.Errorf("read-only client sent %v", sent)
+	}
+}
+
+func TestReadOnly_AllowsReadsAndQueries(t *testing.T) {
+	var sent []string
+	client := readOnlyClient(&sent)
+	ctx := context.Background()
+
+	if _, err := client.GetContext(ctx, "/api/rbac/group"); err != nil {
+		t.Errorf("GET: %v", err)
+	}
+	if _, err := client.ValidateSonarQuery(ctx, "Bucket"); err != nil {
+		t.Errorf("query validation: %v", err)
+	}
+	if _, err := client.GetTrustedDynamicIpRangeStatus(ctx, "org"); err != nil {
+		t.Errorf("GraphQL query: %v", err)
+	}
+	if want := []string{"GET /api/rbac/group", "POST /api/sonar/query/validate", "POST /api/gql"}; strings.Join(sent, ",") != strings.Join(want, ",") {
+		t.Errorf("sent %v, want %v", sent, want)
+	}
+}
@@ -0,0 +1,99 @@
+package api_client
+
+import (
+	"context"
+	"strings"
+	"sync"
+)
+
+// responseCache memoizes successful collection GETs for the lifetime of one
+// provider instance (one plan or apply). Terraform reads every
+// orcasecurity_group_access in a plan by listing the whole collection, so
+// without it N assignments cost N full scans.
+//
+// Entries are keyed by request path, query included. Any non-GET request
+// drops every entry in the same collection: a write to /api/automations/<id>
+// or /api/automations/<id>/priority evicts /api/automations?limit=...
+type responseCache struct {
+	mu      sync.Mutex
+	entries map[string]*APIResponse
+	// generation is bumped on every invalidation so a GET that was in flight
+	// while a write landed does not store a pre-write body.
+	generation uint64
+}
+
+func newResponseCache() *responseCache {
+	return &responseCache{entries: map[string]*APIResponse{}}
+}
+
+func (rc *responseCache) get(path string) (*APIResponse, uint64, bool) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	resp, ok := rc.entries[path]
+	return resp, rc.generation, ok
+}
+
+func (rc *responseCache) put(path string, generation uint64, resp *APIResponse) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	if generation == rc.generation {
+		rc.entries[path] = resp
+	}
+}
+
+// invalidate drops every entry whose collection contains, or is contained by,
+// the written path.
+func (rc *responseCache) invalidate(path string) {
+	written := collectionPath(path)
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rc.generation++
+	for key := range rc.entries {
+		cached := collectionPath(key)
+		if pathWithin(written, cached) || pathWithin(cached, written) {
+			delete(rc.entries, key)
+		}
+	}
+}
+
+// collectionPath strips the query string and any trailing slash, so
+// "/api/user_invites/?x=1" and "/api/user_invites" compare equal.
+func collectionPath(path string) string {
+	path, _, _ = strings.Cut(path, "?")
+	return strings.TrimRight(path, "/")
+}
+
+// pathWithin reports whether p is base or a descendant of it, matching whole
+// segments so /api/automations does not cover /api/automations_v3.
+func pathWithin(p, base string) bool {
+	return p == base || strings.HasPrefix(p, base+"/")
+}
+
+// getCached is GetContext served from the response cache when caching is
+// enabled. Only collection and catalog lookups go through it; single-record
+// reads always hit the API so drift is detected.
+func (c *APIClient) getCached(ctx context.Context, path string) (*APIResponse, error) {
+	if c.cache == nil {
+		return c.GetContext(ctx, path)
+	}
+	resp, generation, ok := c.cache.get(path)
+	if ok {
+		c.debugf("Serving cached response for: GET %s", path)
+		return resp, nil
+	}
+	resp, err := c.GetContext(ctx, path)
+	if err != nil {
+		return resp, err
+	}
+	c.cache.put(path, generation, resp)
+	return resp, nil
+}
+
+// invalidateCache evicts the collection touched by a write. It runs whether
+// or not the write succeeded: a failed or timed-out request may still have
+// been applied server-side.
+func (c *APIClient) invalidateCache(path string) {
+	if c.cache != nil {
+		c.cache.invalidate(path)
+	}
+}
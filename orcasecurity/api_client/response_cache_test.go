@@ -0,0 +1,99 @@
+package api_client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// countingClient returns a client whose transport answers every request with
+// an empty envelope and counts GETs per path+query.
+func countingClient(cache *responseCache) (*APIClient, map[string]int) {
+	gets := map[string]int{}
+	httpClient := &http.Client{Transport: RoundTripFunc(func(req *http.Request) *http.Response {
+		if req.Method == http.MethodGet {
+			gets[req.URL.RequestURI()]++
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(`{"total_items": 0, "data": []}`)),
+			Header:     make(http.Header),
+			Request:    req,
+		}
+	})}
+	return &APIClient{APIEndpoint: retryTestAPIEndpoint, APIToken: "secret", HTTPClient: httpClient, cache: cache}, gets
+}
+
+func TestResponseCache_ServesRepeatedListsOnce(t *testing.T) {
+	c, gets := countingClient(newResponseCache())
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		if _, err := c.ListRBACRoles(ctx); err != nil {
+			t.Fatalf("ListRBACRoles: %v", err)
+		}
+		if _, err := c.ListAutomationsV2(ctx); err != nil {
+			t.Fatalf("ListAutomationsV2: %v", err)
+		}
+	}
+	if gets["/api/rbac/role"] != 1 {
+		t.Errorf("role list fetched %d times, want 1", gets["/api/rbac/role"])
+	}
+	if got := gets["/api/automations?limit=300&start_at_index=0"]; got != 1 {
+		t.Errorf("automation list fetched %d times, want 1", got)
+	}
+}
+
+func TestResponseCache_WriteInvalidatesCollection(t *testing.T) {
+	c, gets := countingClient(newResponseCache())
+	ctx := context.Background()
+
+	_, _ = c.ListAutomationsV2(ctx)
+	_, _ = c.ListRBACRoles(ctx)
+	if _, err := c.PutContext(ctx, "/api/automations/a1/priority", map[string]int{"priority": 1}); err != nil {
+		t.Fatalf("PutContext: %v", err)
+	}
+	_, _ = c.ListAutomationsV2(ctx)
+	_, _ = c.ListRBACRoles(ctx)
+
+	if got := gets["/api/automations?limit=300&start_at_index=0"]; got != 2 {
+		t.Errorf("automation list fetched %d times, want 2 after a write to the collection", got)
+	}
+	if gets["/api/rbac/role"] != 1 {
+		t.Errorf("role list fetched %d times, want 1: unrelated writes must not evict it", gets["/api/rbac/role"])
+	}
+}
+
+func TestResponseCache_DisabledByDefault(t *testing.T) {
+	c, gets := countingClient(nil)
+	for i := 0; i < 2; i++ {
+		_, _ = c.ListRBACRoles(context.Background())
+	}
+	if gets["/api/rbac/role"] != 2 {
+		t.Errorf("role list fetched %d times, want 2 with caching off", gets["/api/rbac/role"])
+	}
+}
+
+func TestResponseCache_StaleFetchNotStored(t *testing.T) {
+	rc := newResponseCache()
+	_, generation, _ := rc.get("/api/users")
+	rc.invalidate("/api/users/u1")
+	rc.put("/api/users", generation, &APIResponse{})
+	if _, _, ok := rc.get("/api/users"); ok {
+		t.Error("a response fetched before an invalidation must not be cached")
+	}
+}
+
+func TestPathWithin_MatchesWholeSegments(t *testing.T) {
+	if !pathWithin("/api/automations/a1", "/api/automations") {
+		t.Error("expected child path to be within its collection")
+	}
+	if pathWithin("/api/automations_v3", "/api/automations") {
+		t.Error("sibling with shared prefix must not match")
+	}
+	if collectionPath("/api/user_invites/?limit=1") != "/api/user_invites" {
+		t.Error("collectionPath should drop query and trailing slash")
+	}
+}
@@ -1,6 +1,7 @@
 package api_client
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 )
@@ -47,8 +48,8 @@ type orcaSettingsResponse struct {
 	Data   OrcaSettings `json:"data"`
 }
 
-func (client *APIClient) GetOrcaSettings() (*OrcaSettings, error) {
-	resp, err := client.Get("/api/settings")
+func (client *APIClient) GetOrcaSettings(ctx context.Context) (*OrcaSettings, error) {
+	resp, err := client.GetContext(ctx, "/api/settings")
 	if err != nil {
 		return nil, err
 	}
@@ -66,10 +67,10 @@ func (client *APIClient) GetOrcaSettings() (*OrcaSettings, error) {
 	return nil, fmt.Errorf("orca settings response did not include report_uploader_arn")
 }
 
-func (client *APIClient) CreateS3BucketConfig(payload S3BucketExternalServiceConfig) (*S3BucketExternalServiceConfig, error) {
+func (client *APIClient) CreateS3BucketConfig(ctx context.Context, payload S3BucketExternalServiceConfig) (*S3BucketExternalServiceConfig, error) {
 	payload.ServiceName = S3BucketServiceName
 
-	resp, err := client.Post("/api/external_service/config", payload)
+	resp, err := client.PostContext(ctx, "/api/external_service/config", payload)
 	if err != nil {
 		return nil, err
 	}
@@ -84,12 +85,12 @@ func (client *APIClient) CreateS3BucketConfig(payload S3BucketExternalServiceCon
 	return &response.Data, nil
 }
 
-func (client *APIClient) GetS3BucketConfig(templateName string) (*S3BucketExternalServiceConfig, error) {
+func (client *APIClient) GetS3BucketConfig(ctx context.Context, templateName string) (*S3BucketExternalServiceConfig, error) {
 	path := fmt.Sprintf(
 		"/api/external_service/config?service_name=%s&template_name=%s",
 		S3BucketServiceName, url.QueryEscape(templateName),
 	)
-	resp, err := client.Get(path)
+	resp, err := client.GetContext(ctx, path)
 	if err != nil {
 		if resp != nil && resp.StatusCode() == 404 {
 			return nil, nil
@@ -107,7 +108,23 @@ func (client *APIClient) GetS3BucketConfig(templateName string) (*S3BucketExtern
 	return &response.Data[0], nil
 }
 
-func (client *APIClient) UpdateS3BucketConfig(templateName string, payload S3BucketExternalServiceConfig) (*S3BucketExternalServiceConfig, error) {
+func (client *APIClient) ListS3BucketConfigs(ctx context.Context) ([]S3BucketExternalServiceConfig, error) {
+	resp, err := client.GetContext(ctx, "/api/external_service/config?service_name="+S3BucketServiceName)
+	if err != nil {
+		if resp != nil && resp.StatusCode() == 404 {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	response := s3BucketListResponse{}
+	if err := resp.ReadJSON(&response); err != nil {
+		return nil, fmt.Errorf("failed to decode S3 bucket list response: %w", err)
+	}
+	return response.Data, nil
+}
+
+func (client *APIClient) UpdateS3BucketConfig(ctx context.Context, templateName string, payload S3BucketExternalServiceConfig) (*S3BucketExternalServiceConfig, error) {
 	path := fmt.Sprintf(
 		"/api/external_service/config/%s?template=%s",
 		S3BucketServiceName, url.QueryEscape(templateName),
@@ -126,7 +143,7 @@ func (client *APIClient) UpdateS3BucketConfig(templateName string, payload S3Buc
 	}
 	body["config"] = cfg
 
-	resp, err := client.Put(path, body)
+	resp, err := client.PutContext(ctx, path, body)
 	if err != nil {
 		return nil, err
 	}
@@ -141,11 +158,11 @@ func (client *APIClient) UpdateS3BucketConfig(templateName string, payload S3Buc
 	return &response.Data, nil
 }
 
-func (client *APIClient) DeleteS3BucketConfig(templateName string) error {
+func (client *APIClient) DeleteS3BucketConfig(ctx context.Context, templateName string) error {
 	path := fmt.Sprintf(
 		"/api/external_service/config/%s?template=%s",
 		S3BucketServiceName, url.QueryEscape(templateName),
 	)
-	_, err := client.Delete(path)
+	_, err := client.DeleteContext(ctx, path)
 	return err
 }
@@ -1,6 +1,7 @@
 package api_client
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -59,8 +60,8 @@ type ScheduledReport struct {
 	SnowflakeTemplate string `json:"snowflake_template,omitempty"`
 }
 
-func (client *APIClient) DoesScheduledReportExist(id string) (bool, error) {
-	resp, err := client.Get(fmt.Sprintf("%s/%s", scheduledReportAPIPath, id))
+func (client *APIClient) DoesScheduledReportExist(ctx context.Context, id string) (bool, error) {
+	resp, err := client.GetContext(ctx, fmt.Sprintf("%s/%s", scheduledReportAPIPath, id))
 	if resp != nil && (resp.StatusCode() == 404 || resp.StatusCode() == 400) {
 		return false, nil
 	}
@@ -70,8 +71,8 @@ func (client *APIClient) DoesScheduledReportExist(id string) (bool, error) {
 	return resp.IsOk(), nil
 }
 
-func (client *APIClient) GetScheduledReport(id string) (*ScheduledReport, error) {
-	resp, err := client.Get(fmt.Sprintf("%s/%s", scheduledReportAPIPath, id))
+func (client *APIClient) GetScheduledReport(ctx context.Context, id string) (*ScheduledReport, error) {
+	resp, err := client.GetContext(ctx, fmt.Sprintf("%s/%s", scheduledReportAPIPath, id))
 	if resp != nil && (resp.StatusCode() == 404 || resp.StatusCode() == 400) {
 		return nil, nil
 	}
@@ -89,8 +90,8 @@ func (client *APIClient) GetScheduledReport(id string) (*ScheduledReport, error)
 	return &report, nil
 }
 
-func (client *APIClient) CreateScheduledReport(data ScheduledReport) (*ScheduledReport, error) {
-	resp, err := client.Post(scheduledReportAPIPath, data)
+func (client *APIClient) CreateScheduledReport(ctx context.Context, data ScheduledReport) (*ScheduledReport, error) {
+	resp, err := client.PostContext(ctx, scheduledReportAPIPath, data)
 	if err != nil {
 		return nil, err
 	}
@@ -102,8 +103,8 @@ func (client *APIClient) CreateScheduledReport(data ScheduledReport) (*Scheduled
 	return &response.Data, nil
 }
 
-func (client *APIClient) UpdateScheduledReport(id string, data ScheduledReport) (*ScheduledReport, error) {
-	resp, err := client.Patch(fmt.Sprintf("%s/%s", scheduledReportAPIPath, id), data)
+func (client *APIClient) UpdateScheduledReport(ctx context.Context, id string, data ScheduledReport) (*ScheduledReport, error) {
+	resp, err := client.PatchContext(ctx, fmt.Sprintf("%s/%s", scheduledReportAPIPath, id), data)
 	if err != nil {
 		return nil, err
 	}
@@ -115,8 +116,8 @@ func (client *APIClient) UpdateScheduledReport(id string, data ScheduledReport)
 	return &response.Data, nil
 }
 
-func (client *APIClient) DeleteScheduledReport(id string) error {
-	resp, err := client.Delete(fmt.Sprintf("%s/%s", scheduledReportAPIPath, id))
+func (client *APIClient) DeleteScheduledReport(ctx context.Context, id string) error {
+	resp, err := client.DeleteContext(ctx, fmt.Sprintf("%s/%s", scheduledReportAPIPath, id))
 	// already gone on the remote side
 	if resp != nil && resp.StatusCode() == 404 {
 		return nil
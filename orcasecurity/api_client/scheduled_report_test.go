@@ -1,6 +1,7 @@
 package api_client
 
 import (
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -85,7 +86,7 @@ func TestCreateScheduledReport(t *testing.T) {
 
 	apiClient := newTestAPIClient(httpClient)
 	status := ScheduledReportStatusActive
-	report, err := apiClient.CreateScheduledReport(ScheduledReport{
+	report, err := apiClient.CreateScheduledReport(context.Background(), ScheduledReport{
 		Name:             "Weekly open alerts",
 		Type:             "alerts_svl",
 		Format:           "csv",
@@ -127,7 +128,7 @@ func TestGetScheduledReport(t *testing.T) {
 	})}
 
 	apiClient := newTestAPIClient(httpClient)
-	report, err := apiClient.GetScheduledReport(testScheduledReportID)
+	report, err := apiClient.GetScheduledReport(context.Background(), testScheduledReportID)
 
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
@@ -165,7 +166,7 @@ func TestGetScheduledReport_NotFound(t *testing.T) {
 	})}
 
 	apiClient := newTestAPIClient(httpClient)
-	report, err := apiClient.GetScheduledReport("invalid-id")
+	report, err := apiClient.GetScheduledReport(context.Background(), "invalid-id")
 
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
@@ -192,7 +193,7 @@ func TestUpdateScheduledReport(t *testing.T) {
 	})}
 
 	apiClient := newTestAPIClient(httpClient)
-	report, err := apiClient.UpdateScheduledReport(testScheduledReportID, ScheduledReport{
+	report, err := apiClient.UpdateScheduledReport(context.Background(), testScheduledReportID, ScheduledReport{
 		Name:       "Weekly open alerts",
 		Type:       "alerts_svl",
 		Format:     "csv",
@@ -225,7 +226,7 @@ func TestDeleteScheduledReport(t *testing.T) {
 	})}
 
 	apiClient := newTestAPIClient(httpClient)
-	if err := apiClient.DeleteScheduledReport(testScheduledReportID); err != nil {
+	if err := apiClient.DeleteScheduledReport(context.Background(), testScheduledReportID); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 }
@@ -240,7 +241,7 @@ func TestDeleteScheduledReport_AlreadyDeleted(t *testing.T) {
 	})}
 
 	apiClient := newTestAPIClient(httpClient)
-	if err := apiClient.DeleteScheduledReport(testScheduledReportID); err != nil {
+	if err := apiClient.DeleteScheduledReport(context.Background(), testScheduledReportID); err != nil {
 		t.Errorf("expected no error when report is already deleted, got: %v", err)
 	}
 }
@@ -255,7 +256,7 @@ func TestDoesScheduledReportExist_Found(t *testing.T) {
 	})}
 
 	apiClient := newTestAPIClient(httpClient)
-	exists, err := apiClient.DoesScheduledReportExist(testScheduledReportID)
+	exists, err := apiClient.DoesScheduledReportExist(context.Background(), testScheduledReportID)
 
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
@@ -275,7 +276,7 @@ func TestDoesScheduledReportExist_NotFound(t *testing.T) {
 	})}
 
 	apiClient := newTestAPIClient(httpClient)
-	exists, err := apiClient.DoesScheduledReportExist("invalid-id")
+	exists, err := apiClient.DoesScheduledReportExist(context.Background(), "invalid-id")
 
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
@@ -1,6 +1,7 @@
 package api_client
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -28,11 +29,11 @@ type serviceNowITSMSingleResponse struct {
 	Data   ServiceNowITSMResource `json:"data"`
 }
 
-func (client *APIClient) CreateServiceNowITSMResource(payload ServiceNowITSMResource) (*ServiceNowITSMResource, error) {
+func (client *APIClient) CreateServiceNowITSMResource(ctx context.Context, payload ServiceNowITSMResource) (*ServiceNowITSMResource, error) {
 	payload.ServiceName = ServiceNowITSMServiceName
 	payload.Type = ServiceNowITSMResourceType
 
-	resp, err := client.Post("/api/external_service/resources", payload)
+	resp, err := client.PostContext(ctx, "/api/external_service/resources", payload)
 	if err != nil {
 		return nil, err
 	}
@@ -59,8 +60,8 @@ func (client *APIClient) CreateServiceNowITSMResource(payload ServiceNowITSMReso
 // service_name=sn_incidents in the caller's organisation. Use it when looking up an existing
 // resource by its human-friendly “name“ (Orca does not expose a name filter on this
 // endpoint, so the provider does the match client-side).
-func (client *APIClient) ListServiceNowITSMResources() ([]ServiceNowITSMResource, error) {
-	resp, err := client.Get(fmt.Sprintf("/api/external_service/resources?service_name=%s", ServiceNowITSMServiceName))
+func (client *APIClient) ListServiceNowITSMResources(ctx context.Context) ([]ServiceNowITSMResource, error) {
+	resp, err := client.GetContext(ctx, fmt.Sprintf("/api/external_service/resources?service_name=%s", ServiceNowITSMServiceName))
 	if err != nil {
 		return nil, err
 	}
@@ -76,8 +77,8 @@ func (client *APIClient) ListServiceNowITSMResources() ([]ServiceNowITSMResource
 	return wrapped.Data, nil
 }
 
-func (client *APIClient) GetServiceNowITSMResourceByName(name string) (*ServiceNowITSMResource, error) {
-	all, err := client.ListServiceNowITSMResources()
+func (client *APIClient) GetServiceNowITSMResourceByName(ctx context.Context, name string) (*ServiceNowITSMResource, error) {
+	all, err := client.ListServiceNowITSMResources(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -96,8 +97,8 @@ func (client *APIClient) GetServiceNowITSMResourceByName(name string) (*ServiceN
 	return &matches[0], nil
 }
 
-func (client *APIClient) GetServiceNowITSMResource(id string) (*ServiceNowITSMResource, error) {
-	resp, err := client.Get(fmt.Sprintf("/api/external_service/resources/%s", id))
+func (client *APIClient) GetServiceNowITSMResource(ctx context.Context, id string) (*ServiceNowITSMResource, error) {
+	resp, err := client.GetContext(ctx, fmt.Sprintf("/api/external_service/resources/%s", id))
 	if err != nil {
 		if resp != nil && resp.StatusCode() == 404 {
 			return nil, nil
@@ -121,11 +122,11 @@ func (client *APIClient) GetServiceNowITSMResource(id string) (*ServiceNowITSMRe
 	return &response.Data, nil
 }
 
-func (client *APIClient) UpdateServiceNowITSMResource(id string, payload ServiceNowITSMResource) (*ServiceNowITSMResource, error) {
+func (client *APIClient) UpdateServiceNowITSMResource(ctx context.Context, id string, payload ServiceNowITSMResource) (*ServiceNowITSMResource, error) {
 	payload.ServiceName = ServiceNowITSMServiceName
 	payload.Type = ServiceNowITSMResourceType
 
-	resp, err := client.Put(fmt.Sprintf("/api/external_service/resources/%s", id), payload)
+	resp, err := client.PutContext(ctx, fmt.Sprintf("/api/external_service/resources/%s", id), payload)
 	if err != nil {
 		return nil, err
 	}
@@ -146,7 +147,7 @@ func (client *APIClient) UpdateServiceNowITSMResource(id string, payload Service
 	return &response.Data, nil
 }
 
-func (client *APIClient) DeleteServiceNowITSMResource(id string) error {
-	_, err := client.Delete(fmt.Sprintf("/api/external_service/resources/%s", id))
+func (client *APIClient) DeleteServiceNowITSMResource(ctx context.Context, id string) error {
+	_, err := client.DeleteContext(ctx, fmt.Sprintf("/api/external_service/resources/%s", id))
 	return err
 }
@@ -1,6 +1,7 @@
 package api_client
 
 import (
+	"context"
 	"encoding/json"
 )
 
@@ -30,21 +31,29 @@ type ServiceNowITSMTemplate = ConfigEnvelope[ServiceNowITSMTemplateConfig]
 
 const ServiceNowITSMTemplateConfigType = "ITSM"
 
-func (client *APIClient) CreateServiceNowITSMTemplate(payload ServiceNowITSMTemplate) (*ServiceNowITSMTemplate, error) {
+func (client *APIClient) CreateServiceNowITSMTemplate(ctx context.Context, payload ServiceNowITSMTemplate) (*ServiceNowITSMTemplate, error) {
 	payload.Config.Type = ServiceNowITSMTemplateConfigType
-	return CreateExternalServiceConfig[ServiceNowITSMTemplateConfig](client, ServiceNowITSMServiceName, payload)
+	return CreateExternalServiceConfig[ServiceNowITSMTemplateConfig](ctx, client, ServiceNowITSMServiceName, payload)
 }
 
 // GetServiceNowITSMTemplate looks up a template by name and filters on “config.type == "ITSM"“
 // (or the legacy unset value) so it does not collide with a SIR template that happens to
 // share a template_name.
-func (client *APIClient) GetServiceNowITSMTemplate(templateName string) (*ServiceNowITSMTemplate, error) {
-	return GetExternalServiceConfig[ServiceNowITSMTemplateConfig](client, ServiceNowITSMServiceName, templateName, func(item *ServiceNowITSMTemplate) bool {
+func (client *APIClient) GetServiceNowITSMTemplate(ctx context.Context, templateName string) (*ServiceNowITSMTemplate, error) {
+	return GetExternalServiceConfig[ServiceNowITSMTemplateConfig](ctx, client, ServiceNowITSMServiceName, templateName, func(item *ServiceNowITSMTemplate) bool {
 		return item.Config.Type == "" || item.Config.Type == ServiceNowITSMTemplateConfigType
 	})
 }
 
-func (client *APIClient) UpdateServiceNowITSMTemplate(templateName string, payload ServiceNowITSMTemplate) (*ServiceNowITSMTemplate, error) {
+// ListServiceNowITSMTemplates returns the sn_incidents configs that are ITSM templates, using the
+// same type filter as GetServiceNowITSMTemplate.
+func (client *APIClient) ListServiceNowITSMTemplates(ctx context.Context) ([]ServiceNowITSMTemplate, error) {
+	return ListExternalServiceConfigs[ServiceNowITSMTemplateConfig](ctx, client, ServiceNowITSMServiceName, func(item *ServiceNowITSMTemplate) bool {
+		return item.Config.Type == "" || item.Config.Type == ServiceNowITSMTemplateConfigType
+	})
+}
+
+func (client *APIClient) UpdateServiceNowITSMTemplate(ctx context.Context, templateName string, payload ServiceNowITSMTemplate) (*ServiceNowITSMTemplate, error) {
 	payload.Config.Type = ServiceNowITSMTemplateConfigType
 	// Intentionally omit ``business_units`` from PUT bodies — Orca's external_service/config
 	// endpoint rejects updates with ``"You can't change business units"``. Changing the set is
@@ -53,9 +62,9 @@ func (client *APIClient) UpdateServiceNowITSMTemplate(templateName string, paylo
 	if payload.Resource != "" {
 		body["resource"] = payload.Resource
 	}
-	return UpdateExternalServiceConfig[ServiceNowITSMTemplateConfig](client, ServiceNowITSMServiceName, templateName, body)
+	return UpdateExternalServiceConfig[ServiceNowITSMTemplateConfig](ctx, client, ServiceNowITSMServiceName, templateName, body)
 }
 
-func (client *APIClient) DeleteServiceNowITSMTemplate(templateName string) error {
-	return DeleteExternalServiceConfig(client, ServiceNowITSMServiceName, templateName)
+func (client *APIClient) DeleteServiceNowITSMTemplate(ctx context.Context, templateName string) error {
+	return DeleteExternalServiceConfig(ctx, client, ServiceNowITSMServiceName, templateName)
 }
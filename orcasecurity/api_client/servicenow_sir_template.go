@@ -1,6 +1,7 @@
 package api_client
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 )
@@ -12,20 +13,27 @@ import (
 
 const ServiceNowSIRTemplateConfigType = "SIR"
 
-func (client *APIClient) CreateServiceNowSIRTemplate(payload ServiceNowITSMTemplate) (*ServiceNowITSMTemplate, error) {
+func (client *APIClient) CreateServiceNowSIRTemplate(ctx context.Context, payload ServiceNowITSMTemplate) (*ServiceNowITSMTemplate, error) {
 	payload.Config.Type = ServiceNowSIRTemplateConfigType
-	return CreateExternalServiceConfig[ServiceNowITSMTemplateConfig](client, ServiceNowITSMServiceName, payload)
+	return CreateExternalServiceConfig[ServiceNowITSMTemplateConfig](ctx, client, ServiceNowITSMServiceName, payload)
 }
 
 // GetServiceNowSIRTemplate looks up a template by name and filters on “config.type == "SIR"“
 // so it does not collide with an ITSM template that happens to share a template_name.
-func (client *APIClient) GetServiceNowSIRTemplate(templateName string) (*ServiceNowITSMTemplate, error) {
-	return GetExternalServiceConfig[ServiceNowITSMTemplateConfig](client, ServiceNowITSMServiceName, templateName, func(item *ServiceNowITSMTemplate) bool {
+func (client *APIClient) GetServiceNowSIRTemplate(ctx context.Context, templateName string) (*ServiceNowITSMTemplate, error) {
+	return GetExternalServiceConfig[ServiceNowITSMTemplateConfig](ctx, client, ServiceNowITSMServiceName, templateName, func(item *ServiceNowITSMTemplate) bool {
 		return item.Config.Type == ServiceNowSIRTemplateConfigType
 	})
 }
 
-func (client *APIClient) UpdateServiceNowSIRTemplate(templateName string, payload ServiceNowITSMTemplate) (*ServiceNowITSMTemplate, error) {
+// ListServiceNowSIRTemplates returns the sn_incidents configs that are SIR templates.
+func (client *APIClient) ListServiceNowSIRTemplates(ctx context.Context) ([]ServiceNowITSMTemplate, error) {
+	return ListExternalServiceConfigs[ServiceNowITSMTemplateConfig](ctx, client, ServiceNowITSMServiceName, func(item *ServiceNowITSMTemplate) bool {
+		return item.Config.Type == ServiceNowSIRTemplateConfigType
+	})
+}
+
+func (client *APIClient) UpdateServiceNowSIRTemplate(ctx context.Context, templateName string, payload ServiceNowITSMTemplate) (*ServiceNowITSMTemplate, error) {
 	payload.Config.Type = ServiceNowSIRTemplateConfigType
 	// ``business_units`` is intentionally omitted from PUT — Orca rejects updates with
 	// "You can't change business units". Matches the ITSM template behaviour.
@@ -33,11 +41,11 @@ func (client *APIClient) UpdateServiceNowSIRTemplate(templateName string, payloa
 	if payload.Resource != "" {
 		body["resource"] = payload.Resource
 	}
-	return UpdateExternalServiceConfig[ServiceNowITSMTemplateConfig](client, ServiceNowITSMServiceName, templateName, body)
+	return UpdateExternalServiceConfig[ServiceNowITSMTemplateConfig](ctx, client, ServiceNowITSMServiceName, templateName, body)
 }
 
-func (client *APIClient) DeleteServiceNowSIRTemplate(templateName string) error {
-	return DeleteExternalServiceConfig(client, ServiceNowITSMServiceName, templateName)
+func (client *APIClient) DeleteServiceNowSIRTemplate(ctx context.Context, templateName string) error {
+	return DeleteExternalServiceConfig(ctx, client, ServiceNowITSMServiceName, templateName)
 }
 
 // ServiceNowSchemaField is a single field exposed by Orca's ServiceNow schema endpoint.
@@ -62,8 +70,8 @@ type serviceNowSchemaResponse struct {
 // snType selects the table variant ("sir" → sn_si_incident, "itsm" → incident). SIR and ITSM
 // share the same credentials resource but map to different tables, so their field sets differ.
 // Mirrors GET /api/resources/{resource_id}/service_now/{sn_type}/schema.
-func (client *APIClient) GetServiceNowSchema(resourceID, snType string) ([]ServiceNowSchemaField, error) {
-	resp, err := client.Get(fmt.Sprintf("/api/resources/%s/service_now/%s/schema", url.PathEscape(resourceID), snType))
+func (client *APIClient) GetServiceNowSchema(ctx context.Context, resourceID, snType string) ([]ServiceNowSchemaField, error) {
+	resp, err := client.GetContext(ctx, fmt.Sprintf("/api/resources/%s/service_now/%s/schema", url.PathEscape(resourceID), snType))
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,7 @@
 package api_client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -28,8 +29,8 @@ type ShiftLeftCveExceptionList struct {
 	Projects        []Project       `json:"projects,omitempty"`
 }
 
-func (client *APIClient) GetShiftLeftCveExceptionList(id string) (*ShiftLeftCveExceptionList, error) {
-	resp, err := client.Get(fmt.Sprintf("/api/shiftleft/exceptions/%s/", id))
+func (client *APIClient) GetShiftLeftCveExceptionList(ctx context.Context, id string) (*ShiftLeftCveExceptionList, error) {
+	resp, err := client.GetContext(ctx, fmt.Sprintf("/api/shiftleft/exceptions/%s/", id))
 	if err != nil {
 		return nil, err
 	}
@@ -46,13 +47,13 @@ func (client *APIClient) GetShiftLeftCveExceptionList(id string) (*ShiftLeftCveE
 	return &response, nil
 }
 
-func (client *APIClient) DoesShiftLeftCveExceptionListExist(id string) (bool, error) {
-	resp, _ := client.Head(fmt.Sprintf("/api/shiftleft/exceptions/%s/", id))
+func (client *APIClient) DoesShiftLeftCveExceptionListExist(ctx context.Context, id string) (bool, error) {
+	resp, _ := client.HeadContext(ctx, fmt.Sprintf("/api/shiftleft/exceptions/%s/", id))
 	return resp.StatusCode() == 200, nil
 }
 
-func (client *APIClient) CreateShiftLeftCveExceptionList(data ShiftLeftCveExceptionList) (*ShiftLeftCveExceptionList, error) {
-	resp, err := client.Post("/api/shiftleft/exceptions/", data)
+func (client *APIClient) CreateShiftLeftCveExceptionList(ctx context.Context, data ShiftLeftCveExceptionList) (*ShiftLeftCveExceptionList, error) {
+	resp, err := client.PostContext(ctx, "/api/shiftleft/exceptions/", data)
 	if err != nil {
 		return nil, err
 	}
@@ -69,8 +70,8 @@ func (client *APIClient) CreateShiftLeftCveExceptionList(data ShiftLeftCveExcept
 	return &response, nil
 }
 
-func (client *APIClient) UpdateShiftLeftCveExceptionList(ID string, data ShiftLeftCveExceptionList) (*ShiftLeftCveExceptionList, error) {
-	resp, err := client.Put(fmt.Sprintf("/api/shiftleft/exceptions/%s/", ID), data)
+func (client *APIClient) UpdateShiftLeftCveExceptionList(ctx context.Context, ID string, data ShiftLeftCveExceptionList) (*ShiftLeftCveExceptionList, error) {
+	resp, err := client.PutContext(ctx, fmt.Sprintf("/api/shiftleft/exceptions/%s/", ID), data)
 	if err != nil {
 		return nil, err
 	}
@@ -83,7 +84,7 @@ func (client *APIClient) UpdateShiftLeftCveExceptionList(ID string, data ShiftLe
 	return &response, nil
 }
 
-func (client *APIClient) DeleteShiftLeftCveExceptionList(ID string) error {
-	_, err := client.Delete(fmt.Sprintf("/api/shiftleft/exceptions/%s/", ID))
+func (client *APIClient) DeleteShiftLeftCveExceptionList(ctx context.Context, ID string) error {
+	_, err := client.DeleteContext(ctx, fmt.Sprintf("/api/shiftleft/exceptions/%s/", ID))
 	return err
 }
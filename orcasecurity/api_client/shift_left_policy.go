@@ -1,6 +1,7 @@
 package api_client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -48,8 +49,8 @@ func shiftLeftPolicyCatalogPath(policyType string) string {
 	return fmt.Sprintf("/api/shiftleft/%s/catalog/controls", ShiftLeftPolicyTypePath(policyType))
 }
 
-func (client *APIClient) GetShiftLeftPolicy(policyType, id string) (*ShiftLeftPolicy, error) {
-	resp, err := client.Get(shiftLeftPolicyItemPath(policyType, id))
+func (client *APIClient) GetShiftLeftPolicy(ctx context.Context, policyType, id string) (*ShiftLeftPolicy, error) {
+	resp, err := client.GetContext(ctx, shiftLeftPolicyItemPath(policyType, id))
 	if err != nil {
 		return nil, err
 	}
@@ -66,13 +67,13 @@ func (client *APIClient) GetShiftLeftPolicy(policyType, id string) (*ShiftLeftPo
 	return &response, nil
 }
 
-func (client *APIClient) DoesShiftLeftPolicyExist(policyType, id string) (bool, error) {
-	resp, _ := client.Head(shiftLeftPolicyItemPath(policyType, id))
+func (client *APIClient) DoesShiftLeftPolicyExist(ctx context.Context, policyType, id string) (bool, error) {
+	resp, _ := client.HeadContext(ctx, shiftLeftPolicyItemPath(policyType, id))
 	return resp.StatusCode() == 200, nil
 }
 
-func (client *APIClient) CreateShiftLeftPolicy(policyType string, policy ShiftLeftPolicy) (*ShiftLeftPolicy, error) {
-	resp, err := client.Post(shiftLeftPolicyBasePath(policyType), policy)
+func (client *APIClient) CreateShiftLeftPolicy(ctx context.Context, policyType string, policy ShiftLeftPolicy) (*ShiftLeftPolicy, error) {
+	resp, err := client.PostContext(ctx, shiftLeftPolicyBasePath(policyType), policy)
 	if err != nil {
 		return nil, err
 	}
@@ -85,8 +86,8 @@ func (client *APIClient) CreateShiftLeftPolicy(policyType string, policy ShiftLe
 	return &response, nil
 }
 
-func (client *APIClient) UpdateShiftLeftPolicy(policyType, id string, policy ShiftLeftPolicy) (*ShiftLeftPolicy, error) {
-	resp, err := client.Put(shiftLeftPolicyItemPath(policyType, id), policy)
+func (client *APIClient) UpdateShiftLeftPolicy(ctx context.Context, policyType, id string, policy ShiftLeftPolicy) (*ShiftLeftPolicy, error) {
+	resp, err := client.PutContext(ctx, shiftLeftPolicyItemPath(policyType, id), policy)
 	if err != nil {
 		return nil, err
 	}
@@ -99,13 +100,13 @@ func (client *APIClient) UpdateShiftLeftPolicy(policyType, id string, policy Shi
 	return &response, nil
 }
 
-func (client *APIClient) DeleteShiftLeftPolicy(policyType, id string) error {
-	_, err := client.Delete(shiftLeftPolicyItemPath(policyType, id))
+func (client *APIClient) DeleteShiftLeftPolicy(ctx context.Context, policyType, id string) error {
+	_, err := client.DeleteContext(ctx, shiftLeftPolicyItemPath(policyType, id))
 	return err
 }
 
-func (client *APIClient) GetShiftLeftPolicyCatalogControls(policyType string) (*ShiftLeftPolicyCatalogControls, error) {
-	resp, err := client.Get(shiftLeftPolicyCatalogPath(policyType))
+func (client *APIClient) GetShiftLeftPolicyCatalogControls(ctx context.Context, policyType string) (*ShiftLeftPolicyCatalogControls, error) {
+	resp, err := client.getCached(ctx, shiftLeftPolicyCatalogPath(policyType))
 	if err != nil {
 		return nil, err
 	}
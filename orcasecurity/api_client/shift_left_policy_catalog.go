@@ -1,6 +1,7 @@
 package api_client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"sort"
@@ -206,8 +207,8 @@ func enrichControlsInMap(data map[string]interface{}, index catalogControlIndex)
 }
 
 // EnrichShiftLeftPolicyFromCatalog fills missing control fields from the policy type catalog.
-func (client *APIClient) EnrichShiftLeftPolicyFromCatalog(policyType string, policy *ShiftLeftPolicy) error {
-	catalog, err := client.GetShiftLeftPolicyCatalogControls(policyType)
+func (client *APIClient) EnrichShiftLeftPolicyFromCatalog(ctx context.Context, policyType string, policy *ShiftLeftPolicy) error {
+	catalog, err := client.GetShiftLeftPolicyCatalogControls(ctx, policyType)
 	if err != nil {
 		return err
 	}
@@ -361,12 +362,12 @@ func injectScopeControls(policyData map[string]interface{}, scopeKey string, ent
 // AddAllCatalogControls injects every catalog control for the given scopes into the
 // policy. Use scope "" for non-grouped policy types (controls live at the top level);
 // for container_image pass the feature scope names (e.g. "vulnerabilities").
-func (client *APIClient) AddAllCatalogControls(policyType string, policy *ShiftLeftPolicy, scopeKeys []string) error {
+func (client *APIClient) AddAllCatalogControls(ctx context.Context, policyType string, policy *ShiftLeftPolicy, scopeKeys []string) error {
 	if len(scopeKeys) == 0 {
 		return nil
 	}
 
-	catalog, err := client.GetShiftLeftPolicyCatalogControls(policyType)
+	catalog, err := client.GetShiftLeftPolicyCatalogControls(ctx, policyType)
 	if err != nil {
 		return err
 	}
@@ -1,6 +1,7 @@
 package api_client
 
 import (
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -43,7 +44,7 @@ func TestEnrichShiftLeftPolicyFromCatalog(t *testing.T) {
 		}),
 	}
 
-	if err := client.EnrichShiftLeftPolicyFromCatalog("iac", &policy); err != nil {
+	if err := client.EnrichShiftLeftPolicyFromCatalog(context.Background(), "iac", &policy); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 
@@ -81,7 +82,7 @@ func TestEnrichShiftLeftPolicyFromCatalog_UnknownControl(t *testing.T) {
 		}),
 	}
 
-	err := client.EnrichShiftLeftPolicyFromCatalog("iac", &policy)
+	err := client.EnrichShiftLeftPolicyFromCatalog(context.Background(), "iac", &policy)
 	if err == nil || !strings.Contains(err.Error(), "unknown control id") {
 		t.Fatalf("expected unknown control error, got %v", err)
 	}
@@ -101,7 +102,7 @@ func TestAddAllCatalogControls_FlatScope(t *testing.T) {
 
 	client := APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
 	policy := ShiftLeftPolicy{}
-	if err := client.AddAllCatalogControls("iac", &policy, []string{""}); err != nil {
+	if err := client.AddAllCatalogControls(context.Background(), "iac", &policy, []string{""}); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 
@@ -143,7 +144,7 @@ func TestAddAllCatalogControls_ContainerScope(t *testing.T) {
 
 	client := APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
 	policy := ShiftLeftPolicy{}
-	if err := client.AddAllCatalogControls("container_image", &policy, []string{"vulnerabilities"}); err != nil {
+	if err := client.AddAllCatalogControls(context.Background(), "container_image", &policy, []string{"vulnerabilities"}); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 
@@ -174,7 +175,7 @@ func TestAddAllCatalogControls_ContainerScope(t *testing.T) {
 func TestAddAllCatalogControls_NoScopes(t *testing.T) {
 	client := APIClient{APIEndpoint: "http://localhost", APIToken: "secret"}
 	policy := ShiftLeftPolicy{}
-	if err := client.AddAllCatalogControls("iac", &policy, nil); err != nil {
+	if err := client.AddAllCatalogControls(context.Background(), "iac", &policy, nil); err != nil {
 		t.Fatalf("expected no error and no API call for empty scopes, got: %v", err)
 	}
 	if policy.Controls != nil || policy.PolicyData != nil {
@@ -1,6 +1,7 @@
 package api_client
 
 import (
+	"context"
 	"io"
 	"net/http"
 	"strings"
@@ -31,7 +32,7 @@ func TestGetShiftLeftPolicy(t *testing.T) {
 	})}
 
 	client := APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	policy, err := client.GetShiftLeftPolicy("iac", "policy-123")
+	policy, err := client.GetShiftLeftPolicy(context.Background(), "iac", "policy-123")
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -52,7 +53,7 @@ func TestCreateShiftLeftPolicy_ScmPosture(t *testing.T) {
 	})}
 
 	client := APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	policy, err := client.CreateShiftLeftPolicy("scm_posture", ShiftLeftPolicy{Name: "scm policy"})
+	policy, err := client.CreateShiftLeftPolicy(context.Background(), "scm_posture", ShiftLeftPolicy{Name: "scm policy"})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -73,7 +74,7 @@ func TestDeleteShiftLeftPolicy(t *testing.T) {
 	})}
 
 	client := APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	err := client.DeleteShiftLeftPolicy("container_image", "ci-1")
+	err := client.DeleteShiftLeftPolicy(context.Background(), "container_image", "ci-1")
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -94,7 +95,7 @@ func TestDoesShiftLeftPolicyExist(t *testing.T) {
 	})}
 
 	client := APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	exists, err := client.DoesShiftLeftPolicyExist("iac", "policy-123")
+	exists, err := client.DoesShiftLeftPolicyExist(context.Background(), "iac", "policy-123")
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -112,7 +113,7 @@ func TestDoesShiftLeftPolicyExist_NotFound(t *testing.T) {
 	})}
 
 	client := APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	exists, err := client.DoesShiftLeftPolicyExist("iac", "missing")
+	exists, err := client.DoesShiftLeftPolicyExist(context.Background(), "iac", "missing")
 	if err != nil {
 		t.Fatalf("expected no error on 404 so the plan recreates the resource, got: %v", err)
 	}
@@ -136,7 +137,7 @@ func TestUpdateShiftLeftPolicy(t *testing.T) {
 	})}
 
 	client := APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	policy, err := client.UpdateShiftLeftPolicy("iac", "policy-123", ShiftLeftPolicy{Name: "updated"})
+	policy, err := client.UpdateShiftLeftPolicy(context.Background(), "iac", "policy-123", ShiftLeftPolicy{Name: "updated"})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -157,7 +158,7 @@ func TestGetShiftLeftPolicyCatalogControls(t *testing.T) {
 	})}
 
 	client := APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	catalog, err := client.GetShiftLeftPolicyCatalogControls("iac")
+	catalog, err := client.GetShiftLeftPolicyCatalogControls(context.Background(), "iac")
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -1,6 +1,7 @@
 package api_client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -45,8 +46,8 @@ func hasBuiltinPolicy(policies []ShiftLeftProjectPolicy) bool {
 
 // GetShiftLeftProject returns the project or an error — never (nil, nil):
 // the underlying client errors on any non-OK response, including 404.
-func (client *APIClient) GetShiftLeftProject(id string) (*ShiftLeftProject, error) {
-	resp, err := client.Get(fmt.Sprintf("/api/shiftleft/projects/%s/", id))
+func (client *APIClient) GetShiftLeftProject(ctx context.Context, id string) (*ShiftLeftProject, error) {
+	resp, err := client.GetContext(ctx, fmt.Sprintf("/api/shiftleft/projects/%s/", id))
 	if err != nil {
 		return nil, err
 	}
@@ -62,13 +63,13 @@ func (client *APIClient) GetShiftLeftProject(id string) (*ShiftLeftProject, erro
 	return &response, nil
 }
 
-func (client *APIClient) DoesShiftLeftProjectExist(id string) (bool, error) {
-	resp, _ := client.Head(fmt.Sprintf("/api/shiftleft/projects/%s/", id))
+func (client *APIClient) DoesShiftLeftProjectExist(ctx context.Context, id string) (bool, error) {
+	resp, _ := client.HeadContext(ctx, fmt.Sprintf("/api/shiftleft/projects/%s/", id))
 	return resp.StatusCode() == 200, nil
 }
 
-func (client *APIClient) CreateShiftLeftProject(shift_left_project ShiftLeftProject) (*ShiftLeftProject, error) {
-	resp, err := client.Post("/api/shiftleft/projects/", shift_left_project)
+func (client *APIClient) CreateShiftLeftProject(ctx context.Context, shift_left_project ShiftLeftProject) (*ShiftLeftProject, error) {
+	resp, err := client.PostContext(ctx, "/api/shiftleft/projects/", shift_left_project)
 	if err != nil {
 		return nil, err
 	}
@@ -81,8 +82,8 @@ func (client *APIClient) CreateShiftLeftProject(shift_left_project ShiftLeftProj
 	return &response, nil
 }
 
-func (client *APIClient) UpdateShiftLeftProject(ID string, data ShiftLeftProject) (*ShiftLeftProject, error) {
-	resp, err := client.Put(fmt.Sprintf("/api/shiftleft/projects/%s/", ID), data)
+func (client *APIClient) UpdateShiftLeftProject(ctx context.Context, ID string, data ShiftLeftProject) (*ShiftLeftProject, error) {
+	resp, err := client.PutContext(ctx, fmt.Sprintf("/api/shiftleft/projects/%s/", ID), data)
 	if err != nil {
 		return nil, err
 	}
@@ -95,7 +96,7 @@ func (client *APIClient) UpdateShiftLeftProject(ID string, data ShiftLeftProject
 	return &response, nil
 }
 
-func (client *APIClient) DeleteShiftLeftProject(ID string) error {
-	_, err := client.Delete(fmt.Sprintf("/api/shiftleft/projects/%s/", ID))
+func (client *APIClient) DeleteShiftLeftProject(ctx context.Context, ID string) error {
+	_, err := client.DeleteContext(ctx, fmt.Sprintf("/api/shiftleft/projects/%s/", ID))
 	return err
 }
@@ -1,6 +1,7 @@
 package api_client
 
 import (
+	"context"
 	"io"
 	"net/http"
 	"strings"
@@ -38,7 +39,7 @@ func TestGetShiftLeftProject_ReconstructsDefaultPolicies(t *testing.T) {
 	})}
 
 	client := APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	project, err := client.GetShiftLeftProject("proj-1")
+	project, err := client.GetShiftLeftProject(context.Background(), "proj-1")
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -62,7 +63,7 @@ func TestGetShiftLeftProject_NoBuiltinPolicies(t *testing.T) {
 	})}
 
 	client := APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	project, err := client.GetShiftLeftProject("proj-1")
+	project, err := client.GetShiftLeftProject(context.Background(), "proj-1")
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -82,7 +83,7 @@ func TestGetShiftLeftProject_NotFoundIsError(t *testing.T) {
 	})}
 
 	client := APIClient{APIEndpoint: "http://localhost", APIToken: "secret", HTTPClient: httpClient}
-	project, err := client.GetShiftLeftProject("missing")
+	project, err := client.GetShiftLeftProject(context.Background(), "missing")
 	if err == nil {
 		t.Fatal("expected an error on 404")
 	}
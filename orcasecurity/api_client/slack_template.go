@@ -1,6 +1,7 @@
 package api_client
 
 import (
+	"context"
 	"encoding/json"
 )
 
@@ -25,21 +26,25 @@ type SlackConfig struct {
 // envelope's Resource field is left empty.
 type SlackTemplate = ConfigEnvelope[SlackConfig]
 
-func (client *APIClient) CreateSlackTemplate(payload SlackTemplate) (*SlackTemplate, error) {
-	return CreateExternalServiceConfig[SlackConfig](client, SlackServiceName, payload)
+func (client *APIClient) CreateSlackTemplate(ctx context.Context, payload SlackTemplate) (*SlackTemplate, error) {
+	return CreateExternalServiceConfig[SlackConfig](ctx, client, SlackServiceName, payload)
 }
 
-func (client *APIClient) GetSlackTemplate(templateName string) (*SlackTemplate, error) {
-	return GetExternalServiceConfig[SlackConfig](client, SlackServiceName, templateName, nil)
+func (client *APIClient) GetSlackTemplate(ctx context.Context, templateName string) (*SlackTemplate, error) {
+	return GetExternalServiceConfig[SlackConfig](ctx, client, SlackServiceName, templateName, nil)
 }
 
-func (client *APIClient) UpdateSlackTemplate(templateName string, payload SlackTemplate) (*SlackTemplate, error) {
+func (client *APIClient) ListSlackTemplates(ctx context.Context) ([]SlackTemplate, error) {
+	return ListExternalServiceConfigs[SlackConfig](ctx, client, SlackServiceName, nil)
+}
+
+func (client *APIClient) UpdateSlackTemplate(ctx context.Context, templateName string, payload SlackTemplate) (*SlackTemplate, error) {
 	// business_units intentionally omitted — Orca rejects BU changes on update for slack;
 	// modelled as RequiresReplace on the Terraform side.
 	body := BuildUpdateBody(payload, payload.Config, false)
-	return UpdateExternalServiceConfig[SlackConfig](client, SlackServiceName, templateName, body)
+	return UpdateExternalServiceConfig[SlackConfig](ctx, client, SlackServiceName, templateName, body)
 }
 
-func (client *APIClient) DeleteSlackTemplate(templateName string) error {
-	return DeleteExternalServiceConfig(client, SlackServiceName, templateName)
+func (client *APIClient) DeleteSlackTemplate(ctx context.Context, templateName string) error {
+	return DeleteExternalServiceConfig(ctx, client, SlackServiceName, templateName)
 }
@@ -1,5 +1,7 @@
 package api_client
 
+import "context"
+
 const SnykServiceName = "snyk"
 
 type SnykConfig struct {
@@ -9,15 +11,19 @@ type SnykConfig struct {
 
 type SnykExternalServiceConfig = ConfigEnvelope[SnykConfig]
 
-func (client *APIClient) CreateSnykConfig(payload SnykExternalServiceConfig) (*SnykExternalServiceConfig, error) {
-	return CreateExternalServiceConfig[SnykConfig](client, SnykServiceName, payload)
+func (client *APIClient) CreateSnykConfig(ctx context.Context, payload SnykExternalServiceConfig) (*SnykExternalServiceConfig, error) {
+	return CreateExternalServiceConfig[SnykConfig](ctx, client, SnykServiceName, payload)
+}
+
+func (client *APIClient) GetSnykConfig(ctx context.Context, templateName string) (*SnykExternalServiceConfig, error) {
+	return GetExternalServiceConfig[SnykConfig](ctx, client, SnykServiceName, templateName, nil)
 }
 
-func (client *APIClient) GetSnykConfig(templateName string) (*SnykExternalServiceConfig, error) {
-	return GetExternalServiceConfig[SnykConfig](client, SnykServiceName, templateName, nil)
+func (client *APIClient) ListSnykConfigs(ctx context.Context) ([]SnykExternalServiceConfig, error) {
+	return ListExternalServiceConfigs[SnykConfig](ctx, client, SnykServiceName, nil)
 }
 
-func (client *APIClient) UpdateSnykConfig(templateName string, payload SnykExternalServiceConfig) (*SnykExternalServiceConfig, error) {
+func (client *APIClient) UpdateSnykConfig(ctx context.Context, templateName string, payload SnykExternalServiceConfig) (*SnykExternalServiceConfig, error) {
 	cfg := map[string]interface{}{}
 	if payload.Config.APIToken != "" {
 		cfg["api_token"] = payload.Config.APIToken
@@ -25,9 +31,9 @@ func (client *APIClient) UpdateSnykConfig(templateName string, payload SnykExter
 	if payload.Config.Region != "" {
 		cfg["region"] = payload.Config.Region
 	}
-	return UpdateExternalServiceConfig[SnykConfig](client, SnykServiceName, templateName, BuildUpdateBody(payload, cfg, false))
+	return UpdateExternalServiceConfig[SnykConfig](ctx, client, SnykServiceName, templateName, BuildUpdateBody(payload, cfg, false))
 }
 
-func (client *APIClient) DeleteSnykConfig(templateName string) error {
-	return DeleteExternalServiceConfig(client, SnykServiceName, templateName)
+func (client *APIClient) DeleteSnykConfig(ctx context.Context, templateName string) error {
+	return DeleteExternalServiceConfig(ctx, client, SnykServiceName, templateName)
 }
@@ -0,0 +1,62 @@
+package api_client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+)
+
+// Kinds of SonarQueryIssue reported by the query parser.
+const (
+	SonarIssueSyntax       = "syntax"
+	SonarIssueUnknownModel = "unknown_model"
+	SonarIssueUnknownField = "unknown_field"
+)
+
+// SonarQueryIssue is one problem the query parser found. Model and Field name the offending
+// identifier for unknown_model / unknown_field issues; Position is the 0-based character offset
+// of a syntax error in a DSL query, when the parser gives one.
+type SonarQueryIssue struct {
+	Kind     string `json:"type"`
+	Message  string `json:"message"`
+	Model    string `json:"model,omitempty"`
+	Field    string `json:"field,omitempty"`
+	Position *int   `json:"position,omitempty"`
+}
+
+// ErrSonarValidationUnavailable is returned by ValidateSonarQuery when the backend has no query
+// validation endpoint; callers skip plan-time validation rather than failing the plan.
+var ErrSonarValidationUnavailable = errors.New("sonar query validation is not available on this Orca backend")
+
+// ValidateSonarQuery parses query without running it. query is either a Sonar DSL string (the
+// `rule` of a custom sonar alert) or a decoded JSON query (an automation's sonar_query). A nil
+// slice means the query is valid; a query the parser rejects without saying why gets a single
+// generic issue, so it is never mistaken for a valid one.
+func (client *APIClient) ValidateSonarQuery(ctx context.Context, query any) ([]SonarQueryIssue, error) {
+	type responseType struct {
+		Data struct {
+			Valid  bool              `json:"valid"`
+			Errors []SonarQueryIssue `json:"errors"`
+		} `json:"data"`
+	}
+	resp, err := client.PostContext(asQuery(ctx), "/api/sonar/query/validate", map[string]any{"query": query})
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && (apiErr.StatusCode == http.StatusNotFound || apiErr.StatusCode == http.StatusNotImplemented) {
+		return nil, ErrSonarValidationUnavailable
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	response := responseType{}
+	if err = resp.ReadJSON(&response); err != nil {
+		return nil, err
+	}
+	if response.Data.Valid {
+		return nil, nil
+	}
+	if len(response.Data.Errors) == 0 {
+		return []SonarQueryIssue{{Message: "query is invalid"}}, nil
+	}
+	return response.Data.Errors, nil
+}
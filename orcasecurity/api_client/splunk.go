@@ -1,5 +1,7 @@
 package api_client
 
+import "context"
+
 const SplunkServiceName = "splunk"
 
 type SplunkConfig struct {
@@ -10,15 +12,19 @@ type SplunkConfig struct {
 
 type SplunkExternalServiceConfig = ConfigEnvelope[SplunkConfig]
 
-func (client *APIClient) CreateSplunkConfig(payload SplunkExternalServiceConfig) (*SplunkExternalServiceConfig, error) {
-	return CreateExternalServiceConfig[SplunkConfig](client, SplunkServiceName, payload)
+func (client *APIClient) CreateSplunkConfig(ctx context.Context, payload SplunkExternalServiceConfig) (*SplunkExternalServiceConfig, error) {
+	return CreateExternalServiceConfig[SplunkConfig](ctx, client, SplunkServiceName, payload)
+}
+
+func (client *APIClient) GetSplunkConfig(ctx context.Context, templateName string) (*SplunkExternalServiceConfig, error) {
+	return GetExternalServiceConfig[SplunkConfig](ctx, client, SplunkServiceName, templateName, nil)
 }
 
-func (client *APIClient) GetSplunkConfig(templateName string) (*SplunkExternalServiceConfig, error) {
-	return GetExternalServiceConfig[SplunkConfig](client, SplunkServiceName, templateName, nil)
+func (client *APIClient) ListSplunkConfigs(ctx context.Context) ([]SplunkExternalServiceConfig, error) {
+	return ListExternalServiceConfigs[SplunkConfig](ctx, client, SplunkServiceName, nil)
 }
 
-func (client *APIClient) UpdateSplunkConfig(templateName string, payload SplunkExternalServiceConfig) (*SplunkExternalServiceConfig, error) {
+func (client *APIClient) UpdateSplunkConfig(ctx context.Context, templateName string, payload SplunkExternalServiceConfig) (*SplunkExternalServiceConfig, error) {
 	cfg := map[string]interface{}{
 		"allow_self_signed_cert": payload.Config.AllowSelfSignedCert,
 	}
@@ -28,9 +34,9 @@ func (client *APIClient) UpdateSplunkConfig(templateName string, payload SplunkE
 	if payload.Config.Token != "" {
 		cfg["token"] = payload.Config.Token
 	}
-	return UpdateExternalServiceConfig[SplunkConfig](client, SplunkServiceName, templateName, BuildUpdateBody(payload, cfg, false))
+	return UpdateExternalServiceConfig[SplunkConfig](ctx, client, SplunkServiceName, templateName, BuildUpdateBody(payload, cfg, false))
 }
 
-func (client *APIClient) DeleteSplunkConfig(templateName string) error {
-	return DeleteExternalServiceConfig(client, SplunkServiceName, templateName)
+func (client *APIClient) DeleteSplunkConfig(ctx context.Context, templateName string) error {
+	return DeleteExternalServiceConfig(ctx, client, SplunkServiceName, templateName)
 }
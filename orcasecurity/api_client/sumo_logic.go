@@ -0,0 +1,42 @@
+package api_client
+
+import "context"
+
+const SumoLogicServiceName = "sumo_logic"
+
+// SumoLogicConfig mirrors the "config" block of the sumo_logic external_service/config payload.
+// The HTTP source URL embeds its own auth token, so Orca keeps it in the secret store.
+type SumoLogicConfig struct {
+	URL            string `json:"url,omitempty"`
+	SourceCategory string `json:"source_category,omitempty"`
+	SourceName     string `json:"source_name,omitempty"`
+}
+
+type SumoLogicExternalServiceConfig = ConfigEnvelope[SumoLogicConfig]
+
+func (client *APIClient) CreateSumoLogicConfig(ctx context.Context, payload SumoLogicExternalServiceConfig) (*SumoLogicExternalServiceConfig, error) {
+	return CreateExternalServiceConfig[SumoLogicConfig](ctx, client, SumoLogicServiceName, payload)
+}
+
+func (client *APIClient) GetSumoLogicConfig(ctx context.Context, templateName string) (*SumoLogicExternalServiceConfig, error) {
+	return GetExternalServiceConfig[SumoLogicConfig](ctx, client, SumoLogicServiceName, templateName, nil)
+}
+
+func (client *APIClient) ListSumoLogicConfigs(ctx context.Context) ([]SumoLogicExternalServiceConfig, error) {
+	return ListExternalServiceConfigs[SumoLogicConfig](ctx, client, SumoLogicServiceName, nil)
+}
+
+func (client *APIClient) UpdateSumoLogicConfig(ctx context.Context, templateName string, payload SumoLogicExternalServiceConfig) (*SumoLogicExternalServiceConfig, error) {
+	cfg := map[string]interface{}{
+		"source_category": payload.Config.SourceCategory,
+		"source_name":     payload.Config.SourceName,
+	}
+	if payload.Config.URL != "" {
+		cfg["url"] = payload.Config.URL
+	}
+	return UpdateExternalServiceConfig[SumoLogicConfig](ctx, client, SumoLogicServiceName, templateName, BuildUpdateBody(payload, cfg, false))
+}
+
+func (client *APIClient) DeleteSumoLogicConfig(ctx context.Context, templateName string) error {
+	return DeleteExternalServiceConfig(ctx, client, SumoLogicServiceName, templateName)
+}
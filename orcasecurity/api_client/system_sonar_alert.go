@@ -1,6 +1,7 @@
 package api_client
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -32,12 +33,12 @@ type SystemSonarAlertStatusResponse struct {
 	Status  string `json:"status"`
 }
 
-func (client *APIClient) GetSystemSonarAlert(id string) (*SystemSonarAlert, error) {
+func (client *APIClient) GetSystemSonarAlert(ctx context.Context, id string) (*SystemSonarAlert, error) {
 	type responseType struct {
 		Data SystemSonarAlert `json:"data"`
 	}
 
-	resp, err := client.Get(fmt.Sprintf("/api/sonar/rules/%s", id))
+	resp, err := client.GetContext(ctx, fmt.Sprintf("/api/sonar/rules/%s", id))
 	if err != nil {
 		return nil, err
 	}
@@ -54,8 +55,8 @@ func (client *APIClient) GetSystemSonarAlert(id string) (*SystemSonarAlert, erro
 	return &response.Data, nil
 }
 
-func (client *APIClient) DoesSystemSonarAlertExist(id string) (bool, error) {
-	resp, err := client.Head(fmt.Sprintf("/api/sonar/rules/%s", id))
+func (client *APIClient) DoesSystemSonarAlertExist(ctx context.Context, id string) (bool, error) {
+	resp, err := client.HeadContext(ctx, fmt.Sprintf("/api/sonar/rules/%s", id))
 	// Check for network error (nil response)
 	if resp == nil {
 		return false, err
@@ -74,7 +75,7 @@ func (client *APIClient) DoesSystemSonarAlertExist(id string) (bool, error) {
 	return true, nil
 }
 
-func (client *APIClient) UpdateSystemSonarAlertStatus(id string, ruleType string, enabled bool) (*SystemSonarAlertStatusResponse, error) {
+func (client *APIClient) UpdateSystemSonarAlertStatus(ctx context.Context, id string, ruleType string, enabled bool) (*SystemSonarAlertStatusResponse, error) {
 	request := SystemSonarAlertStatusRequest{
 		RuleID:   id,
 		RuleType: ruleType,
@@ -82,7 +83,7 @@ func (client *APIClient) UpdateSystemSonarAlertStatus(id string, ruleType string
 		Custom:   false,
 	}
 
-	resp, err := client.Put(fmt.Sprintf("/api/sonar/rules/status/%s", id), request)
+	resp, err := client.PutContext(ctx, fmt.Sprintf("/api/sonar/rules/status/%s", id), request)
 	if err != nil {
 		return nil, err
 	}
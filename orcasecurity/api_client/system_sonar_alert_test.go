@@ -1,6 +1,7 @@
 package api_client
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -48,7 +49,7 @@ func TestGetSystemSonarAlert(t *testing.T) {
 	})}
 
 	apiClient := newTestAPIClient(httpClient)
-	alert, err := apiClient.GetSystemSonarAlert(testRuleID)
+	alert, err := apiClient.GetSystemSonarAlert(context.Background(), testRuleID)
 
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
@@ -79,7 +80,7 @@ func TestGetSystemSonarAlert_NotFound(t *testing.T) {
 	})}
 
 	apiClient := newTestAPIClient(httpClient)
-	_, err := apiClient.GetSystemSonarAlert("invalid-id")
+	_, err := apiClient.GetSystemSonarAlert(context.Background(), "invalid-id")
 
 	if err == nil {
 		t.Error("expected error for not found alert")
@@ -110,7 +111,7 @@ func TestUpdateSystemSonarAlertStatus(t *testing.T) {
 	})}
 
 	apiClient := newTestAPIClient(httpClient)
-	resp, err := apiClient.UpdateSystemSonarAlertStatus(testRuleID, testRuleType, false)
+	resp, err := apiClient.UpdateSystemSonarAlertStatus(context.Background(), testRuleID, testRuleType, false)
 
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
@@ -143,7 +144,7 @@ func TestUpdateSystemSonarAlertStatus_Enable(t *testing.T) {
 	})}
 
 	apiClient := newTestAPIClient(httpClient)
-	resp, err := apiClient.UpdateSystemSonarAlertStatus(testRuleID, testRuleType, true)
+	resp, err := apiClient.UpdateSystemSonarAlertStatus(context.Background(), testRuleID, testRuleType, true)
 
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
@@ -167,7 +168,7 @@ func TestDoesSystemSonarAlertExist_Found(t *testing.T) {
 	})}
 
 	apiClient := newTestAPIClient(httpClient)
-	exists, err := apiClient.DoesSystemSonarAlertExist(testRuleID)
+	exists, err := apiClient.DoesSystemSonarAlertExist(context.Background(), testRuleID)
 
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
@@ -187,7 +188,7 @@ func TestDoesSystemSonarAlertExist_NotFound(t *testing.T) {
 	})}
 
 	apiClient := newTestAPIClient(httpClient)
-	exists, err := apiClient.DoesSystemSonarAlertExist("invalid-id")
+	exists, err := apiClient.DoesSystemSonarAlertExist(context.Background(), "invalid-id")
 
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
@@ -207,7 +208,7 @@ func TestDoesSystemSonarAlertExist_ServerError(t *testing.T) {
 	})}
 
 	apiClient := newTestAPIClient(httpClient)
-	exists, err := apiClient.DoesSystemSonarAlertExist(testRuleID)
+	exists, err := apiClient.DoesSystemSonarAlertExist(context.Background(), testRuleID)
 
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
@@ -227,7 +228,7 @@ func TestDoesSystemSonarAlertExist_NetworkError(t *testing.T) {
 	httpClient := &http.Client{Transport: errorRoundTripper{}}
 
 	apiClient := newTestAPIClient(httpClient)
-	exists, err := apiClient.DoesSystemSonarAlertExist(testRuleID)
+	exists, err := apiClient.DoesSystemSonarAlertExist(context.Background(), testRuleID)
 
 	if err == nil {
 		t.Error("expected error for network failure")
@@ -1,5 +1,7 @@
 package api_client
 
+import "context"
+
 const TerraformCloudServiceName = "terraform_cloud"
 
 type TerraformCloudConfig struct {
@@ -9,15 +11,19 @@ type TerraformCloudConfig struct {
 
 type TerraformCloudExternalServiceConfig = ConfigEnvelope[TerraformCloudConfig]
 
-func (client *APIClient) CreateTerraformCloudConfig(payload TerraformCloudExternalServiceConfig) (*TerraformCloudExternalServiceConfig, error) {
-	return CreateExternalServiceConfig[TerraformCloudConfig](client, TerraformCloudServiceName, payload)
+func (client *APIClient) CreateTerraformCloudConfig(ctx context.Context, payload TerraformCloudExternalServiceConfig) (*TerraformCloudExternalServiceConfig, error) {
+	return CreateExternalServiceConfig[TerraformCloudConfig](ctx, client, TerraformCloudServiceName, payload)
+}
+
+func (client *APIClient) GetTerraformCloudConfig(ctx context.Context, templateName string) (*TerraformCloudExternalServiceConfig, error) {
+	return GetExternalServiceConfig[TerraformCloudConfig](ctx, client, TerraformCloudServiceName, templateName, nil)
 }
 
-func (client *APIClient) GetTerraformCloudConfig(templateName string) (*TerraformCloudExternalServiceConfig, error) {
-	return GetExternalServiceConfig[TerraformCloudConfig](client, TerraformCloudServiceName, templateName, nil)
+func (client *APIClient) ListTerraformCloudConfigs(ctx context.Context) ([]TerraformCloudExternalServiceConfig, error) {
+	return ListExternalServiceConfigs[TerraformCloudConfig](ctx, client, TerraformCloudServiceName, nil)
 }
 
-func (client *APIClient) UpdateTerraformCloudConfig(templateName string, payload TerraformCloudExternalServiceConfig) (*TerraformCloudExternalServiceConfig, error) {
+func (client *APIClient) UpdateTerraformCloudConfig(ctx context.Context, templateName string, payload TerraformCloudExternalServiceConfig) (*TerraformCloudExternalServiceConfig, error) {
 	cfg := map[string]interface{}{}
 	if payload.Config.APIToken != "" {
 		cfg["api_token"] = payload.Config.APIToken
@@ -25,9 +31,9 @@ func (client *APIClient) UpdateTerraformCloudConfig(templateName string, payload
 	if payload.Config.APIURL != "" {
 		cfg["api_url"] = payload.Config.APIURL
 	}
-	return UpdateExternalServiceConfig[TerraformCloudConfig](client, TerraformCloudServiceName, templateName, BuildUpdateBody(payload, cfg, false))
+	return UpdateExternalServiceConfig[TerraformCloudConfig](ctx, client, TerraformCloudServiceName, templateName, BuildUpdateBody(payload, cfg, false))
 }
 
-func (client *APIClient) DeleteTerraformCloudConfig(templateName string) error {
-	return DeleteExternalServiceConfig(client, TerraformCloudServiceName, templateName)
+func (client *APIClient) DeleteTerraformCloudConfig(ctx context.Context, templateName string) error {
+	return DeleteExternalServiceConfig(ctx, client, TerraformCloudServiceName, templateName)
 }
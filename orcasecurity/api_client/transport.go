@@ -0,0 +1,106 @@
+package api_client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+)
+
+// TransportConfig carries the provider-level HTTP transport settings. Every
+// field is optional; the zero value reproduces the NewAPIClient defaults
+// (proxy from HTTPS_PROXY/NO_PROXY, system roots, no client certificate).
+type TransportConfig struct {
+	// ProxyURL routes every request through this proxy instead of the
+	// HTTPS_PROXY/NO_PROXY environment.
+	ProxyURL string
+
+	// CACertFile and CACertPEM add PEM-encoded roots on top of the system
+	// pool, e.g. a TLS-inspecting egress proxy's CA. Both may be set.
+	CACertFile string
+	CACertPEM  string
+
+	// ClientCertFile and ClientKeyFile enable mTLS. Set both or neither.
+	ClientCertFile string
+	ClientKeyFile  string
+
+	RequestTimeout   time.Duration
+	MaxRetryAttempts int
+	MaxRetryBackoff  time.Duration
+
+	// MaxRequestsPerSecond and MaxConcurrentRequests bound the whole client,
+	// not each resource. Zero leaves that dimension unlimited.
+	MaxRequestsPerSecond  float64
+	MaxConcurrentRequests int
+
+	// CacheResponses enables the per-client read-through cache for
+	// collection GETs (see responseCache).
+	CacheResponses bool
+}
+
+func newHTTPClient(cfg TransportConfig) (*http.Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if cfg.ProxyURL != "" {
+		proxy, err := url.Parse(cfg.ProxyURL)
+		if err != nil || proxy.Scheme == "" || proxy.Host == "" {
+			return nil, fmt.Errorf("invalid proxy URL %q: must be an absolute URL such as http://proxy.internal:3128", cfg.ProxyURL)
+		}
+		transport.Proxy = http.ProxyURL(proxy)
+	}
+
+	tlsConfig, err := buildTLSConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if tlsConfig != nil {
+		transport.TLSClientConfig = tlsConfig
+	}
+
+	return &http.Client{Transport: transport}, nil
+}
+
+// buildTLSConfig returns nil when cfg needs nothing beyond Go's defaults.
+func buildTLSConfig(cfg TransportConfig) (*tls.Config, error) {
+	if cfg.CACertFile == "" && cfg.CACertPEM == "" && cfg.ClientCertFile == "" && cfg.ClientKeyFile == "" {
+		return nil, nil
+	}
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	if cfg.CACertFile != "" || cfg.CACertPEM != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if cfg.CACertFile != "" {
+			pem, err := os.ReadFile(cfg.CACertFile)
+			if err != nil {
+				return nil, fmt.Errorf("read CA bundle: %w", err)
+			}
+			if !pool.AppendCertsFromPEM(pem) {
+				return nil, fmt.Errorf("CA bundle %s contains no PEM certificates", cfg.CACertFile)
+			}
+		}
+		if cfg.CACertPEM != "" && !pool.AppendCertsFromPEM([]byte(cfg.CACertPEM)) {
+			return nil, errors.New("inline CA PEM contains no certificates")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if (cfg.ClientCertFile == "") != (cfg.ClientKeyFile == "") {
+		return nil, errors.New("client certificate and client key must be set together")
+	}
+	if cfg.ClientCertFile != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.ClientCertFile, cfg.ClientKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
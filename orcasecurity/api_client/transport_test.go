@@ -0,0 +1,87 @@
+package api_client
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestNewAPIClientWithTransport_TrustsInlineCAPEM(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+	caPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw}))
+
+	endpoint, token := srv.URL, "secret"
+	c, err := NewAPIClientWithTransport(&endpoint, &token, TransportConfig{CACertPEM: caPEM})
+	if err != nil {
+		t.Fatalf("NewAPIClientWithTransport: %v", err)
+	}
+	if _, err := c.Get("/api/x"); err != nil {
+		t.Fatalf("GET with custom CA: %v", err)
+	}
+
+	plain, _ := NewAPIClient(&endpoint, &token)
+	if _, err := plain.Get("/api/x"); err == nil {
+		t.Fatal("expected the default client to reject the test server's self-signed certificate")
+	}
+}
+
+func TestNewAPIClientWithTransport_ProxyURL(t *testing.T) {
+	endpoint, token := "https://api.example.com", "secret"
+	c, err := NewAPIClientWithTransport(&endpoint, &token, TransportConfig{ProxyURL: "http://proxy.internal:3128"})
+	if err != nil {
+		t.Fatalf("NewAPIClientWithTransport: %v", err)
+	}
+	req, _ := http.NewRequest(http.MethodGet, endpoint, nil)
+	got, err := c.HTTPClient.Transport.(*http.Transport).Proxy(req)
+	if err != nil {
+		t.Fatalf("Proxy: %v", err)
+	}
+	if want, _ := url.Parse("http://proxy.internal:3128"); got.String() != want.String() {
+		t.Fatalf("proxy = %v, want %v", got, want)
+	}
+}
+
+func TestNewAPIClientWithTransport_RejectsBadSettings(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     TransportConfig
+		wantErr string
+	}{
+		{"relative proxy", TransportConfig{ProxyURL: "proxy.internal:3128"}, "invalid proxy URL"},
+		{"empty CA PEM", TransportConfig{CACertPEM: "not a certificate"}, "no certificates"},
+		{"missing CA file", TransportConfig{CACertFile: "/nonexistent/ca.pem"}, "read CA bundle"},
+		{"cert without key", TransportConfig{ClientCertFile: "/tmp/cert.pem"}, "must be set together"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			endpoint, token := "https://api.example.com", "secret"
+			_, err := NewAPIClientWithTransport(&endpoint, &token, tt.cfg)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("err = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewAPIClientWithTransport_RetryBudget(t *testing.T) {
+	endpoint, token := "https://api.example.com", "secret"
+	c, err := NewAPIClientWithTransport(&endpoint, &token, TransportConfig{MaxRetryAttempts: 2, MaxRetryBackoff: retryBaseDelay})
+	if err != nil {
+		t.Fatalf("NewAPIClientWithTransport: %v", err)
+	}
+	if got := c.retryAttempts(); got != 2 {
+		t.Fatalf("retryAttempts = %d, want 2", got)
+	}
+	if got := c.retryDelay(5, nil); got != retryBaseDelay {
+		t.Fatalf("retryDelay = %s, want capped at %s", got, retryBaseDelay)
+	}
+	if c.RequestTimeout != defaultRequestTimeout {
+		t.Fatalf("RequestTimeout = %s, want default %s", c.RequestTimeout, defaultRequestTimeout)
+	}
+}
@@ -1,6 +1,7 @@
 package api_client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strconv"
@@ -25,13 +26,13 @@ type trustedCloudAccountAPIResponseTypeRead struct {
 	Status string                `json:"status"`
 }
 
-func (client *APIClient) DoesTrustedCloudAccountExist(id string) (bool, error) {
-	resp, _ := client.Head(fmt.Sprintf("/api/organization/trusted_accounts?id=%s", id))
+func (client *APIClient) DoesTrustedCloudAccountExist(ctx context.Context, id string) (bool, error) {
+	resp, _ := client.HeadContext(ctx, fmt.Sprintf("/api/organization/trusted_accounts?id=%s", id))
 	return resp.StatusCode() == 200, nil
 }
 
-func (client *APIClient) GetTrustedCloudAccount(id string) (*TrustedCloudAccount, error) {
-	resp, err := client.Get(fmt.Sprintf("/api/organization/trusted_accounts?id=%s", id))
+func (client *APIClient) GetTrustedCloudAccount(ctx context.Context, id string) (*TrustedCloudAccount, error) {
+	resp, err := client.GetContext(ctx, fmt.Sprintf("/api/organization/trusted_accounts?id=%s", id))
 	if resp.StatusCode() == 400 || resp.StatusCode() == 500 {
 		return nil, nil
 	}
@@ -47,8 +48,8 @@ func (client *APIClient) GetTrustedCloudAccount(id string) (*TrustedCloudAccount
 	return &response.Data[0], nil
 }
 
-func (client *APIClient) CreateTrustedCloudAccount(data TrustedCloudAccount) (*TrustedCloudAccount, error) {
-	resp, err := client.Post("/api/organization/trusted_accounts", data)
+func (client *APIClient) CreateTrustedCloudAccount(ctx context.Context, data TrustedCloudAccount) (*TrustedCloudAccount, error) {
+	resp, err := client.PostContext(ctx, "/api/organization/trusted_accounts", data)
 	if err != nil {
 		return nil, err
 	}
@@ -61,8 +62,8 @@ func (client *APIClient) CreateTrustedCloudAccount(data TrustedCloudAccount) (*T
 	return &response.Data, nil
 }
 
-func (client *APIClient) UpdateTrustedCloudAccount(data TrustedCloudAccount) (*TrustedCloudAccount, error) {
-	resp, err := client.Put(fmt.Sprintf("/api/organization/trusted_accounts?id=%s", strconv.Itoa(int(data.ID))), data)
+func (client *APIClient) UpdateTrustedCloudAccount(ctx context.Context, data TrustedCloudAccount) (*TrustedCloudAccount, error) {
+	resp, err := client.PutContext(ctx, fmt.Sprintf("/api/organization/trusted_accounts?id=%s", strconv.Itoa(int(data.ID))), data)
 	if err != nil {
 		return nil, err
 	}
@@ -75,7 +76,7 @@ func (client *APIClient) UpdateTrustedCloudAccount(data TrustedCloudAccount) (*T
 	return &response.Data, nil
 }
 
-func (client *APIClient) DeleteTrustedCloudAccount(id string) error {
-	_, err := client.Delete(fmt.Sprintf("/api/organization/trusted_accounts?id=%s", id))
+func (client *APIClient) DeleteTrustedCloudAccount(ctx context.Context, id string) error {
+	_, err := client.DeleteContext(ctx, fmt.Sprintf("/api/organization/trusted_accounts?id=%s", id))
 	return err
 }
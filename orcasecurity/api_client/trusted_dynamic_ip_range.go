@@ -1,6 +1,7 @@
 package api_client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -27,13 +28,13 @@ type OuterReceptacle struct {
 	} `json:"data"`
 }
 
-func (client *APIClient) GetTrustedDynamicIpRangeStatus(orgId string) (bool, error) {
+func (client *APIClient) GetTrustedDynamicIpRangeStatus(ctx context.Context, orgId string) (bool, error) {
 	data := SetGetTDIRType{
 		Query:     "query ($orgId: String!) {isDynamicTrustedIpsEnabled(orgId: $orgId)}",
 		Variables: TDIRVariablesType{OrgID: orgId},
 	}
-	resp, err := client.Post("/api/gql", data)
-	if resp.StatusCode() == 400 || resp.StatusCode() == 500 {
+	resp, err := client.PostContext(asQuery(ctx), "/api/gql", data)
+	if resp != nil && (resp.StatusCode() == 400 || resp.StatusCode() == 500) {
 		return false, nil
 	}
 	if err != nil {
@@ -48,12 +49,12 @@ func (client *APIClient) GetTrustedDynamicIpRangeStatus(orgId string) (bool, err
 	return response.Data.IsDynamicTrustedIpsEnabled, nil
 }
 
-func (client *APIClient) SetTrustedDynamicIpRange(orgId string) (bool, error) {
+func (client *APIClient) SetTrustedDynamicIpRange(ctx context.Context, orgId string) (bool, error) {
 	data := SetGetTDIRType{
 		Query:     "mutation ($orgId: String!, $value: Boolean!) {setDynamicTrustedIpsEnabled(orgId: $orgId, value: $value) {ok}}",
 		Variables: TDIRVariablesType{OrgID: orgId, Value: true},
 	}
-	resp, err := client.Post("/api/gql", data)
+	resp, err := client.PostContext(ctx, "/api/gql", data)
 	if err != nil {
 		return false, err
 	}
@@ -74,7 +75,7 @@ func (client *APIClient) SetTrustedDynamicIpRange(orgId string) (bool, error) {
 	return response.Data.SetDynamicTrustedIpsEnabled.OK, nil
 }
 
-func (client *APIClient) UnsetTrustedDynamicIpRange(orgId string) error {
+func (client *APIClient) UnsetTrustedDynamicIpRange(ctx context.Context, orgId string) error {
 	data := SetGetTDIRType{
 		Query:     "mutation ($orgId: String!, $value: Boolean!) {setDynamicTrustedIpsEnabled(orgId: $orgId, value: $value) {ok}}",
 		Variables: TDIRVariablesType{OrgID: orgId, Value: false},
@@ -86,7 +87,7 @@ func (client *APIClient) UnsetTrustedDynamicIpRange(orgId string) error {
 	debugJson, _ := json.Marshal(data)
 	client.debugf("JSON that would be sent: %s", string(debugJson))
 
-	resp, err := client.Post("/api/gql", data)
+	resp, err := client.PostContext(ctx, "/api/gql", data)
 	if err != nil {
 		return fmt.Errorf("API post error: %v", err)
 	}
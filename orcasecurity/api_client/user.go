@@ -1,6 +1,7 @@
 package api_client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -27,7 +28,7 @@ type User struct {
 // ListUsers returns every user in the organization, paging through the offset
 // paginator (limit + start_at_index query params, {status,data,total_items}
 // envelope — same shape as the paginated RBAC access endpoints).
-func (client *APIClient) ListUsers() ([]User, error) {
+func (client *APIClient) ListUsers(ctx context.Context) ([]User, error) {
 	var out []User
 	fetched := 0
 	for {
@@ -35,7 +36,7 @@ func (client *APIClient) ListUsers() ([]User, error) {
 		q.Set("limit", strconv.Itoa(usersPageLimit))
 		q.Set("start_at_index", strconv.Itoa(fetched))
 
-		resp, err := client.Get(apiUsersPath + "?" + q.Encode())
+		resp, err := client.getCached(ctx, apiUsersPath+"?"+q.Encode())
 		if err != nil {
 			return nil, err
 		}
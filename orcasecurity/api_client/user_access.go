@@ -1,5 +1,7 @@
 package api_client
 
+import "context"
+
 const apiRBACUserAccessPath = "/api/rbac/access/user"
 
 var userAccessEndpoint = rbacAccessEndpoint{
@@ -49,8 +51,8 @@ func userAccessFromRecord(r rbacAccessRecord) UserAccess {
 
 // CreateUserAccess assigns a role to a user with optional cloud account, Shift
 // Left, or user filter (e.g. business unit) scope.
-func (client *APIClient) CreateUserAccess(data UserAccess) (*UserAccess, error) {
-	id, err := client.createAccess(userAccessEndpoint, data.toRecord())
+func (client *APIClient) CreateUserAccess(ctx context.Context, data UserAccess) (*UserAccess, error) {
+	id, err := client.createAccess(ctx, userAccessEndpoint, data.toRecord())
 	if err != nil {
 		return nil, err
 	}
@@ -60,8 +62,8 @@ func (client *APIClient) CreateUserAccess(data UserAccess) (*UserAccess, error)
 }
 
 // ListUserAccessForUser returns the assignments whose nested user id equals userID.
-func (client *APIClient) ListUserAccessForUser(userID string) ([]UserAccess, error) {
-	records, err := client.listAccessForOwner(userAccessEndpoint, userID)
+func (client *APIClient) ListUserAccessForUser(ctx context.Context, userID string) ([]UserAccess, error) {
+	records, err := client.listAccessForOwner(ctx, userAccessEndpoint, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -73,8 +75,8 @@ func (client *APIClient) ListUserAccessForUser(userID string) ([]UserAccess, err
 }
 
 // FindUserAccess resolves an assignment by id (preferred) or role+scope fallback; nil when nothing matches.
-func (client *APIClient) FindUserAccess(assignmentID string, want UserAccess) (*UserAccess, error) {
-	rec, err := client.findAccess(userAccessEndpoint, assignmentID, want.toRecord())
+func (client *APIClient) FindUserAccess(ctx context.Context, assignmentID string, want UserAccess) (*UserAccess, error) {
+	rec, err := client.findAccess(ctx, userAccessEndpoint, assignmentID, want.toRecord())
 	if err != nil || rec == nil {
 		return nil, err
 	}
@@ -83,8 +85,8 @@ func (client *APIClient) FindUserAccess(assignmentID string, want UserAccess) (*
 }
 
 // UpdateUserAccess updates an existing assignment (id carried in the body).
-func (client *APIClient) UpdateUserAccess(data UserAccess) (*UserAccess, error) {
-	rec, err := client.updateAccess(userAccessEndpoint, data.toRecord())
+func (client *APIClient) UpdateUserAccess(ctx context.Context, data UserAccess) (*UserAccess, error) {
+	rec, err := client.updateAccess(ctx, userAccessEndpoint, data.toRecord())
 	if err != nil {
 		return nil, err
 	}
@@ -93,6 +95,6 @@ func (client *APIClient) UpdateUserAccess(data UserAccess) (*UserAccess, error)
 }
 
 // DeleteUserAccess removes a user–role assignment (id carried in the body).
-func (client *APIClient) DeleteUserAccess(id string) error {
-	return client.deleteAccess(userAccessEndpoint, id)
+func (client *APIClient) DeleteUserAccess(ctx context.Context, id string) error {
+	return client.deleteAccess(ctx, userAccessEndpoint, id)
 }
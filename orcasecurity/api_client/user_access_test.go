@@ -1,6 +1,7 @@
 package api_client
 
 import (
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -72,7 +73,7 @@ func TestCreateUserAccess(t *testing.T) {
 	})}
 
 	apiClient := newTestAPIClient(httpClient)
-	ua, err := apiClient.CreateUserAccess(UserAccess{
+	ua, err := apiClient.CreateUserAccess(context.Background(), UserAccess{
 		UserID:           testUserAccessUserID,
 		RoleID:           testUserAccessRoleID,
 		AllCloudAccounts: true,
@@ -101,7 +102,7 @@ func TestFindUserAccess(t *testing.T) {
 	})}
 
 	apiClient := newTestAPIClient(httpClient)
-	ua, err := apiClient.FindUserAccess(testUserAccessID, UserAccess{UserID: testUserAccessUserID})
+	ua, err := apiClient.FindUserAccess(context.Background(), testUserAccessID, UserAccess{UserID: testUserAccessUserID})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -129,7 +130,7 @@ func TestListUserAccessForUser_FetchesAllInOneRequest(t *testing.T) {
 	})}
 
 	apiClient := newTestAPIClient(httpClient)
-	got, err := apiClient.ListUserAccessForUser(testUserAccessUserID)
+	got, err := apiClient.ListUserAccessForUser(context.Background(), testUserAccessUserID)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -152,7 +153,7 @@ func TestFindUserAccess_ScansAllWhenUserUnknown(t *testing.T) {
 	})}
 
 	apiClient := newTestAPIClient(httpClient)
-	ua, err := apiClient.FindUserAccess(testUserAccessID, UserAccess{})
+	ua, err := apiClient.FindUserAccess(context.Background(), testUserAccessID, UserAccess{})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -171,7 +172,7 @@ func TestFindUserAccess_NotFound(t *testing.T) {
 	})}
 
 	apiClient := newTestAPIClient(httpClient)
-	ua, err := apiClient.FindUserAccess(testUserAccessID, UserAccess{UserID: testUserAccessUserID})
+	ua, err := apiClient.FindUserAccess(context.Background(), testUserAccessID, UserAccess{UserID: testUserAccessUserID})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -199,7 +200,7 @@ func TestDeleteUserAccess(t *testing.T) {
 	})}
 
 	apiClient := newTestAPIClient(httpClient)
-	if err := apiClient.DeleteUserAccess(testUserAccessID); err != nil {
+	if err := apiClient.DeleteUserAccess(context.Background(), testUserAccessID); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 	if !called {
@@ -1,9 +1,9 @@
 package api_client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // UserInvite maps to the /api/user_invites endpoints ("Add Users" in the UI).
@@ -40,8 +40,8 @@ type UserInvite struct {
 }
 
 // CreateUserInvite invites a single user and returns the created invite.
-func (client *APIClient) CreateUserInvite(req UserInviteRequest) (*UserInvite, error) {
-	resp, err := client.Post(apiUserInvitesBulkCreatePath, req)
+func (client *APIClient) CreateUserInvite(ctx context.Context, req UserInviteRequest) (*UserInvite, error) {
+	resp, err := client.PostContext(ctx, apiUserInvitesBulkCreatePath, req)
 	if err != nil {
 		return nil, err
 	}
@@ -79,8 +79,8 @@ func parseUserInviteList(body []byte) ([]UserInvite, error) {
 }
 
 // ListUserInvites returns all pending invites in the organization.
-func (client *APIClient) ListUserInvites() ([]UserInvite, error) {
-	resp, err := client.Get(apiUserInvitesListPath)
+func (client *APIClient) ListUserInvites(ctx context.Context) ([]UserInvite, error) {
+	resp, err := client.GetContext(ctx, apiUserInvitesListPath)
 	if err != nil {
 		return nil, err
 	}
@@ -89,8 +89,8 @@ func (client *APIClient) ListUserInvites() ([]UserInvite, error) {
 
 // GetUserInvite finds a pending invite by id, returning nil when it no longer
 // exists (e.g. the invitee registered or the invite was revoked).
-func (client *APIClient) GetUserInvite(id string) (*UserInvite, error) {
-	invites, err := client.ListUserInvites()
+func (client *APIClient) GetUserInvite(ctx context.Context, id string) (*UserInvite, error) {
+	invites, err := client.ListUserInvites(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -104,9 +104,9 @@ func (client *APIClient) GetUserInvite(id string) (*UserInvite, error) {
 }
 
 // DeleteUserInvite revokes a pending invite.
-func (client *APIClient) DeleteUserInvite(id string) error {
-	_, err := client.Delete(fmt.Sprintf(apiUserInviteByIDFmt, id))
-	if err != nil && strings.Contains(err.Error(), "status: 404") {
+func (client *APIClient) DeleteUserInvite(ctx context.Context, id string) error {
+	_, err := client.DeleteContext(ctx, fmt.Sprintf(apiUserInviteByIDFmt, id))
+	if IsNotFound(err) {
 		return nil
 	}
 	return err
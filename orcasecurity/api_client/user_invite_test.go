@@ -1,6 +1,7 @@
 package api_client
 
 import (
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -36,7 +37,7 @@ func TestCreateUserInvite(t *testing.T) {
 	})}
 
 	apiClient := newTestAPIClient(httpClient)
-	invite, err := apiClient.CreateUserInvite(UserInviteRequest{
+	invite, err := apiClient.CreateUserInvite(context.Background(), UserInviteRequest{
 		InviteUserEmails: []string{"tf@example.com"},
 		RoleID:           testUserAccessRoleID,
 		ShouldSendEmail:  true,
@@ -62,7 +63,7 @@ func TestGetUserInvite(t *testing.T) {
 	})}
 
 	apiClient := newTestAPIClient(httpClient)
-	invite, err := apiClient.GetUserInvite(testUserInviteID)
+	invite, err := apiClient.GetUserInvite(context.Background(), testUserInviteID)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -77,7 +78,7 @@ func TestGetUserInvite_NotFound(t *testing.T) {
 	})}
 
 	apiClient := newTestAPIClient(httpClient)
-	invite, err := apiClient.GetUserInvite(testUserInviteID)
+	invite, err := apiClient.GetUserInvite(context.Background(), testUserInviteID)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -98,7 +99,7 @@ func TestDeleteUserInvite(t *testing.T) {
 	})}
 
 	apiClient := newTestAPIClient(httpClient)
-	if err := apiClient.DeleteUserInvite(testUserInviteID); err != nil {
+	if err := apiClient.DeleteUserInvite(context.Background(), testUserInviteID); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 }
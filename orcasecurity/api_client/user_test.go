@@ -1,6 +1,7 @@
 package api_client
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -23,7 +24,7 @@ func TestListUsers_SinglePage(t *testing.T) {
 	defer srv.Close()
 
 	c := &APIClient{APIEndpoint: srv.URL, APIToken: "tok", HTTPClient: srv.Client()}
-	users, err := c.ListUsers()
+	users, err := c.ListUsers(context.Background())
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -48,7 +49,7 @@ func TestListUsers_Paginates(t *testing.T) {
 	defer srv.Close()
 
 	c := &APIClient{APIEndpoint: srv.URL, APIToken: "tok", HTTPClient: srv.Client()}
-	users, err := c.ListUsers()
+	users, err := c.ListUsers(context.Background())
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -75,7 +76,7 @@ func TestListUsers_NonSuccessStatus(t *testing.T) {
 	defer srv.Close()
 
 	c := &APIClient{APIEndpoint: srv.URL, APIToken: "tok", HTTPClient: srv.Client()}
-	if _, err := c.ListUsers(); err == nil {
+	if _, err := c.ListUsers(context.Background()); err == nil {
 		t.Fatal("expected error")
 	}
 }
@@ -1,6 +1,7 @@
 package api_client
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 )
@@ -22,8 +23,8 @@ type Config struct {
 	WebhookUrl    string            `json:"webhook_url"`
 }
 
-func (client *APIClient) GetWebhookByName(name string) (*Webhook, error) {
-	resp, err := client.Get(
+func (client *APIClient) GetWebhookByName(ctx context.Context, name string) (*Webhook, error) {
+	resp, err := client.GetContext(ctx,
 		fmt.Sprintf("/api/external_service/config?service_name=%s&template_name=%s",
 			WebhookServiceConfigName, url.QueryEscape(name),
 		),
@@ -1,5 +1,7 @@
 package api_client
 
+import "context"
+
 const WebhookConfigServiceName = "webhook"
 
 // WebhookCustomHeaderValue mirrors the Orca payload shape for custom headers:
@@ -20,21 +22,26 @@ type WebhookResourceConfig struct {
 
 type WebhookExternalServiceConfig = ConfigEnvelope[WebhookResourceConfig]
 
-func (client *APIClient) CreateWebhookConfig(payload WebhookExternalServiceConfig) (*WebhookExternalServiceConfig, error) {
-	return CreateExternalServiceConfig[WebhookResourceConfig](client, WebhookConfigServiceName, payload)
+func (client *APIClient) CreateWebhookConfig(ctx context.Context, payload WebhookExternalServiceConfig) (*WebhookExternalServiceConfig, error) {
+	return CreateExternalServiceConfig[WebhookResourceConfig](ctx, client, WebhookConfigServiceName, payload)
+}
+
+func (client *APIClient) GetWebhookConfigByTemplate(ctx context.Context, templateName string) (*WebhookExternalServiceConfig, error) {
+	return GetExternalServiceConfig[WebhookResourceConfig](ctx, client, WebhookConfigServiceName, templateName, nil)
 }
 
-func (client *APIClient) GetWebhookConfigByTemplate(templateName string) (*WebhookExternalServiceConfig, error) {
-	return GetExternalServiceConfig[WebhookResourceConfig](client, WebhookConfigServiceName, templateName, nil)
+// ListWebhookConfigs returns the webhook configs that no vendor resource owns.
+func (client *APIClient) ListWebhookConfigs(ctx context.Context) ([]WebhookExternalServiceConfig, error) {
+	return ListExternalServiceConfigs[WebhookResourceConfig](ctx, client, WebhookConfigServiceName, notVendorWebhook)
 }
 
-func (client *APIClient) UpdateWebhookConfig(templateName string, payload WebhookExternalServiceConfig) (*WebhookExternalServiceConfig, error) {
+func (client *APIClient) UpdateWebhookConfig(ctx context.Context, templateName string, payload WebhookExternalServiceConfig) (*WebhookExternalServiceConfig, error) {
 	// The webhook endpoint accepts the whole config block (the sensitive api_key is the only
 	// secret and is always re-submitted from state), so send the struct directly rather than
 	// composing a per-field map.
-	return UpdateExternalServiceConfig[WebhookResourceConfig](client, WebhookConfigServiceName, templateName, BuildUpdateBody(payload, payload.Config, true))
+	return UpdateExternalServiceConfig[WebhookResourceConfig](ctx, client, WebhookConfigServiceName, templateName, BuildUpdateBody(payload, payload.Config, true))
 }
 
-func (client *APIClient) DeleteWebhookConfig(templateName string) error {
-	return DeleteExternalServiceConfig(client, WebhookConfigServiceName, templateName)
+func (client *APIClient) DeleteWebhookConfig(ctx context.Context, templateName string) error {
+	return DeleteExternalServiceConfig(ctx, client, WebhookConfigServiceName, templateName)
 }
@@ -0,0 +1,142 @@
+package api_client
+
+import (
+	"context"
+	"slices"
+)
+
+// Tines, Torq, Opus, Panther and Coralogix are stored by Orca as variants of the webhook service: they share
+// service_name = "webhook" and differ only by config.type. The typed methods below pin the
+// discriminator on writes and filter on it on reads, so a vendor resource never picks up a
+// generic webhook (or another vendor's config) that happens to share a template_name.
+const (
+	WebhookTypeTines     = "tines"
+	WebhookTypeTorq      = "torq"
+	WebhookTypeOpus      = "opus"
+	WebhookTypePanther   = "panther"
+	WebhookTypeCoralogix = "coralogix"
+)
+
+// vendorWebhookTypes are the config.type values owned by a dedicated vendor resource. The
+// generic webhook list skips them so `terraform query` does not offer the same config as an
+// import target for two resource types.
+var vendorWebhookTypes = []string{WebhookTypeTines, WebhookTypeTorq, WebhookTypeOpus, WebhookTypePanther, WebhookTypeCoralogix}
+
+func webhookTypeFilter(webhookType string) func(*WebhookExternalServiceConfig) bool {
+	return func(item *WebhookExternalServiceConfig) bool {
+		return item.Config.Type == webhookType
+	}
+}
+
+func notVendorWebhook(item *WebhookExternalServiceConfig) bool {
+	return !slices.Contains(vendorWebhookTypes, item.Config.Type)
+}
+
+func createWebhookVariant(ctx context.Context, client *APIClient, webhookType string, payload WebhookExternalServiceConfig) (*WebhookExternalServiceConfig, error) {
+	payload.Config.Type = webhookType
+	return CreateExternalServiceConfig[WebhookResourceConfig](ctx, client, WebhookConfigServiceName, payload)
+}
+
+func getWebhookVariant(ctx context.Context, client *APIClient, webhookType, templateName string) (*WebhookExternalServiceConfig, error) {
+	return GetExternalServiceConfig[WebhookResourceConfig](ctx, client, WebhookConfigServiceName, templateName, webhookTypeFilter(webhookType))
+}
+
+func listWebhookVariants(ctx context.Context, client *APIClient, webhookType string) ([]WebhookExternalServiceConfig, error) {
+	return ListExternalServiceConfigs[WebhookResourceConfig](ctx, client, WebhookConfigServiceName, webhookTypeFilter(webhookType))
+}
+
+func updateWebhookVariant(ctx context.Context, client *APIClient, webhookType, templateName string, payload WebhookExternalServiceConfig) (*WebhookExternalServiceConfig, error) {
+	// PUT body is partial. Omit an empty webhook_url (a write-only value that did not change)
+	// so the API keeps the stored one; custom_headers is always sent so removing the last
+	// header clears them.
+	cfg := map[string]interface{}{"type": webhookType}
+	if payload.Config.WebhookURL != "" {
+		cfg["webhook_url"] = payload.Config.WebhookURL
+	}
+	headers := payload.Config.CustomHeaders
+	if headers == nil {
+		headers = map[string][]WebhookCustomHeaderValue{}
+	}
+	cfg["custom_headers"] = headers
+	return UpdateExternalServiceConfig[WebhookResourceConfig](ctx, client, WebhookConfigServiceName, templateName, BuildUpdateBody(payload, cfg, true))
+}
+
+func (client *APIClient) CreateTinesConfig(ctx context.Context, payload WebhookExternalServiceConfig) (*WebhookExternalServiceConfig, error) {
+	return createWebhookVariant(ctx, client, WebhookTypeTines, payload)
+}
+
+func (client *APIClient) GetTinesConfig(ctx context.Context, templateName string) (*WebhookExternalServiceConfig, error) {
+	return getWebhookVariant(ctx, client, WebhookTypeTines, templateName)
+}
+
+func (client *APIClient) ListTinesConfigs(ctx context.Context) ([]WebhookExternalServiceConfig, error) {
+	return listWebhookVariants(ctx, client, WebhookTypeTines)
+}
+
+func (client *APIClient) UpdateTinesConfig(ctx context.Context, templateName string, payload WebhookExternalServiceConfig) (*WebhookExternalServiceConfig, error) {
+	return updateWebhookVariant(ctx, client, WebhookTypeTines, templateName, payload)
+}
+
+func (client *APIClient) CreateTorqConfig(ctx context.Context, payload WebhookExternalServiceConfig) (*WebhookExternalServiceConfig, error) {
+	return createWebhookVariant(ctx, client, WebhookTypeTorq, payload)
+}
+
+func (client *APIClient) GetTorqConfig(ctx context.Context, templateName string) (*WebhookExternalServiceConfig, error) {
+	return getWebhookVariant(ctx, client, WebhookTypeTorq, templateName)
+}
+
+func (client *APIClient) ListTorqConfigs(ctx context.Context) ([]WebhookExternalServiceConfig, error) {
+	return listWebhookVariants(ctx, client, WebhookTypeTorq)
+}
+
+func (client *APIClient) UpdateTorqConfig(ctx context.Context, templateName string, payload WebhookExternalServiceConfig) (*WebhookExternalServiceConfig, error) {
+	return updateWebhookVariant(ctx, client, WebhookTypeTorq, templateName, payload)
+}
+
+func (client *APIClient) CreateOpusConfig(ctx context.Context, payload WebhookExternalServiceConfig) (*WebhookExternalServiceConfig, error) {
+	return createWebhookVariant(ctx, client, WebhookTypeOpus, payload)
+}
+
+func (client *APIClient) GetOpusConfig(ctx context.Context, templateName string) (*WebhookExternalServiceConfig, error) {
+	return getWebhookVariant(ctx, client, WebhookTypeOpus, templateName)
+}
+
+func (client *APIClient) ListOpusConfigs(ctx context.Context) ([]WebhookExternalServiceConfig, error) {
+	return listWebhookVariants(ctx, client, WebhookTypeOpus)
+}
+
+func (client *APIClient) UpdateOpusConfig(ctx context.Context, templateName string, payload WebhookExternalServiceConfig) (*WebhookExternalServiceConfig, error) {
+	return updateWebhookVariant(ctx, client, WebhookTypeOpus, templateName, payload)
+}
+
+func (client *APIClient) CreatePantherConfig(ctx context.Context, payload WebhookExternalServiceConfig) (*WebhookExternalServiceConfig, error) {
+	return createWebhookVariant(ctx, client, WebhookTypePanther, payload)
+}
+
+func (client *APIClient) GetPantherConfig(ctx context.Context, templateName string) (*WebhookExternalServiceConfig, error) {
+	return getWebhookVariant(ctx, client, WebhookTypePanther, templateName)
+}
+
+func (client *APIClient) ListPantherConfigs(ctx context.Context) ([]WebhookExternalServiceConfig, error) {
+	return listWebhookVariants(ctx, client, WebhookTypePanther)
+}
+
+func (client *APIClient) UpdatePantherConfig(ctx context.Context, templateName string, payload WebhookExternalServiceConfig) (*WebhookExternalServiceConfig, error) {
+	return updateWebhookVariant(ctx, client, WebhookTypePanther, templateName, payload)
+}
+
+func (client *APIClient) CreateCoralogixConfig(ctx context.Context, payload WebhookExternalServiceConfig) (*WebhookExternalServiceConfig, error) {
+	return createWebhookVariant(ctx, client, WebhookTypeCoralogix, payload)
+}
+
+func (client *APIClient) GetCoralogixConfig(ctx context.Context, templateName string) (*WebhookExternalServiceConfig, error) {
+	return getWebhookVariant(ctx, client, WebhookTypeCoralogix, templateName)
+}
+
+func (client *APIClient) ListCoralogixConfigs(ctx context.Context) ([]WebhookExternalServiceConfig, error) {
+	return listWebhookVariants(ctx, client, WebhookTypeCoralogix)
+}
+
+func (client *APIClient) UpdateCoralogixConfig(ctx context.Context, templateName string, payload WebhookExternalServiceConfig) (*WebhookExternalServiceConfig, error) {
+	return updateWebhookVariant(ctx, client, WebhookTypeCoralogix, templateName, payload)
+}
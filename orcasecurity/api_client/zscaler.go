@@ -1,5 +1,7 @@
 package api_client
 
+import "context"
+
 const ZscalerServiceName = "zscaler"
 
 type ZscalerConfig struct {
@@ -10,15 +12,19 @@ type ZscalerConfig struct {
 
 type ZscalerExternalServiceConfig = ConfigEnvelope[ZscalerConfig]
 
-func (client *APIClient) CreateZscalerConfig(payload ZscalerExternalServiceConfig) (*ZscalerExternalServiceConfig, error) {
-	return CreateExternalServiceConfig[ZscalerConfig](client, ZscalerServiceName, payload)
+func (client *APIClient) CreateZscalerConfig(ctx context.Context, payload ZscalerExternalServiceConfig) (*ZscalerExternalServiceConfig, error) {
+	return CreateExternalServiceConfig[ZscalerConfig](ctx, client, ZscalerServiceName, payload)
+}
+
+func (client *APIClient) GetZscalerConfig(ctx context.Context, templateName string) (*ZscalerExternalServiceConfig, error) {
+	return GetExternalServiceConfig[ZscalerConfig](ctx, client, ZscalerServiceName, templateName, nil)
 }
 
-func (client *APIClient) GetZscalerConfig(templateName string) (*ZscalerExternalServiceConfig, error) {
-	return GetExternalServiceConfig[ZscalerConfig](client, ZscalerServiceName, templateName, nil)
+func (client *APIClient) ListZscalerConfigs(ctx context.Context) ([]ZscalerExternalServiceConfig, error) {
+	return ListExternalServiceConfigs[ZscalerConfig](ctx, client, ZscalerServiceName, nil)
 }
 
-func (client *APIClient) UpdateZscalerConfig(templateName string, payload ZscalerExternalServiceConfig) (*ZscalerExternalServiceConfig, error) {
+func (client *APIClient) UpdateZscalerConfig(ctx context.Context, templateName string, payload ZscalerExternalServiceConfig) (*ZscalerExternalServiceConfig, error) {
 	cfg := map[string]interface{}{}
 	if payload.Config.VanityDomain != "" {
 		cfg["vanity_domain"] = payload.Config.VanityDomain
@@ -29,9 +35,9 @@ func (client *APIClient) UpdateZscalerConfig(templateName string, payload Zscale
 	if payload.Config.ClientSecret != "" {
 		cfg["client_secret"] = payload.Config.ClientSecret
 	}
-	return UpdateExternalServiceConfig[ZscalerConfig](client, ZscalerServiceName, templateName, BuildUpdateBody(payload, cfg, false))
+	return UpdateExternalServiceConfig[ZscalerConfig](ctx, client, ZscalerServiceName, templateName, BuildUpdateBody(payload, cfg, false))
 }
 
-func (client *APIClient) DeleteZscalerConfig(templateName string) error {
-	return DeleteExternalServiceConfig(client, ZscalerServiceName, templateName)
+func (client *APIClient) DeleteZscalerConfig(ctx context.Context, templateName string) error {
+	return DeleteExternalServiceConfig(ctx, client, ZscalerServiceName, templateName)
 }
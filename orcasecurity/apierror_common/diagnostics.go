@@ -0,0 +1,93 @@
+// Package apierror_common turns api_client errors into Terraform diagnostics. Orca's per-field
+// validation errors land on the schema attribute they name, so Terraform points at the offending
+// line of configuration instead of reporting one generic "Error creating X".
+package apierror_common
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"terraform-provider-orcasecurity/orcasecurity/api_client"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// Schema is satisfied by the schema carried on tfsdk.Plan, tfsdk.State and tfsdk.Config, so
+// callers pass req.Plan.Schema or resp.State.Schema.
+type Schema interface {
+	TypeAtPath(ctx context.Context, p path.Path) (attr.Type, diag.Diagnostics)
+}
+
+// wrapperPrefixes are API payload envelopes that have no counterpart in the flat resource
+// schemas (e.g. external service configs nest variant fields under "config").
+var wrapperPrefixes = []string{"config.", "data."}
+
+// AddError reports err as summary with detail+": "+err. Field errors on an *api_client.APIError
+// whose field resolves in sch become attribute errors; anything left over, or every error when
+// sch is nil, goes into the general diagnostic.
+func AddError(ctx context.Context, diags *diag.Diagnostics, sch Schema, summary, detail string, err error) {
+	var apiErr *api_client.APIError
+	if sch == nil || !errors.As(err, &apiErr) || len(apiErr.FieldErrors) == 0 {
+		diags.AddError(summary, detail+": "+err.Error())
+		return
+	}
+
+	unresolved := false
+	for _, fe := range apiErr.FieldErrors {
+		p, ok := resolvePath(ctx, sch, fe.Field)
+		if !ok {
+			unresolved = true
+			continue
+		}
+		msg := fe.Message
+		if apiErr.RequestID != "" {
+			msg += " (request ID: " + apiErr.RequestID + ")"
+		}
+		diags.AddAttributeError(p, summary, msg)
+	}
+	if unresolved {
+		diags.AddError(summary, detail+": "+err.Error())
+	}
+}
+
+// resolvePath maps a dotted API field name ("name", "sections.0.title") onto a schema path,
+// retrying without a known wrapper prefix.
+func resolvePath(ctx context.Context, sch Schema, field string) (path.Path, bool) {
+	candidates := []string{field}
+	for _, prefix := range wrapperPrefixes {
+		if rest, ok := strings.CutPrefix(field, prefix); ok {
+			candidates = append(candidates, rest)
+		}
+	}
+	for _, c := range candidates {
+		if p, ok := toPath(c); ok {
+			if _, d := sch.TypeAtPath(ctx, p); !d.HasError() {
+				return p, true
+			}
+		}
+	}
+	return path.Empty(), false
+}
+
+func toPath(field string) (path.Path, bool) {
+	segments := strings.Split(field, ".")
+	if field == "" || segments[0] == "" {
+		return path.Empty(), false
+	}
+	p := path.Root(segments[0])
+	for _, s := range segments[1:] {
+		if s == "" {
+			return path.Empty(), false
+		}
+		if i, err := strconv.Atoi(s); err == nil {
+			p = p.AtListIndex(i)
+			continue
+		}
+		p = p.AtName(s)
+	}
+	return p, true
+}
@@ -0,0 +1,106 @@
+package apierror_common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"terraform-provider-orcasecurity/orcasecurity/api_client"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+var testSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"name":    schema.StringAttribute{Required: true},
+		"api_key": schema.StringAttribute{Optional: true},
+		"sections": schema.ListNestedAttribute{
+			Optional: true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"title": schema.StringAttribute{Required: true},
+				},
+			},
+		},
+	},
+}
+
+func attributePaths(diags diag.Diagnostics) []string {
+	var out []string
+	for _, d := range diags {
+		if wp, ok := d.(diag.DiagnosticWithPath); ok {
+			out = append(out, wp.Path().String())
+		}
+	}
+	return out
+}
+
+func TestAddError_MapsFieldErrorsToAttributes(t *testing.T) {
+	err := fmt.Errorf("creating: %w", &api_client.APIError{
+		StatusCode: 400,
+		Message:    "validation failed",
+		RequestID:  "req-1",
+		FieldErrors: []api_client.FieldError{
+			{Field: "config.api_key", Message: "too short"},
+			{Field: "name", Message: "is required"},
+			{Field: "sections.1.title", Message: "blank"},
+		},
+	})
+
+	var diags diag.Diagnostics
+	AddError(context.Background(), &diags, testSchema, "Error creating X", "Could not create X", err)
+
+	if len(diags) != 3 {
+		t.Fatalf("expected 3 attribute diagnostics, got %d: %v", len(diags), diags)
+	}
+	want := []string{
+		path.Root("api_key").String(),
+		path.Root("name").String(),
+		path.Root("sections").AtListIndex(1).AtName("title").String(),
+	}
+	if fmt.Sprint(attributePaths(diags)) != fmt.Sprint(want) {
+		t.Errorf("expected paths %v, got %v", want, attributePaths(diags))
+	}
+	if d := diags[1].Detail(); d != "is required (request ID: req-1)" {
+		t.Errorf("unexpected detail: %q", d)
+	}
+}
+
+func TestAddError_UnresolvedFieldFallsBackToGeneralError(t *testing.T) {
+	err := &api_client.APIError{
+		StatusCode:  400,
+		Message:     "validation failed",
+		FieldErrors: []api_client.FieldError{{Field: "name", Message: "taken"}, {Field: "owner", Message: "unknown"}},
+	}
+
+	var diags diag.Diagnostics
+	AddError(context.Background(), &diags, testSchema, "Error creating X", "Could not create X", err)
+
+	if len(diags) != 2 {
+		t.Fatalf("expected attribute + general diagnostics, got %d: %v", len(diags), diags)
+	}
+	if len(attributePaths(diags)) != 1 {
+		t.Errorf("expected one attribute diagnostic, got %v", attributePaths(diags))
+	}
+	if d := diags[1].Detail(); !strings.Contains(d, "owner: unknown") {
+		t.Errorf("expected general diagnostic to carry the full error, got %q", d)
+	}
+}
+
+func TestAddError_PlainError(t *testing.T) {
+	for name, sch := range map[string]Schema{"with schema": testSchema, "nil schema": nil} {
+		var diags diag.Diagnostics
+		AddError(context.Background(), &diags, sch, "Error creating X", "Could not create X", errors.New("boom"))
+
+		if len(diags) != 1 || diags[0].Detail() != "Could not create X: boom" {
+			t.Errorf("%s: unexpected diagnostics: %v", name, diags)
+		}
+		if len(attributePaths(diags)) != 0 {
+			t.Errorf("%s: expected no attribute diagnostics", name)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package automation_v2
+
+import (
+	"context"
+
+	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	"terraform-provider-orcasecurity/orcasecurity/identity_common"
+	"terraform-provider-orcasecurity/orcasecurity/list_common"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type listFilterModel struct {
+	NameContains types.String `tfsdk:"name_contains"`
+	Status       types.String `tfsdk:"status"`
+}
+
+// NewAutomationV2ListResource lists automations in server evaluation order.
+func NewAutomationV2ListResource() list.ListResource {
+	return list_common.New(list_common.Spec{
+		NewResource: NewAutomationV2Resource,
+		Description: "Lists Orca automations, in evaluation order, optionally filtered by name and status.",
+		Attributes: map[string]schema.Attribute{
+			"name_contains": list_common.NameContainsAttribute("automations"),
+			"status": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list automations with this status. Valid values: 'enabled', 'disabled'.",
+				Validators:  []validator.String{stringvalidator.OneOf("enabled", "disabled")},
+			},
+		},
+		Fetch: listAutomations,
+	})
+}
+
+func listAutomations(ctx context.Context, client *api_client.APIClient, config tfsdk.Config) ([]list_common.Item, diag.Diagnostics) {
+	var filter listFilterModel
+	diags := config.Get(ctx, &filter)
+	if diags.HasError() {
+		return nil, diags
+	}
+	automations, err := client.ListAutomationsV2(ctx)
+	if err != nil {
+		diags.AddError("Error listing Automation V2", "Could not list Automation V2: "+err.Error())
+		return nil, diags
+	}
+	var items []list_common.Item
+	for _, a := range automations {
+		status := a.Status
+		if status == "success" {
+			status = "enabled"
+		}
+		if !list_common.Contains(a.Name, filter.NameContains) || !list_common.Is(status, filter.Status) {
+			continue
+		}
+		items = append(items, list_common.Item{
+			Identity:    map[string]string{identity_common.IDAttribute: a.ID},
+			DisplayName: a.Name,
+		})
+	}
+	return items, diags
+}
@@ -0,0 +1,58 @@
+package automation_v2
+
+import (
+	"context"
+	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	"terraform-provider-orcasecurity/orcasecurity/internal/fakeorca"
+	"terraform-provider-orcasecurity/orcasecurity/internal/testutils"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// The API reports enabled automations as "success"; the status filter matches them as "enabled".
+func TestList_Filters(t *testing.T) {
+	client := fakeorca.New(t).Client()
+	ids := map[string]string{}
+	for _, a := range []api_client.AutomationV2{
+		{Name: "Jira critical", Status: "success"},
+		{Name: "jira low", Status: "disabled"},
+		{Name: "Slack all", Status: "enabled"},
+	} {
+		created, err := client.CreateAutomationV2(context.Background(), a, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids[a.Name] = created.ID
+	}
+
+	cases := []struct {
+		name    string
+		filters map[string]tftypes.Value
+		want    []string
+	}{
+		{"no filters", nil, []string{"Jira critical", "jira low", "Slack all"}},
+		{"name", map[string]tftypes.Value{"name_contains": tftypes.NewValue(tftypes.String, "JIRA")}, []string{"Jira critical", "jira low"}},
+		{"enabled", map[string]tftypes.Value{"status": tftypes.NewValue(tftypes.String, "enabled")}, []string{"Jira critical", "Slack all"}},
+		{"disabled", map[string]tftypes.Value{"status": tftypes.NewValue(tftypes.String, "disabled")}, []string{"jira low"}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			results := testutils.RunList(t, NewAutomationV2ListResource(), NewAutomationV2Resource, client, testutils.ListQuery{Filters: tc.filters})
+			if len(results) != len(tc.want) {
+				t.Fatalf("got %d results, want %d", len(results), len(tc.want))
+			}
+			for i, r := range results {
+				if r.Diagnostics.HasError() {
+					t.Fatalf("result %d: %v", i, r.Diagnostics)
+				}
+				if r.DisplayName != tc.want[i] {
+					t.Errorf("result %d display name = %q, want %q", i, r.DisplayName, tc.want[i])
+				}
+				if got := testutils.IdentityString(t, r, "id"); got != ids[tc.want[i]] {
+					t.Errorf("result %d id = %q, want %q", i, got, ids[tc.want[i]])
+				}
+			}
+		})
+	}
+}
@@ -30,8 +30,8 @@ import (
 // silently clamps values above the organization's current highest priority,
 // so callers must compare the returned value with the requested one and
 // surface a diagnostic on mismatch.
-func (r *automationV2Resource) applyPriority(id string, requested int64) (int64, error) {
-	instance, err := r.apiClient.SetAutomationV2Priority(id, requested)
+func (r *automationV2Resource) applyPriority(ctx context.Context, id string, requested int64) (int64, error) {
+	instance, err := r.apiClient.SetAutomationV2Priority(ctx, id, requested)
 	if err != nil {
 		return 0, err
 	}
@@ -82,12 +82,12 @@ func modelsEqualIgnoringPriority(a, b automationV2ResourceModel) bool {
 // is perfectly healthy. Instead the state records what the server actually
 // holds, so the next plan shows a priority diff and the next apply retries
 // through the priority-only update path.
-func (r *automationV2Resource) applyPlanPriorityOnCreate(plan *automationV2ResourceModel, instanceID string, resp *resource.CreateResponse) {
+func (r *automationV2Resource) applyPlanPriorityOnCreate(ctx context.Context, plan *automationV2ResourceModel, instanceID string, resp *resource.CreateResponse) {
 	if plan.Priority.IsNull() || plan.Priority.IsUnknown() {
 		return
 	}
 	requested := plan.Priority.ValueInt64()
-	actual, err := r.applyPriority(instanceID, requested)
+	actual, err := r.applyPriority(ctx, instanceID, requested)
 	if err != nil {
 		plan.Priority = types.Int64Null()
 		resp.Diagnostics.AddWarning(
@@ -106,7 +106,7 @@ func (r *automationV2Resource) applyPlanPriorityOnCreate(plan *automationV2Resou
 // talks solely to the priority endpoint, mirroring the UI. Skipping the CRUD
 // update avoids its side effect of resetting all action statuses to ACTIVE.
 func (r *automationV2Resource) updatePriorityOnly(ctx context.Context, plan *automationV2ResourceModel, resp *resource.UpdateResponse) {
-	instance, err := r.apiClient.GetAutomationV2(plan.ID.ValueString())
+	instance, err := r.apiClient.GetAutomationV2(ctx, plan.ID.ValueString())
 	if err == nil && instance == nil {
 		err = fmt.Errorf("received nil instance from API")
 	}
@@ -117,7 +117,7 @@ func (r *automationV2Resource) updatePriorityOnly(ctx context.Context, plan *aut
 		)
 		return
 	}
-	resp.Diagnostics.Append(r.resolvePlanPriorityOnUpdate(plan, instance)...)
+	resp.Diagnostics.Append(r.resolvePlanPriorityOnUpdate(ctx, plan, instance)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
@@ -129,7 +129,7 @@ func (r *automationV2Resource) updatePriorityOnly(ctx context.Context, plan *aut
 // caller commits plan to state exactly once, so state stays consistent whether
 // this succeeds, clamps, or errors (an error still persists the server's real
 // priority rather than hiding the remote mutation).
-func (r *automationV2Resource) resolvePlanPriorityOnUpdate(plan *automationV2ResourceModel, verifyInstance *api_client.AutomationV2) diag.Diagnostics {
+func (r *automationV2Resource) resolvePlanPriorityOnUpdate(ctx context.Context, plan *automationV2ResourceModel, verifyInstance *api_client.AutomationV2) diag.Diagnostics {
 	var diags diag.Diagnostics
 	if plan.Priority.IsNull() || plan.Priority.IsUnknown() {
 		return diags
@@ -138,7 +138,7 @@ func (r *automationV2Resource) resolvePlanPriorityOnUpdate(plan *automationV2Res
 	if verifyInstance.Priority != nil && *verifyInstance.Priority == requested {
 		return diags
 	}
-	actual, err := r.applyPriority(plan.ID.ValueString(), requested)
+	actual, err := r.applyPriority(ctx, plan.ID.ValueString(), requested)
 	if err != nil {
 		plan.Priority = types.Int64PointerValue(verifyInstance.Priority)
 		diags.AddError(
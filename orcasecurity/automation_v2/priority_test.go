@@ -1,6 +1,7 @@
 package automation_v2
 
 import (
+	"context"
 	"io"
 	"net/http"
 	"strings"
@@ -33,7 +34,7 @@ func TestApplyPriorityReturnsServerValue(t *testing.T) {
 	r := priorityTestResource(t,
 		`{"status":"success","data":{"id":"a1","name":"n","status":"enabled","filter":{"sonar_query":{"models":["Alert"],"type":"object_set"}},"actions":[],"priority":3}}`,
 		"/api/automations/a1/priority")
-	actual, err := r.applyPriority("a1", 3)
+	actual, err := r.applyPriority(context.Background(), "a1", 3)
 	if err != nil {
 		t.Fatalf("applyPriority failed: %v", err)
 	}
@@ -46,7 +47,7 @@ func TestApplyPriorityReportsClampedValue(t *testing.T) {
 	r := priorityTestResource(t,
 		`{"status":"success","data":{"id":"a1","name":"n","status":"enabled","filter":{"sonar_query":{"models":["Alert"],"type":"object_set"}},"actions":[],"priority":10}}`,
 		"")
-	actual, err := r.applyPriority("a1", 50)
+	actual, err := r.applyPriority(context.Background(), "a1", 50)
 	if err != nil {
 		t.Fatalf("applyPriority failed: %v", err)
 	}
@@ -59,7 +60,7 @@ func TestApplyPriorityErrorsWhenServerOmitsPriority(t *testing.T) {
 	r := priorityTestResource(t,
 		`{"status":"success","data":{"id":"a1","name":"n","status":"enabled","filter":{"sonar_query":{"models":["Alert"],"type":"object_set"}},"actions":[]}}`,
 		"")
-	_, err := r.applyPriority("a1", 2)
+	_, err := r.applyPriority(context.Background(), "a1", 2)
 	if err == nil {
 		t.Fatal("expected error when response has no priority, got nil")
 	}
@@ -117,7 +118,7 @@ func TestApplyPlanPriorityOnCreateFailureWarnsAndKeepsAutomation(t *testing.T) {
 	plan := &automationV2ResourceModel{Priority: types.Int64Value(3)}
 	resp := &resource.CreateResponse{}
 
-	r.applyPlanPriorityOnCreate(plan, "a1", resp)
+	r.applyPlanPriorityOnCreate(context.Background(), plan, "a1", resp)
 
 	if resp.Diagnostics.HasError() {
 		t.Fatalf("priority failure on create must not be an error (taints the resource), got: %v", resp.Diagnostics)
@@ -137,7 +138,7 @@ func TestApplyPlanPriorityOnCreateClampWarnsWithActual(t *testing.T) {
 	plan := &automationV2ResourceModel{Priority: types.Int64Value(50)}
 	resp := &resource.CreateResponse{}
 
-	r.applyPlanPriorityOnCreate(plan, "a1", resp)
+	r.applyPlanPriorityOnCreate(context.Background(), plan, "a1", resp)
 
 	if resp.Diagnostics.HasError() {
 		t.Fatalf("clamp on create must not be an error, got: %v", resp.Diagnostics)
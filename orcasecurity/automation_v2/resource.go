@@ -5,6 +5,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	"terraform-provider-orcasecurity/orcasecurity/apierror_common"
+	"terraform-provider-orcasecurity/orcasecurity/identity_common"
+	"terraform-provider-orcasecurity/orcasecurity/sonar_query"
+	"terraform-provider-orcasecurity/orcasecurity/timeouts_common"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
@@ -26,7 +30,9 @@ var (
 	_ resource.Resource                     = &automationV2Resource{}
 	_ resource.ResourceWithConfigure        = &automationV2Resource{}
 	_ resource.ResourceWithImportState      = &automationV2Resource{}
+	_ resource.ResourceWithIdentity         = &automationV2Resource{}
 	_ resource.ResourceWithConfigValidators = &automationV2Resource{}
+	_ resource.ResourceWithModifyPlan       = &automationV2Resource{}
 )
 
 const scoreChangeJustificationDescription = "More detailed reasoning as to why these alerts are having their score changed. Optional; empty string is treated as omitted."
@@ -161,6 +167,8 @@ type automationV2ResourceModel struct {
 	OrganizationID  types.String `tfsdk:"organization_id"`
 	ApplyOnExisting types.Bool   `tfsdk:"apply_on_existing"`
 	Priority        types.Int64  `tfsdk:"priority"`
+
+	Timeouts timeouts_common.Value `tfsdk:"timeouts"`
 }
 
 func NewAutomationV2Resource() resource.Resource {
@@ -222,8 +230,30 @@ func (r *automationV2Resource) ConfigValidators(_ context.Context) []resource.Co
 	}
 }
 
+// ModifyPlan checks a new or changed filter.sonar_query with the Orca query parser, so syntax
+// errors and unknown models or fields fail the plan rather than the apply.
+func (r *automationV2Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	queryPath := path.Root("filter").AtName("sonar_query")
+	var planned, prior types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, queryPath, &planned)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, queryPath, &prior)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	sonar_query.ValidatePlanned(ctx, r.apiClient, queryPath, planned, prior, false, &resp.Diagnostics)
+}
+
 func (r *automationV2Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	identity_common.ImportID(ctx, req, resp)
+}
+
+func (r *automationV2Resource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity_common.IDSchema()
 }
 
 func createExternalConfigTemplateSchema(serviceLabel string) schema.SingleNestedAttribute {
@@ -276,7 +306,7 @@ func createDatadogTemplateSchema() schema.SingleNestedAttribute {
 	}
 }
 
-func (r *automationV2Resource) Schema(_ context.Context, req resource.SchemaRequest, res *resource.SchemaResponse) {
+func (r *automationV2Resource) Schema(ctx context.Context, req resource.SchemaRequest, res *resource.SchemaResponse) {
 	res.Schema = schema.Schema{
 		Description: "Provides an automation. You can read more about automations [here](https://docs.orcasecurity.io/docs/automations).",
 		Attributes: map[string]schema.Attribute{
@@ -488,6 +518,9 @@ func (r *automationV2Resource) Schema(_ context.Context, req resource.SchemaRequ
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts_common.Block(ctx),
+		},
 	}
 }
 
@@ -996,6 +1029,9 @@ func (r *automationV2Resource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	ctx, cancel := timeouts_common.Create(ctx, plan.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
 	filter, err := buildV2Filter(plan.Filter)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -1038,12 +1074,9 @@ func (r *automationV2Resource) Create(ctx context.Context, req resource.CreateRe
 	}
 
 	applyOnExisting := plan.ApplyOnExisting.ValueBool()
-	instance, err := r.apiClient.CreateAutomationV2(createReq, applyOnExisting)
+	instance, err := r.apiClient.CreateAutomationV2(ctx, createReq, applyOnExisting)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating Automation V2",
-			"Could not create Automation V2, unexpected error: "+err.Error(),
-		)
+		apierror_common.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, "Error creating Automation V2", "Could not create Automation V2", err)
 		return
 	}
 
@@ -1056,6 +1089,7 @@ func (r *automationV2Resource) Create(ctx context.Context, req resource.CreateRe
 	}
 
 	plan.ID = types.StringValue(instance.ID)
+	resp.Diagnostics.Append(identity_common.SetID(ctx, resp.Identity, plan.ID.ValueString())...)
 	plan.OrganizationID = types.StringValue(instance.OrganizationID)
 	plan.ApplyOnExisting = types.BoolValue(applyOnExisting)
 
@@ -1067,7 +1101,7 @@ func (r *automationV2Resource) Create(ctx context.Context, req resource.CreateRe
 
 	plan.EndTime = normalizeEndTime(plan.EndTime, instance.EndTime)
 
-	r.applyPlanPriorityOnCreate(&plan, instance.ID, resp)
+	r.applyPlanPriorityOnCreate(ctx, &plan, instance.ID, resp)
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -1077,6 +1111,7 @@ func (r *automationV2Resource) Create(ctx context.Context, req resource.CreateRe
 }
 
 func (r *automationV2Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(identity_common.SetIDFromState(ctx, resp.Identity, req.State)...)
 	var state automationV2ResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -1084,7 +1119,10 @@ func (r *automationV2Resource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
-	exists, err := r.apiClient.DoesAutomationV2Exist(state.ID.ValueString())
+	ctx, cancel := timeouts_common.Read(ctx, state.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
+	exists, err := r.apiClient.DoesAutomationV2Exist(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading Automation V2",
@@ -1098,7 +1136,7 @@ func (r *automationV2Resource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
-	instance, err := r.apiClient.GetAutomationV2(state.ID.ValueString())
+	instance, err := r.apiClient.GetAutomationV2(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading Automation V2",
@@ -1153,6 +1191,7 @@ func (r *automationV2Resource) Read(ctx context.Context, req resource.ReadReques
 }
 
 func (r *automationV2Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(identity_common.SetIDFromState(ctx, resp.Identity, req.State)...)
 	var plan automationV2ResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -1160,6 +1199,9 @@ func (r *automationV2Resource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	ctx, cancel := timeouts_common.Update(ctx, plan.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
 	// A priority-only change goes through the dedicated priority endpoint
 	// alone, like the UI: the full CRUD update below has the backend side
 	// effect of resetting every action's status to ACTIVE.
@@ -1222,7 +1264,7 @@ func (r *automationV2Resource) Update(ctx context.Context, req resource.UpdateRe
 		updateReq.EndTime = plan.EndTime.ValueString()
 	}
 
-	updatedInstance, err := r.apiClient.UpdateAutomationV2(plan.ID.ValueString(), updateReq)
+	updatedInstance, err := r.apiClient.UpdateAutomationV2(ctx, plan.ID.ValueString(), updateReq)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating Automation V2",
@@ -1239,7 +1281,7 @@ func (r *automationV2Resource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
-	verifyInstance, err := r.apiClient.GetAutomationV2(plan.ID.ValueString())
+	verifyInstance, err := r.apiClient.GetAutomationV2(ctx, plan.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading Automation V2",
@@ -1270,7 +1312,7 @@ func (r *automationV2Resource) Update(ctx context.Context, req resource.UpdateRe
 	// Refresh end_time from API response (server might normalize the format)
 	plan.EndTime = normalizeEndTime(plan.EndTime, updatedInstance.EndTime)
 
-	resp.Diagnostics.Append(r.resolvePlanPriorityOnUpdate(&plan, verifyInstance)...)
+	resp.Diagnostics.Append(r.resolvePlanPriorityOnUpdate(ctx, &plan, verifyInstance)...)
 
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -1284,7 +1326,10 @@ func (r *automationV2Resource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
-	err := r.apiClient.DeleteAutomationV2(state.ID.ValueString())
+	ctx, cancel := timeouts_common.Delete(ctx, state.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
+	err := r.apiClient.DeleteAutomationV2(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting Automation V2",
@@ -79,8 +79,8 @@ func (ds *automationPrioritiesDataSource) Schema(_ context.Context, _ datasource
 
 // fetchAutomations retrieves all automations in server evaluation order and
 // maps them to the data source's entry model.
-func (ds *automationPrioritiesDataSource) fetchAutomations() ([]automationPriorityEntryModel, error) {
-	instances, err := ds.apiClient.ListAutomationsV2()
+func (ds *automationPrioritiesDataSource) fetchAutomations(ctx context.Context) ([]automationPriorityEntryModel, error) {
+	instances, err := ds.apiClient.ListAutomationsV2(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -97,7 +97,7 @@ func (ds *automationPrioritiesDataSource) fetchAutomations() ([]automationPriori
 }
 
 func (ds *automationPrioritiesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	entries, err := ds.fetchAutomations()
+	entries, err := ds.fetchAutomations(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading automations",
@@ -38,7 +38,7 @@ func TestFetchAutomationsMapsServerOrder(t *testing.T) {
 		{"id":"a2","name":"second","status":"disabled","filter":{"sonar_query":{"models":["Alert"],"type":"object_set"}},"actions":[],"priority":2}
 	]}`)
 
-	entries, err := ds.fetchAutomations()
+	entries, err := ds.fetchAutomations(context.Background())
 	if err != nil {
 		t.Fatalf("fetchAutomations failed: %v", err)
 	}
@@ -56,7 +56,7 @@ func TestFetchAutomationsMapsServerOrder(t *testing.T) {
 func TestFetchAutomationsEmpty(t *testing.T) {
 	ds := stubDataSource(`{"total_items": 0, "data": []}`)
 
-	entries, err := ds.fetchAutomations()
+	entries, err := ds.fetchAutomations(context.Background())
 	if err != nil {
 		t.Fatalf("fetchAutomations failed: %v", err)
 	}
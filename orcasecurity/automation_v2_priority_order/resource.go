@@ -5,6 +5,9 @@ import (
 	"fmt"
 	"slices"
 	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	"terraform-provider-orcasecurity/orcasecurity/apierror_common"
+	"terraform-provider-orcasecurity/orcasecurity/identity_common"
+	"terraform-provider-orcasecurity/orcasecurity/timeouts_common"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -18,6 +21,7 @@ import (
 var (
 	_ resource.Resource              = &automationPriorityOrderResource{}
 	_ resource.ResourceWithConfigure = &automationPriorityOrderResource{}
+	_ resource.ResourceWithIdentity  = &automationPriorityOrderResource{}
 )
 
 // resourceID is the fixed singleton ID: priority is one global ordering per
@@ -31,6 +35,8 @@ type automationPriorityOrderResource struct {
 type automationPriorityOrderResourceModel struct {
 	ID            types.String `tfsdk:"id"`
 	AutomationIDs types.List   `tfsdk:"automation_ids"`
+
+	Timeouts timeouts_common.Value `tfsdk:"timeouts"`
 }
 
 func NewAutomationPriorityOrderResource() resource.Resource {
@@ -48,7 +54,12 @@ func (r *automationPriorityOrderResource) Configure(_ context.Context, req resou
 	r.apiClient = req.ProviderData.(*api_client.APIClient)
 }
 
-func (r *automationPriorityOrderResource) Schema(_ context.Context, _ resource.SchemaRequest, res *resource.SchemaResponse) {
+// IdentitySchema is the shared single-ID identity; the ID is always resourceID.
+func (r *automationPriorityOrderResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, res *resource.IdentitySchemaResponse) {
+	res.IdentitySchema = identity_common.IDSchema()
+}
+
+func (r *automationPriorityOrderResource) Schema(ctx context.Context, _ resource.SchemaRequest, res *resource.SchemaResponse) {
 	res.Schema = schema.Schema{
 		Description: "Owns the top evaluation-order positions of the organization's automations. " +
 			"The listed automations are assigned priorities 1..N in list order on every apply. " +
@@ -73,6 +84,9 @@ func (r *automationPriorityOrderResource) Schema(_ context.Context, _ resource.S
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts_common.Block(ctx),
+		},
 	}
 }
 
@@ -80,9 +94,9 @@ func (r *automationPriorityOrderResource) Schema(_ context.Context, _ resource.S
 // sequentially and unconditionally. Sequential single-writer application makes
 // the outcome deterministic; the server no-ops moves where old == new, and a
 // pre-read skip would go stale because each move shifts later automations.
-func (r *automationPriorityOrderResource) assertOrder(ids []string) error {
+func (r *automationPriorityOrderResource) assertOrder(ctx context.Context, ids []string) error {
 	for i, id := range ids {
-		if _, err := r.apiClient.SetAutomationV2Priority(id, int64(i+1)); err != nil {
+		if _, err := r.apiClient.SetAutomationV2Priority(ctx, id, int64(i+1)); err != nil {
 			return fmt.Errorf("setting priority %d for automation %s: %w", i+1, id, err)
 		}
 	}
@@ -97,12 +111,12 @@ func (r *automationPriorityOrderResource) assertOrder(ids []string) error {
 // impossible to separate via the public API. One corrective retry covers
 // transient interleaving; a persistent mismatch fails with the achieved order
 // rather than silently saving a desired state the server does not hold.
-func (r *automationPriorityOrderResource) applyOrder(ids []string) error {
+func (r *automationPriorityOrderResource) applyOrder(ctx context.Context, ids []string) error {
 	for attempt := 0; ; attempt++ {
-		if err := r.assertOrder(ids); err != nil {
+		if err := r.assertOrder(ctx, ids); err != nil {
 			return err
 		}
-		achieved, err := r.topNIDs(len(ids))
+		achieved, err := r.topNIDs(ctx, len(ids))
 		if err != nil {
 			return fmt.Errorf("verifying automation order: %w", err)
 		}
@@ -122,8 +136,8 @@ func (r *automationPriorityOrderResource) applyOrder(ids []string) error {
 
 // topNIDs returns the IDs of the first n automations in server evaluation
 // order (fewer if the organization has fewer automations).
-func (r *automationPriorityOrderResource) topNIDs(n int) ([]string, error) {
-	instances, err := r.apiClient.ListAutomationsV2()
+func (r *automationPriorityOrderResource) topNIDs(ctx context.Context, n int) ([]string, error) {
+	instances, err := r.apiClient.ListAutomationsV2(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -150,32 +164,34 @@ func (r *automationPriorityOrderResource) Create(ctx context.Context, req resour
 		return
 	}
 
-	if err := r.applyOrder(r.listToIDs(ctx, plan.AutomationIDs)); err != nil {
-		resp.Diagnostics.AddError(
-			"Error setting automation priority order",
-			"Could not set automation priority order: "+err.Error(),
-		)
+	ctx, cancel := timeouts_common.Create(ctx, plan.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
+	if err := r.applyOrder(ctx, r.listToIDs(ctx, plan.AutomationIDs)); err != nil {
+		apierror_common.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, "Error setting automation priority order", "Could not set automation priority order", err)
 		return
 	}
 
 	plan.ID = types.StringValue(resourceID)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(identity_common.SetID(ctx, resp.Identity, resourceID)...)
 }
 
 func (r *automationPriorityOrderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(identity_common.SetID(ctx, resp.Identity, resourceID)...)
 	var state automationPriorityOrderResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := timeouts_common.Read(ctx, state.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
 	tracked := r.listToIDs(ctx, state.AutomationIDs)
-	actual, err := r.topNIDs(len(tracked))
+	actual, err := r.topNIDs(ctx, len(tracked))
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error reading automation priority order",
-			"Could not list automations: "+err.Error(),
-		)
+		apierror_common.AddError(ctx, &resp.Diagnostics, req.State.Schema, "Error reading automation priority order", "Could not list automations", err)
 		return
 	}
 
@@ -195,16 +211,17 @@ func (r *automationPriorityOrderResource) Update(ctx context.Context, req resour
 		return
 	}
 
-	if err := r.applyOrder(r.listToIDs(ctx, plan.AutomationIDs)); err != nil {
-		resp.Diagnostics.AddError(
-			"Error setting automation priority order",
-			"Could not set automation priority order: "+err.Error(),
-		)
+	ctx, cancel := timeouts_common.Update(ctx, plan.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
+	if err := r.applyOrder(ctx, r.listToIDs(ctx, plan.AutomationIDs)); err != nil {
+		apierror_common.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, "Error setting automation priority order", "Could not set automation priority order", err)
 		return
 	}
 
 	plan.ID = types.StringValue(resourceID)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(identity_common.SetID(ctx, resp.Identity, resourceID)...)
 }
 
 func (r *automationPriorityOrderResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
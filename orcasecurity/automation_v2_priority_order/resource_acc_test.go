@@ -1,6 +1,7 @@
 package automation_v2_priority_order_test
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"testing"
@@ -27,7 +28,7 @@ func testAccCheckServerPriority(resourceName string, want int64) resource.TestCh
 		if err != nil {
 			return err
 		}
-		instance, err := client.GetAutomationV2(rs.Primary.ID)
+		instance, err := client.GetAutomationV2(context.Background(), rs.Primary.ID)
 		if err != nil {
 			return err
 		}
@@ -82,7 +82,7 @@ func TestAssertOrderPutsSequentially(t *testing.T) {
 		}
 	})
 
-	err := r.assertOrder([]string{"b", "a", "c"})
+	err := r.assertOrder(context.Background(), []string{"b", "a", "c"})
 	if err != nil {
 		t.Fatalf("assertOrder failed: %v", err)
 	}
@@ -109,7 +109,7 @@ func TestAssertOrderSurfacesFailingID(t *testing.T) {
 		}
 	})
 
-	err := r.assertOrder([]string{"ok", "gone"})
+	err := r.assertOrder(context.Background(), []string{"ok", "gone"})
 	if err == nil {
 		t.Fatal("expected error for missing automation, got nil")
 	}
@@ -142,7 +142,7 @@ func orderStub(serverOrder ...string) *automationPriorityOrderResource {
 
 func TestApplyOrderVerifiesAchievedOrder(t *testing.T) {
 	r := orderStub("a", "b")
-	if err := r.applyOrder([]string{"a", "b"}); err != nil {
+	if err := r.applyOrder(context.Background(), []string{"a", "b"}); err != nil {
 		t.Fatalf("applyOrder must succeed when the server converges, got: %v", err)
 	}
 }
@@ -152,7 +152,7 @@ func TestApplyOrderVerifiesAchievedOrder(t *testing.T) {
 // achieved order instead of silently saving the desired state.
 func TestApplyOrderFailsWhenServerDoesNotConverge(t *testing.T) {
 	r := orderStub("a", "x")
-	err := r.applyOrder([]string{"a", "c"})
+	err := r.applyOrder(context.Background(), []string{"a", "c"})
 	if err == nil {
 		t.Fatal("expected convergence error, got nil")
 	}
@@ -168,7 +168,7 @@ func TestTopNIDs(t *testing.T) {
 		return &http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(body)), Request: req}
 	})
 
-	ids, err := r.topNIDs(2)
+	ids, err := r.topNIDs(context.Background(), 2)
 	if err != nil {
 		t.Fatalf("topNIDs failed: %v", err)
 	}
@@ -183,7 +183,7 @@ func TestTopNIDsFewerThanN(t *testing.T) {
 		return &http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(body)), Request: req}
 	})
 
-	ids, err := r.topNIDs(5)
+	ids, err := r.topNIDs(context.Background(), 5)
 	if err != nil {
 		t.Fatalf("topNIDs failed: %v", err)
 	}
@@ -0,0 +1,65 @@
+package aws_security_lake
+
+import (
+	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	cc "terraform-provider-orcasecurity/orcasecurity/config_integration_common"
+	"terraform-provider-orcasecurity/orcasecurity/internal/testutils"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The shared suite covers the envelope plumbing; the closures below describe the Security Lake
+// config fields, all of which the API round-trips.
+func TestMapping(t *testing.T) {
+	testutils.RunMappingSuite(t, testutils.MappingSuite[api_client.AwsSecurityLakeConfig]{
+		BuildPayload: buildPayload,
+		Extract:      extract,
+		TemplateName: "tf-acc-test-aws-security-lake",
+		FilledState: func() cc.State {
+			return &state{
+				S3Location: types.StringValue("s3://aws-security-data-lake-us-east-1-abc123/ext/orca/"),
+				Region:     types.StringValue("us-east-1"),
+				RoleArn:    types.StringValue("arn:aws:iam::123456789012:role/orca-aws-security-lake"),
+				ExternalID: types.StringValue("orca-external-id"),
+			}
+		},
+		CheckConfig: func(t *testing.T, c api_client.AwsSecurityLakeConfig) {
+			testutils.AssertEq(t, "s3_location", c.S3Location, "s3://aws-security-data-lake-us-east-1-abc123/ext/orca/")
+			testutils.AssertEq(t, "region", c.Region, "us-east-1")
+			testutils.AssertEq(t, "role_arn", c.RoleArn, "arn:aws:iam::123456789012:role/orca-aws-security-lake")
+			testutils.AssertEq(t, "external_id", c.ExternalID, "orca-external-id")
+		},
+		EchoConfig: api_client.AwsSecurityLakeConfig{
+			S3Location: "s3://aws-security-data-lake-eu-west-1-def456/ext/orca/",
+			Region:     "eu-west-1",
+			RoleArn:    "arn:aws:iam::123456789012:role/returned",
+			ExternalID: "returned-external-id",
+		},
+		EchoState: func() cc.State {
+			return &state{S3Location: types.StringValue("planned"), Region: types.StringValue("us-east-1")}
+		},
+		CheckEchoed: func(t *testing.T, st cc.State) {
+			s := st.(*state)
+			testutils.AssertEq(t, "echoed s3_location", s.S3Location.ValueString(), "s3://aws-security-data-lake-eu-west-1-def456/ext/orca/")
+			testutils.AssertEq(t, "echoed region", s.Region.ValueString(), "eu-west-1")
+			testutils.AssertEq(t, "echoed role_arn", s.RoleArn.ValueString(), "arn:aws:iam::123456789012:role/returned")
+			testutils.AssertEq(t, "echoed external_id", s.ExternalID.ValueString(), "returned-external-id")
+		},
+		ZeroConfigChecks: []testutils.StateCheck{
+			{
+				Name: "empty config keeps planned values",
+				State: func() cc.State {
+					return &state{S3Location: types.StringValue("planned"), ExternalID: types.StringValue("planned-external-id")}
+				},
+				Check: func(t *testing.T, st cc.State) {
+					s := st.(*state)
+					testutils.AssertEq(t, "planned s3_location", s.S3Location.ValueString(), "planned")
+					testutils.AssertEq(t, "planned external_id", s.ExternalID.ValueString(), "planned-external-id")
+				},
+			},
+		},
+	})
+}
+
+var _ cc.State = &state{}
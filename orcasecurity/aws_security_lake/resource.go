@@ -0,0 +1,98 @@
+package aws_security_lake
+
+import (
+	"context"
+	"maps"
+	"regexp"
+	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	cc "terraform-provider-orcasecurity/orcasecurity/config_integration_common"
+	common "terraform-provider-orcasecurity/orcasecurity/integrations_common"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// s3LocationPattern matches the s3:// URI Security Lake reports for a custom source.
+var s3LocationPattern = regexp.MustCompile(`^s3://[a-z0-9.-]+(/.*)?$`)
+
+type state struct {
+	cc.CommonFields
+	S3Location types.String `tfsdk:"s3_location"`
+	Region     types.String `tfsdk:"region"`
+	RoleArn    types.String `tfsdk:"role_arn"`
+	ExternalID types.String `tfsdk:"external_id"`
+}
+
+func variantAttributes() map[string]schema.Attribute {
+	attrs := map[string]schema.Attribute{
+		"s3_location": schema.StringAttribute{
+			Required:    true,
+			Description: "S3 location of the Security Lake custom source, for example `s3://aws-security-data-lake-us-east-1-abc123/ext/orca/`.",
+			Validators:  []validator.String{stringvalidator.RegexMatches(s3LocationPattern, "must be an s3:// URI")},
+		},
+	}
+	maps.Copy(attrs, common.AWSRoleAttributes())
+	return attrs
+}
+
+// buildPayload converts the planned state into the API payload.
+func buildPayload(_ context.Context, st cc.State, _ *diag.Diagnostics) api_client.AwsSecurityLakeExternalServiceConfig {
+	s := st.(*state)
+	return api_client.AwsSecurityLakeExternalServiceConfig{
+		TemplateName: s.TemplateName.ValueString(),
+		IsEnabled:    s.IsEnabled.ValueBool(),
+		IsDefault:    s.IsDefault.ValueBool(),
+		Config: api_client.AwsSecurityLakeConfig{
+			S3Location: s.S3Location.ValueString(),
+			Region:     s.Region.ValueString(),
+			RoleArn:    s.RoleArn.ValueString(),
+			ExternalID: s.ExternalID.ValueString(),
+		},
+	}
+}
+
+// extract maps the API envelope back onto state; empty fields never clobber the plan.
+func extract(_ context.Context, o *api_client.AwsSecurityLakeExternalServiceConfig, st cc.State, _ *diag.Diagnostics) cc.APIObject {
+	s := st.(*state)
+	if o.Config.S3Location != "" {
+		s.S3Location = types.StringValue(o.Config.S3Location)
+	}
+	if o.Config.Region != "" {
+		s.Region = types.StringValue(o.Config.Region)
+	}
+	if o.Config.RoleArn != "" {
+		s.RoleArn = types.StringValue(o.Config.RoleArn)
+	}
+	if o.Config.ExternalID != "" {
+		s.ExternalID = types.StringValue(o.Config.ExternalID)
+	}
+	return cc.APIObject{ID: o.ID, TemplateName: o.TemplateName, IsEnabled: o.IsEnabled, IsDefault: o.IsDefault}
+}
+
+func NewAwsSecurityLakeResource() resource.Resource {
+	return cc.New(cc.Spec[api_client.AwsSecurityLakeExternalServiceConfig]{
+		TypeNameSuffix:    "_integration_aws_security_lake",
+		ServiceName:       api_client.AwsSecurityLakeServiceName,
+		UIName:            "AWS Security Lake integration",
+		Description:       "Manage an AWS Security Lake integration in Orca. Creates an external service config of `service_name = \"aws_security_lake\"` that writes alerts as OCSF findings to a Security Lake custom source, using the custom source's provider role.",
+		VariantAttributes: variantAttributes(),
+		NewState:          func() cc.State { return &state{} },
+		BuildPayload:      buildPayload,
+		Extract:           extract,
+		Create:            (*api_client.APIClient).CreateAwsSecurityLakeConfig,
+		Get:               (*api_client.APIClient).GetAwsSecurityLakeConfig,
+		Update:            (*api_client.APIClient).UpdateAwsSecurityLakeConfig,
+		Delete:            (*api_client.APIClient).DeleteAwsSecurityLakeConfig,
+		List:              (*api_client.APIClient).ListAwsSecurityLakeConfigs,
+	})
+}
+
+// NewAwsSecurityLakeListResource lists the integrations managed by NewAwsSecurityLakeResource.
+func NewAwsSecurityLakeListResource() list.ListResource {
+	return cc.NewList(NewAwsSecurityLakeResource)
+}
@@ -0,0 +1,25 @@
+package aws_security_lake_test
+
+import (
+	"terraform-provider-orcasecurity/orcasecurity/internal/acctest"
+	"testing"
+)
+
+func TestAccAwsSecurityLakeResource(t *testing.T) {
+	acctest.RunIntegrationTest(t, awsSecurityLakeSpec)
+}
+
+func TestAwsSecurityLakeResource_Offline(t *testing.T) {
+	acctest.RunIntegrationTestOffline(t, awsSecurityLakeSpec)
+}
+
+var awsSecurityLakeSpec = acctest.IntegrationSpec{
+	ResourceType: "orcasecurity_integration_aws_security_lake",
+	TemplateName: "tf-acc-test-aws-security-lake",
+	Attributes: map[string]string{
+		"s3_location": `"s3://aws-security-data-lake-us-east-1-abc123/ext/orca/"`,
+		"external_id": `"tf-acc-test-external-id"`,
+		"region":      `"us-east-1"`,
+		"role_arn":    `"arn:aws:iam::123456789012:role/tf-acc-test-aws-security-lake"`,
+	},
+}
@@ -0,0 +1,19 @@
+package aws_security_lake
+
+import (
+	"terraform-provider-orcasecurity/orcasecurity/internal/testutils"
+	"testing"
+)
+
+// Security Lake authenticates through an assumed role, so the variant has no secrets: the target,
+// role ARN, external ID and region are all plain required attributes. The variant uses the
+// no-BU CommonFields flavour, so business_units must be absent.
+func TestAwsSecurityLakeResource_SchemaContract(t *testing.T) {
+	testutils.CheckVariantResource(t, testutils.VariantResourceSpec{
+		NewResource:   NewAwsSecurityLakeResource,
+		TypeName:      "orcasecurity_integration_aws_security_lake",
+		PlainRequired: []string{"s3_location", "role_arn", "external_id", "region"},
+		Forbidden:     []string{"business_units"},
+		State:         &state{},
+	})
+}
@@ -0,0 +1,65 @@
+package aws_sns
+
+import (
+	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	cc "terraform-provider-orcasecurity/orcasecurity/config_integration_common"
+	"terraform-provider-orcasecurity/orcasecurity/internal/testutils"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The shared suite covers the envelope plumbing; the closures below describe the SNS
+// config fields, all of which the API round-trips.
+func TestMapping(t *testing.T) {
+	testutils.RunMappingSuite(t, testutils.MappingSuite[api_client.AwsSnsConfig]{
+		BuildPayload: buildPayload,
+		Extract:      extract,
+		TemplateName: "tf-acc-test-aws-sns",
+		FilledState: func() cc.State {
+			return &state{
+				TopicArn:   types.StringValue("arn:aws:sns:us-east-1:123456789012:orca-alerts"),
+				Region:     types.StringValue("us-east-1"),
+				RoleArn:    types.StringValue("arn:aws:iam::123456789012:role/orca-aws-sns"),
+				ExternalID: types.StringValue("orca-external-id"),
+			}
+		},
+		CheckConfig: func(t *testing.T, c api_client.AwsSnsConfig) {
+			testutils.AssertEq(t, "topic_arn", c.TopicArn, "arn:aws:sns:us-east-1:123456789012:orca-alerts")
+			testutils.AssertEq(t, "region", c.Region, "us-east-1")
+			testutils.AssertEq(t, "role_arn", c.RoleArn, "arn:aws:iam::123456789012:role/orca-aws-sns")
+			testutils.AssertEq(t, "external_id", c.ExternalID, "orca-external-id")
+		},
+		EchoConfig: api_client.AwsSnsConfig{
+			TopicArn:   "arn:aws:sns:eu-west-1:123456789012:returned",
+			Region:     "eu-west-1",
+			RoleArn:    "arn:aws:iam::123456789012:role/returned",
+			ExternalID: "returned-external-id",
+		},
+		EchoState: func() cc.State {
+			return &state{TopicArn: types.StringValue("planned"), Region: types.StringValue("us-east-1")}
+		},
+		CheckEchoed: func(t *testing.T, st cc.State) {
+			s := st.(*state)
+			testutils.AssertEq(t, "echoed topic_arn", s.TopicArn.ValueString(), "arn:aws:sns:eu-west-1:123456789012:returned")
+			testutils.AssertEq(t, "echoed region", s.Region.ValueString(), "eu-west-1")
+			testutils.AssertEq(t, "echoed role_arn", s.RoleArn.ValueString(), "arn:aws:iam::123456789012:role/returned")
+			testutils.AssertEq(t, "echoed external_id", s.ExternalID.ValueString(), "returned-external-id")
+		},
+		ZeroConfigChecks: []testutils.StateCheck{
+			{
+				Name: "empty config keeps planned values",
+				State: func() cc.State {
+					return &state{TopicArn: types.StringValue("planned"), ExternalID: types.StringValue("planned-external-id")}
+				},
+				Check: func(t *testing.T, st cc.State) {
+					s := st.(*state)
+					testutils.AssertEq(t, "planned topic_arn", s.TopicArn.ValueString(), "planned")
+					testutils.AssertEq(t, "planned external_id", s.ExternalID.ValueString(), "planned-external-id")
+				},
+			},
+		},
+	})
+}
+
+var _ cc.State = &state{}
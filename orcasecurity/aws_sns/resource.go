@@ -0,0 +1,98 @@
+package aws_sns
+
+import (
+	"context"
+	"maps"
+	"regexp"
+	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	cc "terraform-provider-orcasecurity/orcasecurity/config_integration_common"
+	common "terraform-provider-orcasecurity/orcasecurity/integrations_common"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// topicArnPattern matches an SNS topic ARN in any AWS partition.
+var topicArnPattern = regexp.MustCompile(`^arn:aws[a-z-]*:sns:[a-z0-9-]+:\d{12}:.+`)
+
+type state struct {
+	cc.CommonFields
+	TopicArn   types.String `tfsdk:"topic_arn"`
+	Region     types.String `tfsdk:"region"`
+	RoleArn    types.String `tfsdk:"role_arn"`
+	ExternalID types.String `tfsdk:"external_id"`
+}
+
+func variantAttributes() map[string]schema.Attribute {
+	attrs := map[string]schema.Attribute{
+		"topic_arn": schema.StringAttribute{
+			Required:    true,
+			Description: "ARN of the SNS topic Orca publishes alerts to, for example `arn:aws:sns:us-east-1:123456789012:orca-alerts`. The role needs `sns:Publish` on it.",
+			Validators:  []validator.String{stringvalidator.RegexMatches(topicArnPattern, "must be an SNS topic ARN")},
+		},
+	}
+	maps.Copy(attrs, common.AWSRoleAttributes())
+	return attrs
+}
+
+// buildPayload converts the planned state into the API payload.
+func buildPayload(_ context.Context, st cc.State, _ *diag.Diagnostics) api_client.AwsSnsExternalServiceConfig {
+	s := st.(*state)
+	return api_client.AwsSnsExternalServiceConfig{
+		TemplateName: s.TemplateName.ValueString(),
+		IsEnabled:    s.IsEnabled.ValueBool(),
+		IsDefault:    s.IsDefault.ValueBool(),
+		Config: api_client.AwsSnsConfig{
+			TopicArn:   s.TopicArn.ValueString(),
+			Region:     s.Region.ValueString(),
+			RoleArn:    s.RoleArn.ValueString(),
+			ExternalID: s.ExternalID.ValueString(),
+		},
+	}
+}
+
+// extract maps the API envelope back onto state; empty fields never clobber the plan.
+func extract(_ context.Context, o *api_client.AwsSnsExternalServiceConfig, st cc.State, _ *diag.Diagnostics) cc.APIObject {
+	s := st.(*state)
+	if o.Config.TopicArn != "" {
+		s.TopicArn = types.StringValue(o.Config.TopicArn)
+	}
+	if o.Config.Region != "" {
+		s.Region = types.StringValue(o.Config.Region)
+	}
+	if o.Config.RoleArn != "" {
+		s.RoleArn = types.StringValue(o.Config.RoleArn)
+	}
+	if o.Config.ExternalID != "" {
+		s.ExternalID = types.StringValue(o.Config.ExternalID)
+	}
+	return cc.APIObject{ID: o.ID, TemplateName: o.TemplateName, IsEnabled: o.IsEnabled, IsDefault: o.IsDefault}
+}
+
+func NewAwsSnsResource() resource.Resource {
+	return cc.New(cc.Spec[api_client.AwsSnsExternalServiceConfig]{
+		TypeNameSuffix:    "_integration_aws_sns",
+		ServiceName:       api_client.AwsSnsServiceName,
+		UIName:            "AWS SNS integration",
+		Description:       "Manage an AWS SNS integration in Orca. Creates an external service config of `service_name = \"aws_sns\"` that publishes alerts to an SNS topic, using a role in your account that Orca assumes.",
+		VariantAttributes: variantAttributes(),
+		NewState:          func() cc.State { return &state{} },
+		BuildPayload:      buildPayload,
+		Extract:           extract,
+		Create:            (*api_client.APIClient).CreateAwsSnsConfig,
+		Get:               (*api_client.APIClient).GetAwsSnsConfig,
+		Update:            (*api_client.APIClient).UpdateAwsSnsConfig,
+		Delete:            (*api_client.APIClient).DeleteAwsSnsConfig,
+		List:              (*api_client.APIClient).ListAwsSnsConfigs,
+	})
+}
+
+// NewAwsSnsListResource lists the integrations managed by NewAwsSnsResource.
+func NewAwsSnsListResource() list.ListResource {
+	return cc.NewList(NewAwsSnsResource)
+}
@@ -0,0 +1,25 @@
+package aws_sns_test
+
+import (
+	"terraform-provider-orcasecurity/orcasecurity/internal/acctest"
+	"testing"
+)
+
+func TestAccAwsSnsResource(t *testing.T) {
+	acctest.RunIntegrationTest(t, awsSnsSpec)
+}
+
+func TestAwsSnsResource_Offline(t *testing.T) {
+	acctest.RunIntegrationTestOffline(t, awsSnsSpec)
+}
+
+var awsSnsSpec = acctest.IntegrationSpec{
+	ResourceType: "orcasecurity_integration_aws_sns",
+	TemplateName: "tf-acc-test-aws-sns",
+	Attributes: map[string]string{
+		"topic_arn":   `"arn:aws:sns:us-east-1:123456789012:tf-acc-test"`,
+		"external_id": `"tf-acc-test-external-id"`,
+		"region":      `"us-east-1"`,
+		"role_arn":    `"arn:aws:iam::123456789012:role/tf-acc-test-aws-sns"`,
+	},
+}
@@ -0,0 +1,98 @@
+package aws_sqs
+
+import (
+	"context"
+	"maps"
+	"regexp"
+	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	cc "terraform-provider-orcasecurity/orcasecurity/config_integration_common"
+	common "terraform-provider-orcasecurity/orcasecurity/integrations_common"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// queueArnPattern matches an SQS queue ARN in any AWS partition.
+var queueArnPattern = regexp.MustCompile(`^arn:aws[a-z-]*:sqs:[a-z0-9-]+:\d{12}:.+`)
+
+type state struct {
+	cc.CommonFields
+	QueueArn   types.String `tfsdk:"queue_arn"`
+	Region     types.String `tfsdk:"region"`
+	RoleArn    types.String `tfsdk:"role_arn"`
+	ExternalID types.String `tfsdk:"external_id"`
+}
+
+func variantAttributes() map[string]schema.Attribute {
+	attrs := map[string]schema.Attribute{
+		"queue_arn": schema.StringAttribute{
+			Required:    true,
+			Description: "ARN of the SQS queue Orca sends alerts to, for example `arn:aws:sqs:us-east-1:123456789012:orca-alerts`. The role needs `sqs:SendMessage` on it.",
+			Validators:  []validator.String{stringvalidator.RegexMatches(queueArnPattern, "must be an SQS queue ARN")},
+		},
+	}
+	maps.Copy(attrs, common.AWSRoleAttributes())
+	return attrs
+}
+
+// buildPayload converts the planned state into the API payload.
+func buildPayload(_ context.Context, st cc.State, _ *diag.Diagnostics) api_client.AwsSqsExternalServiceConfig {
+	s := st.(*state)
+	return api_client.AwsSqsExternalServiceConfig{
+		TemplateName: s.TemplateName.ValueString(),
+		IsEnabled:    s.IsEnabled.ValueBool(),
+		IsDefault:    s.IsDefault.ValueBool(),
+		Config: api_client.AwsSqsConfig{
+			QueueArn:   s.QueueArn.ValueString(),
+			Region:     s.Region.ValueString(),
+			RoleArn:    s.RoleArn.ValueString(),
+			ExternalID: s.ExternalID.ValueString(),
+		},
+	}
+}
+
+// extract maps the API envelope back onto state; empty fields never clobber the plan.
+func extract(_ context.Context, o *api_client.AwsSqsExternalServiceConfig, st cc.State, _ *diag.Diagnostics) cc.APIObject {
+	s := st.(*state)
+	if o.Config.QueueArn != "" {
+		s.QueueArn = types.StringValue(o.Config.QueueArn)
+	}
+	if o.Config.Region != "" {
+		s.Region = types.StringValue(o.Config.Region)
+	}
+	if o.Config.RoleArn != "" {
+		s.RoleArn = types.StringValue(o.Config.RoleArn)
+	}
+	if o.Config.ExternalID != "" {
+		s.ExternalID = types.StringValue(o.Config.ExternalID)
+	}
+	return cc.APIObject{ID: o.ID, TemplateName: o.TemplateName, IsEnabled: o.IsEnabled, IsDefault: o.IsDefault}
+}
+
+func NewAwsSqsResource() resource.Resource {
+	return cc.New(cc.Spec[api_client.AwsSqsExternalServiceConfig]{
+		TypeNameSuffix:    "_integration_aws_sqs",
+		ServiceName:       api_client.AwsSqsServiceName,
+		UIName:            "AWS SQS integration",
+		Description:       "Manage an AWS SQS integration in Orca. Creates an external service config of `service_name = \"aws_sqs\"` that sends alerts as messages to an SQS queue, using a role in your account that Orca assumes.",
+		VariantAttributes: variantAttributes(),
+		NewState:          func() cc.State { return &state{} },
+		BuildPayload:      buildPayload,
+		Extract:           extract,
+		Create:            (*api_client.APIClient).CreateAwsSqsConfig,
+		Get:               (*api_client.APIClient).GetAwsSqsConfig,
+		Update:            (*api_client.APIClient).UpdateAwsSqsConfig,
+		Delete:            (*api_client.APIClient).DeleteAwsSqsConfig,
+		List:              (*api_client.APIClient).ListAwsSqsConfigs,
+	})
+}
+
+// NewAwsSqsListResource lists the integrations managed by NewAwsSqsResource.
+func NewAwsSqsListResource() list.ListResource {
+	return cc.NewList(NewAwsSqsResource)
+}
@@ -0,0 +1,25 @@
+package aws_sqs_test
+
+import (
+	"terraform-provider-orcasecurity/orcasecurity/internal/acctest"
+	"testing"
+)
+
+func TestAccAwsSqsResource(t *testing.T) {
+	acctest.RunIntegrationTest(t, awsSqsSpec)
+}
+
+func TestAwsSqsResource_Offline(t *testing.T) {
+	acctest.RunIntegrationTestOffline(t, awsSqsSpec)
+}
+
+var awsSqsSpec = acctest.IntegrationSpec{
+	ResourceType: "orcasecurity_integration_aws_sqs",
+	TemplateName: "tf-acc-test-aws-sqs",
+	Attributes: map[string]string{
+		"queue_arn":   `"arn:aws:sqs:us-east-1:123456789012:tf-acc-test"`,
+		"external_id": `"tf-acc-test-external-id"`,
+		"region":      `"us-east-1"`,
+		"role_arn":    `"arn:aws:iam::123456789012:role/tf-acc-test-aws-sqs"`,
+	},
+}
@@ -0,0 +1,19 @@
+package aws_sqs
+
+import (
+	"terraform-provider-orcasecurity/orcasecurity/internal/testutils"
+	"testing"
+)
+
+// SQS authenticates through an assumed role, so the variant has no secrets: the target,
+// role ARN, external ID and region are all plain required attributes. The variant uses the
+// no-BU CommonFields flavour, so business_units must be absent.
+func TestAwsSqsResource_SchemaContract(t *testing.T) {
+	testutils.CheckVariantResource(t, testutils.VariantResourceSpec{
+		NewResource:   NewAwsSqsResource,
+		TypeName:      "orcasecurity_integration_aws_sqs",
+		PlainRequired: []string{"queue_arn", "role_arn", "external_id", "region"},
+		Forbidden:     []string{"business_units"},
+		State:         &state{},
+	})
+}
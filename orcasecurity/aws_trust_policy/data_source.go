@@ -0,0 +1,139 @@
+package aws_trust_policy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"terraform-provider-orcasecurity/orcasecurity/api_client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &awsTrustPolicyDataSource{}
+	_ datasource.DataSourceWithConfigure = &awsTrustPolicyDataSource{}
+)
+
+type awsTrustPolicyDataSource struct {
+	apiClient *api_client.APIClient
+}
+
+type awsTrustPolicyDataSourceModel struct {
+	ExternalID      types.String `tfsdk:"external_id"`
+	OrcaAccountID   types.String `tfsdk:"orca_account_id"`
+	PrincipalArn    types.String `tfsdk:"principal_arn"`
+	TrustPolicyJSON types.String `tfsdk:"trust_policy_json"`
+}
+
+func NewAwsTrustPolicyDataSource() datasource.DataSource {
+	return &awsTrustPolicyDataSource{}
+}
+
+func (ds *awsTrustPolicyDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_integration_aws_trust_policy"
+}
+
+func (ds *awsTrustPolicyDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	ds.apiClient = req.ProviderData.(*api_client.APIClient)
+}
+
+func (ds *awsTrustPolicyDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Render the IAM trust policy that lets Orca assume a role in your AWS account. Attach it to the role referenced by `role_arn` on `orcasecurity_integration_aws_sqs`, `orcasecurity_integration_aws_sns` or `orcasecurity_integration_aws_security_lake` *before* creating the integration: Orca's create call assumes the role to run a connectivity check.",
+		Attributes: map[string]schema.Attribute{
+			"external_id": schema.StringAttribute{
+				Required:    true,
+				Description: "External ID Orca presents when assuming the role. Use the same value as `external_id` on the integration resource.",
+				Validators:  []validator.String{stringvalidator.LengthBetween(2, 1224)},
+			},
+			"orca_account_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Orca's AWS account ID (fetched from `GET /api/settings`).",
+			},
+			"principal_arn": schema.StringAttribute{
+				Computed:    true,
+				Description: "ARN of the Orca account root. This is the `Principal.AWS` field in the rendered policy.",
+			},
+			"trust_policy_json": schema.StringAttribute{
+				Computed:    true,
+				Description: "Trust policy JSON ready to use as the role's assume-role policy. Feed straight into `aws_iam_role.assume_role_policy`.",
+			},
+		},
+	}
+}
+
+func (ds *awsTrustPolicyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state awsTrustPolicyDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := ds.apiClient.GetOrcaSettings(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error fetching Orca settings",
+			fmt.Sprintf("Could not fetch Orca settings to build trust policy: %s", err.Error()),
+		)
+		return
+	}
+	if settings.AWSAccountID == "" {
+		resp.Diagnostics.AddError(
+			"Error fetching Orca settings",
+			"Orca settings response did not include aws_account_id.",
+		)
+		return
+	}
+
+	principal := principalArn(settings.ResourcePartition, settings.AWSAccountID)
+	policy, err := buildTrustPolicyJSON(principal, state.ExternalID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error rendering trust policy", err.Error())
+		return
+	}
+
+	state.OrcaAccountID = types.StringValue(settings.AWSAccountID)
+	state.PrincipalArn = types.StringValue(principal)
+	state.TrustPolicyJSON = types.StringValue(policy)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// principalArn returns the account-root ARN of Orca's AWS account. Orca reports its partition
+// in settings; older backends leave it empty, which means the commercial partition.
+func principalArn(partition, accountID string) string {
+	if partition == "" {
+		partition = "aws"
+	}
+	return fmt.Sprintf("arn:%s:iam::%s:root", partition, accountID)
+}
+
+func buildTrustPolicyJSON(principal, externalID string) (string, error) {
+	policy := map[string]interface{}{
+		"Version": "2012-10-17",
+		"Statement": []map[string]interface{}{
+			{
+				"Effect":    "Allow",
+				"Principal": map[string]interface{}{"AWS": principal},
+				"Action":    "sts:AssumeRole",
+				"Condition": map[string]interface{}{
+					"StringEquals": map[string]interface{}{
+						"sts:ExternalId": externalID,
+					},
+				},
+			},
+		},
+	}
+	encoded, err := json.MarshalIndent(policy, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(encoded), nil
+}
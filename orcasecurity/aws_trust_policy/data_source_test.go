@@ -0,0 +1,55 @@
+package aws_trust_policy
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestPrincipalArn(t *testing.T) {
+	tests := []struct {
+		partition string
+		want      string
+	}{
+		{partition: "", want: "arn:aws:iam::111122223333:root"},
+		{partition: "aws", want: "arn:aws:iam::111122223333:root"},
+		{partition: "aws-us-gov", want: "arn:aws-us-gov:iam::111122223333:root"},
+	}
+	for _, tc := range tests {
+		if got := principalArn(tc.partition, "111122223333"); got != tc.want {
+			t.Errorf("principalArn(%q) = %q, want %q", tc.partition, got, tc.want)
+		}
+	}
+}
+
+func TestBuildTrustPolicyJSON(t *testing.T) {
+	out, err := buildTrustPolicyJSON("arn:aws:iam::111122223333:root", "orca-external-id")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var policy struct {
+		Version   string
+		Statement []struct {
+			Effect    string
+			Principal map[string]string
+			Action    string
+			Condition map[string]map[string]string
+		}
+	}
+	if err := json.Unmarshal([]byte(out), &policy); err != nil {
+		t.Fatalf("rendered policy is not valid JSON: %v", err)
+	}
+	if policy.Version != "2012-10-17" || len(policy.Statement) != 1 {
+		t.Fatalf("unexpected policy shape: %s", out)
+	}
+	st := policy.Statement[0]
+	if st.Effect != "Allow" || st.Action != "sts:AssumeRole" {
+		t.Errorf("unexpected statement: %s", out)
+	}
+	if st.Principal["AWS"] != "arn:aws:iam::111122223333:root" {
+		t.Errorf("Principal.AWS = %q", st.Principal["AWS"])
+	}
+	if got := st.Condition["StringEquals"]["sts:ExternalId"]; got != "orca-external-id" {
+		t.Errorf("sts:ExternalId = %q", got)
+	}
+}
@@ -58,7 +58,7 @@ func (ds *azureDevopsTemplateDataSource) Read(ctx context.Context, req datasourc
 	var state azureDevopsTemplateStateModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 
-	item, err := ds.apiClient.GetAzureDevopsTemplateByName(state.Name.ValueString())
+	item, err := ds.apiClient.GetAzureDevopsTemplateByName(ctx, state.Name.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to read Azure DevOps templates", err.Error())
 		return
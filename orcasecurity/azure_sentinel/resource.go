@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -16,9 +17,11 @@ import (
 
 type state struct {
 	cc.CommonFieldsWithBU
-	LogType     types.String `tfsdk:"log_type"`
-	PrimaryKey  types.String `tfsdk:"primary_key"`
-	WorkspaceID types.String `tfsdk:"workspace_id"`
+	LogType             types.String `tfsdk:"log_type"`
+	PrimaryKey          types.String `tfsdk:"primary_key"`
+	PrimaryKeyWO        types.String `tfsdk:"primary_key_wo"`
+	PrimaryKeyWOVersion types.Int64  `tfsdk:"primary_key_wo_version"`
+	WorkspaceID         types.String `tfsdk:"workspace_id"`
 }
 
 // buildPayload converts the planned state into the Azure Sentinel API payload.
@@ -39,7 +42,7 @@ func buildPayload(ctx context.Context, st cc.State, diags *diag.Diagnostics) api
 
 // extract maps the API envelope back onto state; empty log_type / workspace_id never clobber
 // the plan.
-func extract(o *api_client.AzureSentinelExternalServiceConfig, st cc.State, _ *diag.Diagnostics) cc.APIObject {
+func extract(_ context.Context, o *api_client.AzureSentinelExternalServiceConfig, st cc.State, _ *diag.Diagnostics) cc.APIObject {
 	s := st.(*state)
 	if o.Config.LogType != "" {
 		s.LogType = types.StringValue(o.Config.LogType)
@@ -53,6 +56,7 @@ func extract(o *api_client.AzureSentinelExternalServiceConfig, st cc.State, _ *d
 func NewAzureSentinelResource() resource.Resource {
 	return cc.New(cc.Spec[api_client.AzureSentinelExternalServiceConfig]{
 		TypeNameSuffix:        "_integration_azure_sentinel",
+		ServiceName:           api_client.AzureSentinelServiceName,
 		UIName:                "Azure Sentinel integration",
 		Description:           "Manage an Azure Sentinel integration in Orca. Creates an external service config of `service_name = \"azure_sentinel\"`. The Log Analytics workspace primary key is stored in Orca's secret store and is never returned by the API.",
 		SupportsBusinessUnits: true,
@@ -74,12 +78,19 @@ func NewAzureSentinelResource() resource.Resource {
 				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
 			},
 		},
-		NewState:     func() cc.State { return &state{} },
-		BuildPayload: buildPayload,
-		Extract:      extract,
-		Create:       (*api_client.APIClient).CreateAzureSentinelConfig,
-		Get:          (*api_client.APIClient).GetAzureSentinelConfig,
-		Update:       (*api_client.APIClient).UpdateAzureSentinelConfig,
-		Delete:       (*api_client.APIClient).DeleteAzureSentinelConfig,
+		WriteOnlySecrets: []string{"primary_key"},
+		NewState:         func() cc.State { return &state{} },
+		BuildPayload:     buildPayload,
+		Extract:          extract,
+		Create:           (*api_client.APIClient).CreateAzureSentinelConfig,
+		Get:              (*api_client.APIClient).GetAzureSentinelConfig,
+		Update:           (*api_client.APIClient).UpdateAzureSentinelConfig,
+		Delete:           (*api_client.APIClient).DeleteAzureSentinelConfig,
+		List:             (*api_client.APIClient).ListAzureSentinelConfigs,
 	})
 }
+
+// NewAzureSentinelListResource lists the integrations managed by NewAzureSentinelResource.
+func NewAzureSentinelListResource() list.ListResource {
+	return cc.NewList(NewAzureSentinelResource)
+}
@@ -0,0 +1,55 @@
+package business_unit
+
+import (
+	"context"
+
+	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	"terraform-provider-orcasecurity/orcasecurity/identity_common"
+	"terraform-provider-orcasecurity/orcasecurity/list_common"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type listFilterModel struct {
+	NameContains types.String `tfsdk:"name_contains"`
+}
+
+// NewBusinessUnitListResource lists the organization's business units.
+func NewBusinessUnitListResource() list.ListResource {
+	return list_common.New(list_common.Spec{
+		NewResource: NewBusinessUnitResource,
+		Description: "Lists business units, optionally filtered by name.",
+		Attributes: map[string]schema.Attribute{
+			"name_contains": list_common.NameContainsAttribute("business units"),
+		},
+		Fetch: listBusinessUnits,
+	})
+}
+
+func listBusinessUnits(ctx context.Context, client *api_client.APIClient, config tfsdk.Config) ([]list_common.Item, diag.Diagnostics) {
+	var filter listFilterModel
+	diags := config.Get(ctx, &filter)
+	if diags.HasError() {
+		return nil, diags
+	}
+	units, err := client.ListBusinessUnits(ctx)
+	if err != nil {
+		diags.AddError("Error listing business units", "Could not list business units: "+err.Error())
+		return nil, diags
+	}
+	var items []list_common.Item
+	for _, u := range units {
+		if !list_common.Contains(u.Name, filter.NameContains) {
+			continue
+		}
+		items = append(items, list_common.Item{
+			Identity:    map[string]string{identity_common.IDAttribute: u.ID},
+			DisplayName: u.Name,
+		})
+	}
+	return items, diags
+}
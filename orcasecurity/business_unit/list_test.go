@@ -0,0 +1,52 @@
+package business_unit
+
+import (
+	"context"
+	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	"terraform-provider-orcasecurity/orcasecurity/internal/fakeorca"
+	"terraform-provider-orcasecurity/orcasecurity/internal/testutils"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestList_Filters(t *testing.T) {
+	client := fakeorca.New(t).Client()
+	ids := map[string]string{}
+	for _, name := range []string{"Platform Prod", "platform staging", "Security"} {
+		unit, err := client.CreateBusinessUnit(context.Background(), api_client.BusinessUnit{Name: name})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids[name] = unit.ID
+	}
+
+	cases := []struct {
+		name    string
+		filters map[string]tftypes.Value
+		want    []string
+	}{
+		{"no filters", nil, []string{"Platform Prod", "platform staging", "Security"}},
+		{"name", map[string]tftypes.Value{"name_contains": tftypes.NewValue(tftypes.String, "PLATFORM")}, []string{"Platform Prod", "platform staging"}},
+		{"no match", map[string]tftypes.Value{"name_contains": tftypes.NewValue(tftypes.String, "finance")}, nil},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			results := testutils.RunList(t, NewBusinessUnitListResource(), NewBusinessUnitResource, client, testutils.ListQuery{Filters: tc.filters})
+			if len(results) != len(tc.want) {
+				t.Fatalf("got %d results, want %d", len(results), len(tc.want))
+			}
+			for i, r := range results {
+				if r.Diagnostics.HasError() {
+					t.Fatalf("result %d: %v", i, r.Diagnostics)
+				}
+				if r.DisplayName != tc.want[i] {
+					t.Errorf("result %d display name = %q, want %q", i, r.DisplayName, tc.want[i])
+				}
+				if got := testutils.IdentityString(t, r, "id"); got != ids[tc.want[i]] {
+					t.Errorf("result %d id = %q, want %q", i, got, ids[tc.want[i]])
+				}
+			}
+		})
+	}
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"fmt"
 	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	"terraform-provider-orcasecurity/orcasecurity/apierror_common"
+	"terraform-provider-orcasecurity/orcasecurity/identity_common"
+	"terraform-provider-orcasecurity/orcasecurity/timeouts_common"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
@@ -24,6 +27,7 @@ var (
 	_ resource.Resource                = &businessUnitResource{}
 	_ resource.ResourceWithConfigure   = &businessUnitResource{}
 	_ resource.ResourceWithImportState = &businessUnitResource{}
+	_ resource.ResourceWithIdentity    = &businessUnitResource{}
 	//_ resource.ResourceWithConfigValidators = &businessUnitResource{}
 )
 
@@ -55,6 +59,8 @@ type businessUnitResourceModel struct {
 	Application         types.String                      `tfsdk:"application"`
 	ContactEmails       []types.String                    `tfsdk:"contact_emails"`
 	DeploymentStages    []types.String                    `tfsdk:"deployment_stages"`
+
+	Timeouts timeouts_common.Value `tfsdk:"timeouts"`
 }
 
 // uuidValidator validates that a string is a valid UUID.
@@ -97,7 +103,7 @@ func (r *businessUnitResource) Configure(_ context.Context, req resource.Configu
 }
 
 func (r *businessUnitResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	businessUnitId := req.ID
+	businessUnitId := identity_common.ImportedID(ctx, req, &resp.Diagnostics)
 	/*if err != nil {
 		resp.Diagnostics.AddError(
 			"Error importing business unit",
@@ -106,7 +112,7 @@ func (r *businessUnitResource) ImportState(ctx context.Context, req resource.Imp
 		return
 	}*/
 
-	businessUnit, err := r.apiClient.GetBusinessUnit(businessUnitId)
+	businessUnit, err := r.apiClient.GetBusinessUnit(ctx, businessUnitId)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error importing business unit",
@@ -114,6 +120,13 @@ func (r *businessUnitResource) ImportState(ctx context.Context, req resource.Imp
 		)
 		return
 	}
+	if businessUnit == nil {
+		resp.Diagnostics.AddError(
+			"Error importing business unit",
+			fmt.Sprintf("Business unit with ID %s was not found", businessUnitId),
+		)
+		return
+	}
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), businessUnitId)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), businessUnit.Name)...)
@@ -133,6 +146,10 @@ func (r *businessUnitResource) ImportState(ctx context.Context, req resource.Imp
 	resp.Diagnostics.Append(diags...)
 }
 
+func (r *businessUnitResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity_common.IDSchema()
+}
+
 func (r *businessUnitResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Provides a business unit. Please note that Shift Left business units are not yet supported in this Terraform provider. For more information, see the docs on [Business Units](https://docs.orcasecurity.io/docs/business-unit-feature).\n\nPlease note that a business unit cannot be composed of multiple, different filter types. You cannot compose 1 business unit that uses both cloud tags and custom tags, for example.",
@@ -242,6 +259,9 @@ func (r *businessUnitResource) Schema(ctx context.Context, req resource.SchemaRe
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts_common.Block(ctx),
+		},
 	}
 }
 
@@ -426,7 +446,7 @@ func setMetadataInState(state *businessUnitResourceModel, instance *api_client.B
 }
 
 // POST /api/filters returns only filter_id; re-read global_filter when unset (backend defaults to global).
-func resolveCreatedGlobalFilter(client *api_client.APIClient, plan *businessUnitResourceModel, instance *api_client.BusinessUnit) diag.Diagnostics {
+func resolveCreatedGlobalFilter(ctx context.Context, client *api_client.APIClient, plan *businessUnitResourceModel, instance *api_client.BusinessUnit) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	if instance.GlobalFilter != nil {
@@ -437,7 +457,7 @@ func resolveCreatedGlobalFilter(client *api_client.APIClient, plan *businessUnit
 		return diags
 	}
 
-	created, err := client.GetBusinessUnit(instance.ID)
+	created, err := client.GetBusinessUnit(ctx, instance.ID)
 	if err != nil {
 		diags.AddError(
 			"Error reading business unit",
@@ -464,6 +484,9 @@ func (r *businessUnitResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	ctx, cancel := timeouts_common.Create(ctx, plan.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
 	// Default to empty filters if not provided
 	var businessUnitFilter *api_client.BusinessUnitFilter = nil
 	var businessUnitShiftLeftFilter *api_client.BusinessUnitShiftLeftFilter = nil
@@ -509,21 +532,19 @@ func (r *businessUnitResource) Create(ctx context.Context, req resource.CreateRe
 	applyMetadataToRequest(&createReq, &plan)
 
 	// Make the API call to create the business unit
-	instance, err := r.apiClient.CreateBusinessUnit(createReq)
+	instance, err := r.apiClient.CreateBusinessUnit(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating business unit",
-			"Could not create business unit, unexpected error: "+err.Error(),
-		)
+		apierror_common.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, "Error creating business unit", "Could not create business unit", err)
 		return
 	}
 
 	// Always set the ID from the API response
 	plan.ID = types.StringValue(instance.ID)
+	resp.Diagnostics.Append(identity_common.SetID(ctx, resp.Identity, plan.ID.ValueString())...)
 
 	// Sync computed metadata back from the API response so any attribute
 	// the framework marked as unknown after planning becomes known.
-	resp.Diagnostics.Append(resolveCreatedGlobalFilter(r.apiClient, &plan, instance)...)
+	resp.Diagnostics.Append(resolveCreatedGlobalFilter(ctx, r.apiClient, &plan, instance)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -535,16 +556,21 @@ func (r *businessUnitResource) Create(ctx context.Context, req resource.CreateRe
 }
 
 func (r *businessUnitResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(identity_common.SetIDFromState(ctx, resp.Identity, req.State)...)
 	var state businessUnitResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	ctx, cancel := timeouts_common.Read(ctx, state.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
 	// Preserve whether previous state used deprecated cloud_account_ids (for backward compat)
 	hadDeprecatedCloudAccountIds := state.Filter != nil && len(state.Filter.CloudAccountIds) > 0
 
-	exists, err := r.apiClient.DoesBusinessUnitExist(state.ID.ValueString())
+	exists, err := r.apiClient.DoesBusinessUnitExist(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading business unit",
@@ -558,7 +584,7 @@ func (r *businessUnitResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
-	instance, err := r.apiClient.GetBusinessUnit(state.ID.ValueString())
+	instance, err := r.apiClient.GetBusinessUnit(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading business unit",
@@ -591,6 +617,7 @@ func (r *businessUnitResource) Read(ctx context.Context, req resource.ReadReques
 }
 
 func (r *businessUnitResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(identity_common.SetIDFromState(ctx, resp.Identity, req.State)...)
 	var plan businessUnitResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -598,6 +625,9 @@ func (r *businessUnitResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	ctx, cancel := timeouts_common.Update(ctx, plan.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
 	if plan.ID.ValueString() == "" {
 		resp.Diagnostics.AddError(
 			"ID is null",
@@ -617,16 +647,13 @@ func (r *businessUnitResource) Update(ctx context.Context, req resource.UpdateRe
 		}
 		applyMetadataToRequest(&updateReq, &plan)
 
-		_, err := r.apiClient.UpdateBusinessUnit(plan.ID.ValueString(), updateReq)
+		_, err := r.apiClient.UpdateBusinessUnit(ctx, plan.ID.ValueString(), updateReq)
 		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error updating business unit",
-				"Could not update business unit, unexpected error: "+err.Error(),
-			)
+			apierror_common.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, "Error updating business unit", "Could not update business unit", err)
 			return
 		}
 
-		_, err = r.apiClient.GetBusinessUnit(plan.ID.ValueString())
+		_, err = r.apiClient.GetBusinessUnit(ctx, plan.ID.ValueString())
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error reading business unit",
@@ -651,13 +678,10 @@ func (r *businessUnitResource) Update(ctx context.Context, req resource.UpdateRe
 		}
 		applyMetadataToRequest(&updateReq, &plan)
 
-		instance, err := r.apiClient.UpdateBusinessUnit(updateReq.ID, updateReq)
+		instance, err := r.apiClient.UpdateBusinessUnit(ctx, updateReq.ID, updateReq)
 
 		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error updating business unit",
-				"Could not update business unit, unexpected error: "+err.Error(),
-			)
+			apierror_common.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, "Error updating business unit", "Could not update business unit", err)
 			return
 		}
 		if instance.ID != "" {
@@ -685,13 +709,10 @@ func (r *businessUnitResource) Update(ctx context.Context, req resource.UpdateRe
 		}
 		applyMetadataToRequest(&updateReq, &plan)
 
-		instance, err := r.apiClient.UpdateBusinessUnit(updateReq.ID, updateReq)
+		instance, err := r.apiClient.UpdateBusinessUnit(ctx, updateReq.ID, updateReq)
 
 		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error updating business unit",
-				"Could not update business unit, unexpected error: "+err.Error(),
-			)
+			apierror_common.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, "Error updating business unit", "Could not update business unit", err)
 			return
 		}
 		if instance.ID != "" {
@@ -713,16 +734,13 @@ func (r *businessUnitResource) Update(ctx context.Context, req resource.UpdateRe
 		}
 		applyMetadataToRequest(&updateReq, &plan)
 
-		_, err := r.apiClient.UpdateBusinessUnit(plan.ID.ValueString(), updateReq)
+		_, err := r.apiClient.UpdateBusinessUnit(ctx, plan.ID.ValueString(), updateReq)
 		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error updating business unit",
-				"Could not update business unit, unexpected error: "+err.Error(),
-			)
+			apierror_common.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, "Error updating business unit", "Could not update business unit", err)
 			return
 		}
 
-		_, err = r.apiClient.GetBusinessUnit(plan.ID.ValueString())
+		_, err = r.apiClient.GetBusinessUnit(ctx, plan.ID.ValueString())
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error reading business unit",
@@ -746,16 +764,13 @@ func (r *businessUnitResource) Update(ctx context.Context, req resource.UpdateRe
 		}
 		applyMetadataToRequest(&updateReq, &plan)
 
-		_, err := r.apiClient.UpdateBusinessUnit(plan.ID.ValueString(), updateReq)
+		_, err := r.apiClient.UpdateBusinessUnit(ctx, plan.ID.ValueString(), updateReq)
 		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error updating business unit",
-				"Could not update business unit, unexpected error: "+err.Error(),
-			)
+			apierror_common.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, "Error updating business unit", "Could not update business unit", err)
 			return
 		}
 
-		_, err = r.apiClient.GetBusinessUnit(plan.ID.ValueString())
+		_, err = r.apiClient.GetBusinessUnit(ctx, plan.ID.ValueString())
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error reading business unit",
@@ -779,16 +794,13 @@ func (r *businessUnitResource) Update(ctx context.Context, req resource.UpdateRe
 		}
 		applyMetadataToRequest(&updateReq, &plan)
 
-		_, err := r.apiClient.UpdateBusinessUnit(plan.ID.ValueString(), updateReq)
+		_, err := r.apiClient.UpdateBusinessUnit(ctx, plan.ID.ValueString(), updateReq)
 		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error updating business unit",
-				"Could not update business unit, unexpected error: "+err.Error(),
-			)
+			apierror_common.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, "Error updating business unit", "Could not update business unit", err)
 			return
 		}
 
-		_, err = r.apiClient.GetBusinessUnit(plan.ID.ValueString())
+		_, err = r.apiClient.GetBusinessUnit(ctx, plan.ID.ValueString())
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error reading business unit",
@@ -812,16 +824,13 @@ func (r *businessUnitResource) Update(ctx context.Context, req resource.UpdateRe
 		}
 		applyMetadataToRequest(&updateReq, &plan)
 
-		_, err := r.apiClient.UpdateBusinessUnit(plan.ID.ValueString(), updateReq)
+		_, err := r.apiClient.UpdateBusinessUnit(ctx, plan.ID.ValueString(), updateReq)
 		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error updating business unit",
-				"Could not update business unit, unexpected error: "+err.Error(),
-			)
+			apierror_common.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, "Error updating business unit", "Could not update business unit", err)
 			return
 		}
 
-		_, err = r.apiClient.GetBusinessUnit(plan.ID.ValueString())
+		_, err = r.apiClient.GetBusinessUnit(ctx, plan.ID.ValueString())
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error reading business unit",
@@ -841,12 +850,9 @@ func (r *businessUnitResource) Update(ctx context.Context, req resource.UpdateRe
 		}
 		applyMetadataToRequest(&updateReq, &plan)
 
-		_, err := r.apiClient.UpdateBusinessUnit(plan.ID.ValueString(), updateReq)
+		_, err := r.apiClient.UpdateBusinessUnit(ctx, plan.ID.ValueString(), updateReq)
 		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error updating business unit",
-				"Could not update business unit, unexpected error: "+err.Error(),
-			)
+			apierror_common.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, "Error updating business unit", "Could not update business unit", err)
 			return
 		}
 
@@ -866,7 +872,10 @@ func (r *businessUnitResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
-	err := r.apiClient.DeleteBusinessUnit(state.ID.ValueString())
+	ctx, cancel := timeouts_common.Delete(ctx, state.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
+	err := r.apiClient.DeleteBusinessUnit(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting business unit",
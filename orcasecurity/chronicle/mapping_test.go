@@ -0,0 +1,59 @@
+package chronicle
+
+import (
+	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	cc "terraform-provider-orcasecurity/orcasecurity/config_integration_common"
+	"terraform-provider-orcasecurity/orcasecurity/internal/testutils"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The shared suite covers the envelope plumbing; the closures below describe Chronicle's config
+// fields: the service account key is write-only, and the API round-trips the rest.
+func TestMapping(t *testing.T) {
+	testutils.RunMappingSuite(t, testutils.MappingSuite[api_client.ChronicleConfig]{
+		BuildPayload: buildPayload,
+		Extract:      extract,
+		TemplateName: "tf-acc-test-chronicle",
+		FilledState: func() cc.State {
+			return &state{
+				CustomerID:         types.StringValue("c1a2b3c4-0000-4000-8000-000000000001"),
+				Region:             types.StringValue("europe"),
+				LogType:            types.StringValue("ORCA"),
+				ServiceAccountJSON: types.StringValue(`{"type":"service_account"}`),
+			}
+		},
+		CheckConfig: func(t *testing.T, c api_client.ChronicleConfig) {
+			testutils.AssertEq(t, "customer_id", c.CustomerID, "c1a2b3c4-0000-4000-8000-000000000001")
+			testutils.AssertEq(t, "region", c.Region, "europe")
+			testutils.AssertEq(t, "log_type", c.LogType, "ORCA")
+			testutils.AssertEq(t, "service_account_json", c.ServiceAccountJSON, `{"type":"service_account"}`)
+		},
+		EchoConfig: api_client.ChronicleConfig{CustomerID: "returned-customer", Region: "asia-southeast1", LogType: "ORCA_ALERTS"},
+		EchoState: func() cc.State {
+			return &state{CustomerID: types.StringValue("planned-customer"), Region: types.StringValue("us")}
+		},
+		CheckEchoed: func(t *testing.T, st cc.State) {
+			s := st.(*state)
+			testutils.AssertEq(t, "echoed customer_id", s.CustomerID.ValueString(), "returned-customer")
+			testutils.AssertEq(t, "echoed region", s.Region.ValueString(), "asia-southeast1")
+			testutils.AssertEq(t, "echoed log_type", s.LogType.ValueString(), "ORCA_ALERTS")
+		},
+		ZeroConfigChecks: []testutils.StateCheck{
+			{
+				Name: "empty config keeps planned and secrets untouched",
+				State: func() cc.State {
+					return &state{CustomerID: types.StringValue("planned-customer"), ServiceAccountJSON: types.StringValue("planned-key")}
+				},
+				Check: func(t *testing.T, st cc.State) {
+					s := st.(*state)
+					testutils.AssertEq(t, "planned customer_id", s.CustomerID.ValueString(), "planned-customer")
+					testutils.AssertEq(t, "planned service_account_json", s.ServiceAccountJSON.ValueString(), "planned-key")
+				},
+			},
+		},
+	})
+}
+
+var _ cc.State = &state{}
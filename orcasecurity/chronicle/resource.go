@@ -0,0 +1,108 @@
+package chronicle
+
+import (
+	"context"
+	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	cc "terraform-provider-orcasecurity/orcasecurity/config_integration_common"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type state struct {
+	cc.CommonFields
+	CustomerID                  types.String `tfsdk:"customer_id"`
+	Region                      types.String `tfsdk:"region"`
+	LogType                     types.String `tfsdk:"log_type"`
+	ServiceAccountJSON          types.String `tfsdk:"service_account_json"`
+	ServiceAccountJSONWO        types.String `tfsdk:"service_account_json_wo"`
+	ServiceAccountJSONWOVersion types.Int64  `tfsdk:"service_account_json_wo_version"`
+}
+
+// buildPayload converts the planned state into the Chronicle API payload.
+func buildPayload(_ context.Context, st cc.State, _ *diag.Diagnostics) api_client.ChronicleExternalServiceConfig {
+	s := st.(*state)
+	return api_client.ChronicleExternalServiceConfig{
+		TemplateName: s.TemplateName.ValueString(),
+		IsEnabled:    s.IsEnabled.ValueBool(),
+		IsDefault:    s.IsDefault.ValueBool(),
+		Config: api_client.ChronicleConfig{
+			CustomerID:         s.CustomerID.ValueString(),
+			Region:             s.Region.ValueString(),
+			LogType:            s.LogType.ValueString(),
+			ServiceAccountJSON: s.ServiceAccountJSON.ValueString(),
+		},
+	}
+}
+
+// extract maps the API envelope back onto state; the service account key is never returned and
+// empty fields never clobber the plan.
+func extract(_ context.Context, o *api_client.ChronicleExternalServiceConfig, st cc.State, _ *diag.Diagnostics) cc.APIObject {
+	s := st.(*state)
+	if o.Config.CustomerID != "" {
+		s.CustomerID = types.StringValue(o.Config.CustomerID)
+	}
+	if o.Config.Region != "" {
+		s.Region = types.StringValue(o.Config.Region)
+	}
+	if o.Config.LogType != "" {
+		s.LogType = types.StringValue(o.Config.LogType)
+	}
+	return cc.APIObject{ID: o.ID, TemplateName: o.TemplateName, IsEnabled: o.IsEnabled, IsDefault: o.IsDefault}
+}
+
+func NewChronicleResource() resource.Resource {
+	return cc.New(cc.Spec[api_client.ChronicleExternalServiceConfig]{
+		TypeNameSuffix: "_integration_chronicle",
+		ServiceName:    api_client.ChronicleServiceName,
+		UIName:         "Google Chronicle integration",
+		Description:    "Manage a Google Chronicle (Google SecOps) integration in Orca. Creates an external service config of `service_name = \"chronicle\"` that streams alerts to the Chronicle ingestion API. The service account key is stored in Orca's secret store and is never returned by the API.",
+		VariantAttributes: map[string]schema.Attribute{
+			"customer_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Chronicle customer ID (a UUID, shown under SIEM Settings → Profile).",
+				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"region": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("us"),
+				Description: "Chronicle region of the instance, for example `us`, `europe` or `asia-southeast1`. Defaults to `us`.",
+				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"log_type": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("ORCA"),
+				Description: "Chronicle log type the alerts are ingested as, which selects the parser. Defaults to `ORCA`.",
+				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"service_account_json": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "JSON key of the Google service account Orca authenticates to the ingestion API with. Stored in Orca's secret store; never returned by the API.",
+				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+		},
+		WriteOnlySecrets: []string{"service_account_json"},
+		NewState:         func() cc.State { return &state{} },
+		BuildPayload:     buildPayload,
+		Extract:          extract,
+		Create:           (*api_client.APIClient).CreateChronicleConfig,
+		Get:              (*api_client.APIClient).GetChronicleConfig,
+		Update:           (*api_client.APIClient).UpdateChronicleConfig,
+		Delete:           (*api_client.APIClient).DeleteChronicleConfig,
+		List:             (*api_client.APIClient).ListChronicleConfigs,
+	})
+}
+
+// NewChronicleListResource lists the integrations managed by NewChronicleResource.
+func NewChronicleListResource() list.ListResource {
+	return cc.NewList(NewChronicleResource)
+}
@@ -0,0 +1,24 @@
+package chronicle_test
+
+import (
+	"terraform-provider-orcasecurity/orcasecurity/internal/acctest"
+	"testing"
+)
+
+func TestAccChronicleResource(t *testing.T) {
+	acctest.RunIntegrationTest(t, chronicleSpec)
+}
+
+func TestChronicleResource_Offline(t *testing.T) {
+	acctest.RunIntegrationTestOffline(t, chronicleSpec)
+}
+
+var chronicleSpec = acctest.IntegrationSpec{
+	ResourceType: "orcasecurity_integration_chronicle",
+	TemplateName: "tf-acc-test-chronicle",
+	Attributes: map[string]string{
+		"customer_id":          `"00000000-0000-4000-8000-000000000001"`,
+		"service_account_json": `jsonencode({ type = "service_account", project_id = "tf-acc-test" })`,
+	},
+	Secrets: []string{"service_account_json"},
+}
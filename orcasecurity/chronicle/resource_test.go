@@ -0,0 +1,19 @@
+package chronicle
+
+import (
+	"terraform-provider-orcasecurity/orcasecurity/internal/testutils"
+	"testing"
+)
+
+// The service account key is a secret with a write-only variant; customer_id is required but not sensitive.
+// The variant uses the no-BU CommonFields flavour, so business_units must be absent.
+func TestChronicleResource_SchemaContract(t *testing.T) {
+	testutils.CheckVariantResource(t, testutils.VariantResourceSpec{
+		NewResource:      NewChronicleResource,
+		TypeName:         "orcasecurity_integration_chronicle",
+		WriteOnlySecrets: []string{"service_account_json"},
+		PlainRequired:    []string{"customer_id"},
+		Forbidden:        []string{"business_units"},
+		State:            &state{},
+	})
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -15,7 +16,9 @@ import (
 
 type state struct {
 	cc.CommonFields
-	APIToken types.String `tfsdk:"api_token"`
+	APIToken          types.String `tfsdk:"api_token"`
+	APITokenWO        types.String `tfsdk:"api_token_wo"`
+	APITokenWOVersion types.Int64  `tfsdk:"api_token_wo_version"`
 }
 
 // buildPayload converts the planned state into the Cloudflare API payload.
@@ -30,13 +33,14 @@ func buildPayload(_ context.Context, st cc.State, _ *diag.Diagnostics) api_clien
 }
 
 // extract maps the API envelope back onto state; the token is never returned so state is untouched.
-func extract(o *api_client.CloudflareExternalServiceConfig, _ cc.State, _ *diag.Diagnostics) cc.APIObject {
+func extract(_ context.Context, o *api_client.CloudflareExternalServiceConfig, _ cc.State, _ *diag.Diagnostics) cc.APIObject {
 	return cc.APIObject{ID: o.ID, TemplateName: o.TemplateName, IsEnabled: o.IsEnabled, IsDefault: o.IsDefault}
 }
 
 func NewCloudflareResource() resource.Resource {
 	return cc.New(cc.Spec[api_client.CloudflareExternalServiceConfig]{
 		TypeNameSuffix: "_integration_cloudflare",
+		ServiceName:    api_client.CloudflareServiceName,
 		UIName:         "Cloudflare integration",
 		Description:    "Manage a Cloudflare integration in Orca. Creates an external service config of `service_name = \"cloudflare\"` and stores the API token in Orca's secret store.",
 		VariantAttributes: map[string]schema.Attribute{
@@ -47,12 +51,19 @@ func NewCloudflareResource() resource.Resource {
 				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
 			},
 		},
-		NewState:     func() cc.State { return &state{} },
-		BuildPayload: buildPayload,
-		Extract:      extract,
-		Create:       (*api_client.APIClient).CreateCloudflareConfig,
-		Get:          (*api_client.APIClient).GetCloudflareConfig,
-		Update:       (*api_client.APIClient).UpdateCloudflareConfig,
-		Delete:       (*api_client.APIClient).DeleteCloudflareConfig,
+		WriteOnlySecrets: []string{"api_token"},
+		NewState:         func() cc.State { return &state{} },
+		BuildPayload:     buildPayload,
+		Extract:          extract,
+		Create:           (*api_client.APIClient).CreateCloudflareConfig,
+		Get:              (*api_client.APIClient).GetCloudflareConfig,
+		Update:           (*api_client.APIClient).UpdateCloudflareConfig,
+		Delete:           (*api_client.APIClient).DeleteCloudflareConfig,
+		List:             (*api_client.APIClient).ListCloudflareConfigs,
 	})
 }
+
+// NewCloudflareListResource lists the integrations managed by NewCloudflareResource.
+func NewCloudflareListResource() list.ListResource {
+	return cc.NewList(NewCloudflareResource)
+}
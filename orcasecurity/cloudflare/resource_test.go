@@ -5,14 +5,14 @@ import (
 	"testing"
 )
 
-// api_token is the only credential and must be Required + Sensitive; cloudflare does not support
+// api_token is the only credential and may also be supplied write-only; cloudflare does not support
 // business_units, so the attribute must be absent.
 func TestCloudflareResource_SchemaContract(t *testing.T) {
 	testutils.CheckVariantResource(t, testutils.VariantResourceSpec{
-		NewResource: NewCloudflareResource,
-		TypeName:    "orcasecurity_integration_cloudflare",
-		Secrets:     []string{"api_token"},
-		Forbidden:   []string{"business_units"},
-		State:       &state{},
+		NewResource:      NewCloudflareResource,
+		TypeName:         "orcasecurity_integration_cloudflare",
+		WriteOnlySecrets: []string{"api_token"},
+		Forbidden:        []string{"business_units"},
+		State:            &state{},
 	})
 }
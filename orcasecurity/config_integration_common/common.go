@@ -10,17 +10,24 @@ import (
 	"context"
 	"fmt"
 	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	"terraform-provider-orcasecurity/orcasecurity/apierror_common"
+	"terraform-provider-orcasecurity/orcasecurity/identity_common"
 	common "terraform-provider-orcasecurity/orcasecurity/integrations_common"
+	"terraform-provider-orcasecurity/orcasecurity/timeouts_common"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -31,6 +38,7 @@ type Common struct {
 	IsEnabled     types.Bool
 	IsDefault     types.Bool
 	BusinessUnits types.Set
+	Timeouts      timeouts_common.Value
 }
 
 // APIObject is the API-shape view of those fields.
@@ -59,6 +67,17 @@ type Spec[P any] struct {
 	SupportsBusinessUnits bool
 	VariantAttributes     map[string]schema.Attribute
 
+	// ServiceName is the Orca service_name of the variant's configs. Together with
+	// template_name it forms the resource identity.
+	ServiceName string
+
+	// WriteOnlySecrets names Sensitive string VariantAttributes that also accept their value
+	// through a write-only "<name>_wo" attribute, paired with a "<name>_wo_version" attribute
+	// whose change triggers an update (write-only values never diff). Exactly one of <name>
+	// and <name>_wo must be set. The state struct declares both extra fields; BuildPayload
+	// keeps reading <name>, which the base fills from <name>_wo when that is the one set.
+	WriteOnlySecrets []string
+
 	// NewState constructs an empty plan/state model the framework can decode into.
 	NewState func() State
 
@@ -69,31 +88,36 @@ type Spec[P any] struct {
 	// writes any variant-specific Computed fields back into ``state`` (e.g. echoed URLs).
 	// Called on Create / Update / Read by default. Append to diags for any conversion error
 	// that should surface instead of being silently swallowed.
-	Extract func(apiObj *P, state State, diags *diag.Diagnostics) APIObject
+	Extract func(ctx context.Context, apiObj *P, state State, diags *diag.Diagnostics) APIObject
 
 	// ExtractOnRead is an optional override used only on Read. Variants whose Create/Update
 	// responses can't be safely re-applied to state (e.g. the Plugin Framework's
 	// "inconsistent sensitive attribute" check when a sensitive nested block round-trips
 	// through the API) supply a narrower Extract for Create/Update and a fuller one here
 	// for Read. When nil, Extract is used on every call.
-	ExtractOnRead func(apiObj *P, state State, diags *diag.Diagnostics) APIObject
+	ExtractOnRead func(ctx context.Context, apiObj *P, state State, diags *diag.Diagnostics) APIObject
 
 	// AfterExtract is an optional hook run on every Create/Read/Update after the API response
 	// has been applied to state. Unlike Extract it receives the API client, so variants that
 	// derive Computed attributes from a *second* endpoint (e.g. S3 bucket policy rendered from
 	// GET /api/settings) can populate them without hand-rolling their own CRUD loop.
-	AfterExtract func(client *api_client.APIClient, state State, diags *diag.Diagnostics)
+	AfterExtract func(ctx context.Context, client *api_client.APIClient, state State, diags *diag.Diagnostics)
 
 	// CRUD method references — typically the bound ``(*api_client.APIClient).XyzConfig``
 	// methods. The base calls these via the shared API client so per-variant files don't
-	// hand-roll the error-wrapping plumbing.
-	Create func(client *api_client.APIClient, payload P) (*P, error)
-	Get    func(client *api_client.APIClient, templateName string) (*P, error)
-	Update func(client *api_client.APIClient, templateName string, payload P) (*P, error)
-	Delete func(client *api_client.APIClient, templateName string) error
+	// hand-roll the error-wrapping plumbing. The context argument follows the client because
+	// method expressions put the receiver first; it carries the framework request context so
+	// Terraform cancellation reaches the HTTP call.
+	Create func(client *api_client.APIClient, ctx context.Context, payload P) (*P, error)
+	Get    func(client *api_client.APIClient, ctx context.Context, templateName string) (*P, error)
+	Update func(client *api_client.APIClient, ctx context.Context, templateName string, payload P) (*P, error)
+	Delete func(client *api_client.APIClient, ctx context.Context, templateName string) error
+
+	// List returns every config of the variant. It backs the list resource built by NewList.
+	List func(client *api_client.APIClient, ctx context.Context) ([]P, error)
 }
 
-func buildSchema[P any](spec Spec[P]) schema.Schema {
+func buildSchema[P any](ctx context.Context, spec Spec[P]) schema.Schema {
 	attrs := map[string]schema.Attribute{
 		"id": schema.StringAttribute{
 			Computed:      true,
@@ -119,7 +143,48 @@ func buildSchema[P any](spec Spec[P]) schema.Schema {
 	for name, attribute := range spec.VariantAttributes {
 		attrs[name] = attribute
 	}
-	return schema.Schema{Description: spec.Description, Attributes: attrs}
+	for _, name := range spec.WriteOnlySecrets {
+		secret, ok := attrs[name].(schema.StringAttribute)
+		if !ok {
+			continue
+		}
+		wo := secret
+		wo.Required, wo.Optional, wo.Sensitive, wo.WriteOnly = false, true, true, true
+		wo.Description = fmt.Sprintf("Write-only alternative to `%s`, never stored in state or plan (requires Terraform 1.11+). "+
+			"Use with ephemeral sources such as Vault. Bump `%s` to send a new value.", name, writeOnlyVersionName(name))
+		attrs[writeOnlyName(name)] = wo
+		attrs[writeOnlyVersionName(name)] = schema.Int64Attribute{
+			Optional:    true,
+			Description: fmt.Sprintf("Version of the `%s` value. Change it to rotate the secret, since Terraform cannot detect changes to write-only values.", writeOnlyName(name)),
+			Validators:  []validator.Int64{int64validator.AlsoRequires(path.MatchRoot(writeOnlyName(name)))},
+		}
+		secret.Required, secret.Optional = false, true
+		secret.Description += fmt.Sprintf(" Exactly one of `%s` or `%s` must be set.", name, writeOnlyName(name))
+		attrs[name] = secret
+	}
+	return schema.Schema{
+		Description: spec.Description,
+		Attributes:  attrs,
+		Blocks:      map[string]schema.Block{"timeouts": timeouts_common.Block(ctx)},
+	}
+}
+
+func writeOnlyName(name string) string        { return name + "_wo" }
+func writeOnlyVersionName(name string) string { return name + "_wo_version" }
+
+// payloadState decodes plan into a fresh state for BuildPayload, substituting each write-only
+// secret supplied in config for its stored counterpart. The returned state is never persisted.
+func (r *genericResource[P]) payloadState(ctx context.Context, plan tfsdk.Plan, config tfsdk.Config, diags *diag.Diagnostics) State {
+	for _, name := range r.spec.WriteOnlySecrets {
+		var wo types.String
+		diags.Append(config.GetAttribute(ctx, path.Root(writeOnlyName(name)), &wo)...)
+		if !wo.IsNull() && !wo.IsUnknown() {
+			diags.Append(plan.SetAttribute(ctx, path.Root(name), wo)...)
+		}
+	}
+	st := r.spec.NewState()
+	diags.Append(plan.Get(ctx, st)...)
+	return st
 }
 
 func applyCommon(ctx context.Context, st State, apiObj APIObject, supportsBUs bool, diags *diag.Diagnostics) {
@@ -164,8 +229,19 @@ func (r *genericResource[P]) Configure(_ context.Context, req resource.Configure
 	r.client = req.ProviderData.(*api_client.APIClient)
 }
 
-func (r *genericResource[P]) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = buildSchema(r.spec)
+func (r *genericResource[P]) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = buildSchema(ctx, r.spec)
+}
+
+func (r *genericResource[P]) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	var validators []resource.ConfigValidator
+	for _, name := range r.spec.WriteOnlySecrets {
+		validators = append(validators, resourcevalidator.ExactlyOneOf(
+			path.MatchRoot(name),
+			path.MatchRoot(writeOnlyName(name)),
+		))
+	}
+	return validators
 }
 
 // gerunds maps each CRUD verb to its gerund so diagnostic titles read "Error creating X"
@@ -177,14 +253,17 @@ var gerunds = map[string]string{
 	"read":   "reading",
 	"update": "updating",
 	"delete": "deleting",
+	"list":   "listing",
 }
 
 // errorWrap converts an api_client error into a TF diagnostic without forcing every variant
-// to repeat the same AddError boilerplate. action is the bare verb ("create", "read").
-func errorWrap(diags *diag.Diagnostics, action, ui string, err error) {
-	diags.AddError(
+// to repeat the same AddError boilerplate. action is the bare verb ("create", "read"). Field
+// validation errors attach to the matching attribute of sch when sch is non-nil.
+func errorWrap(ctx context.Context, diags *diag.Diagnostics, sch apierror_common.Schema, action, ui string, err error) {
+	apierror_common.AddError(ctx, diags, sch,
 		fmt.Sprintf("Error %s %s", gerunds[action], ui),
-		fmt.Sprintf("Could not %s %s: %s", action, ui, err.Error()),
+		fmt.Sprintf("Could not %s %s", action, ui),
+		err,
 	)
 }
 
@@ -198,27 +277,30 @@ func (r *genericResource[P]) Create(ctx context.Context, req resource.CreateRequ
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	payload := r.spec.BuildPayload(ctx, plan, &resp.Diagnostics)
+	ctx, cancel := timeouts_common.Create(ctx, plan.GetCommon().Timeouts, r.client, &resp.Diagnostics)
+	defer cancel()
+	payload := r.spec.BuildPayload(ctx, r.payloadState(ctx, req.Plan, req.Config, &resp.Diagnostics), &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	created, err := r.spec.Create(r.client, payload)
+	created, err := r.spec.Create(r.client, ctx, payload)
 	if err != nil {
-		errorWrap(&resp.Diagnostics, "create", r.spec.UIName, err)
+		errorWrap(ctx, &resp.Diagnostics, req.Plan.Schema, "create", r.spec.UIName, err)
 		return
 	}
-	applyCommon(ctx, plan, r.spec.Extract(created, plan, &resp.Diagnostics), r.spec.SupportsBusinessUnits, &resp.Diagnostics)
-	r.afterExtract(plan, &resp.Diagnostics)
+	applyCommon(ctx, plan, r.spec.Extract(ctx, created, plan, &resp.Diagnostics), r.spec.SupportsBusinessUnits, &resp.Diagnostics)
+	r.afterExtract(ctx, plan, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(r.setIdentity(ctx, resp.Identity, plan.GetCommon().TemplateName.ValueString())...)
 }
 
 // afterExtract runs the optional AfterExtract hook, if the variant declares one.
-func (r *genericResource[P]) afterExtract(st State, diags *diag.Diagnostics) {
+func (r *genericResource[P]) afterExtract(ctx context.Context, st State, diags *diag.Diagnostics) {
 	if r.spec.AfterExtract != nil {
-		r.spec.AfterExtract(r.client, st, diags)
+		r.spec.AfterExtract(ctx, r.client, st, diags)
 	}
 }
 
@@ -228,9 +310,12 @@ func (r *genericResource[P]) Read(ctx context.Context, req resource.ReadRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	current, err := r.spec.Get(r.client, state.GetCommon().TemplateName.ValueString())
+	resp.Diagnostics.Append(r.setIdentity(ctx, resp.Identity, state.GetCommon().TemplateName.ValueString())...)
+	ctx, cancel := timeouts_common.Read(ctx, state.GetCommon().Timeouts, r.client, &resp.Diagnostics)
+	defer cancel()
+	current, err := r.spec.Get(r.client, ctx, state.GetCommon().TemplateName.ValueString())
 	if err != nil {
-		errorWrap(&resp.Diagnostics, "read", r.spec.UIName, err)
+		errorWrap(ctx, &resp.Diagnostics, nil, "read", r.spec.UIName, err)
 		return
 	}
 	if current == nil {
@@ -241,8 +326,8 @@ func (r *genericResource[P]) Read(ctx context.Context, req resource.ReadRequest,
 	if r.spec.ExtractOnRead != nil {
 		extract = r.spec.ExtractOnRead
 	}
-	applyCommon(ctx, state, extract(current, state, &resp.Diagnostics), r.spec.SupportsBusinessUnits, &resp.Diagnostics)
-	r.afterExtract(state, &resp.Diagnostics)
+	applyCommon(ctx, state, extract(ctx, current, state, &resp.Diagnostics), r.spec.SupportsBusinessUnits, &resp.Diagnostics)
+	r.afterExtract(ctx, state, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -260,21 +345,24 @@ func (r *genericResource[P]) Update(ctx context.Context, req resource.UpdateRequ
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	payload := r.spec.BuildPayload(ctx, plan, &resp.Diagnostics)
+	ctx, cancel := timeouts_common.Update(ctx, plan.GetCommon().Timeouts, r.client, &resp.Diagnostics)
+	defer cancel()
+	payload := r.spec.BuildPayload(ctx, r.payloadState(ctx, req.Plan, req.Config, &resp.Diagnostics), &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	updated, err := r.spec.Update(r.client, state.GetCommon().TemplateName.ValueString(), payload)
+	updated, err := r.spec.Update(r.client, ctx, state.GetCommon().TemplateName.ValueString(), payload)
 	if err != nil {
-		errorWrap(&resp.Diagnostics, "update", r.spec.UIName, err)
+		errorWrap(ctx, &resp.Diagnostics, req.Plan.Schema, "update", r.spec.UIName, err)
 		return
 	}
-	applyCommon(ctx, plan, r.spec.Extract(updated, plan, &resp.Diagnostics), r.spec.SupportsBusinessUnits, &resp.Diagnostics)
-	r.afterExtract(plan, &resp.Diagnostics)
+	applyCommon(ctx, plan, r.spec.Extract(ctx, updated, plan, &resp.Diagnostics), r.spec.SupportsBusinessUnits, &resp.Diagnostics)
+	r.afterExtract(ctx, plan, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(r.setIdentity(ctx, resp.Identity, plan.GetCommon().TemplateName.ValueString())...)
 }
 
 func (r *genericResource[P]) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -283,18 +371,62 @@ func (r *genericResource[P]) Delete(ctx context.Context, req resource.DeleteRequ
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	if err := r.spec.Delete(r.client, state.GetCommon().TemplateName.ValueString()); err != nil {
-		errorWrap(&resp.Diagnostics, "delete", r.spec.UIName, err)
+	ctx, cancel := timeouts_common.Delete(ctx, state.GetCommon().Timeouts, r.client, &resp.Diagnostics)
+	defer cancel()
+	if err := r.spec.Delete(r.client, ctx, state.GetCommon().TemplateName.ValueString()); err != nil {
+		errorWrap(ctx, &resp.Diagnostics, nil, "delete", r.spec.UIName, err)
 	}
 }
 
+// ImportState accepts either the template name as import ID or the identity. An identity naming
+// a different service_name is rejected instead of importing an unrelated config.
 func (r *genericResource[P]) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("template_name"), req.ID)...)
+	if req.ID == "" && req.Identity != nil {
+		var serviceName types.String
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root(identityServiceName), &serviceName)...)
+		if !serviceName.IsNull() && serviceName.ValueString() != r.spec.ServiceName {
+			resp.Diagnostics.AddAttributeError(path.Root(identityServiceName), "Invalid import identity",
+				fmt.Sprintf("%s resources have service_name %q, got %q.", r.spec.UIName, r.spec.ServiceName, serviceName.ValueString()))
+			return
+		}
+	}
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("template_name"), path.Root(identityTemplateName), req, resp)
+}
+
+const (
+	identityServiceName  = "service_name"
+	identityTemplateName = "template_name"
+)
+
+// IdentitySchema addresses a config by service_name and template_name, the pair the Orca API
+// keys configs by. service_name is fixed per resource type, so imports may omit it.
+func (r *genericResource[P]) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			identityServiceName: identityschema.StringAttribute{
+				OptionalForImport: true,
+				Description:       "Orca service_name of the integration.",
+			},
+			identityTemplateName: identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "Template name of the integration.",
+			},
+		},
+	}
+}
+
+func (r *genericResource[P]) setIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, templateName string) diag.Diagnostics {
+	return identity_common.Set(ctx, identity, map[string]string{
+		identityServiceName:  r.spec.ServiceName,
+		identityTemplateName: templateName,
+	})
 }
 
 // Compile-time interface assertions.
 var (
-	_ resource.Resource                = &genericResource[struct{}]{}
-	_ resource.ResourceWithConfigure   = &genericResource[struct{}]{}
-	_ resource.ResourceWithImportState = &genericResource[struct{}]{}
+	_ resource.Resource                     = &genericResource[struct{}]{}
+	_ resource.ResourceWithConfigure        = &genericResource[struct{}]{}
+	_ resource.ResourceWithImportState      = &genericResource[struct{}]{}
+	_ resource.ResourceWithConfigValidators = &genericResource[struct{}]{}
+	_ resource.ResourceWithIdentity         = &genericResource[struct{}]{}
 )
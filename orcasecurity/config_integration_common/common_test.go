@@ -3,12 +3,14 @@ package config_integration_common
 import (
 	"context"
 	"errors"
+	"terraform-provider-orcasecurity/orcasecurity/timeouts_common"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -40,7 +42,7 @@ func newSpec(supportsBUs bool, variant map[string]schema.Attribute) Spec[struct{
 // buildSchema must always emit the four cross-variant attributes with the documented
 // requiredness/computed flags.
 func TestBuildSchema_CommonAttributesAlwaysPresent(t *testing.T) {
-	s := buildSchema(newSpec(false, nil))
+	s := buildSchema(context.Background(), newSpec(false, nil))
 
 	for _, name := range []string{"id", "template_name", "is_enabled", "is_default"} {
 		if _, ok := s.Attributes[name]; !ok {
@@ -76,12 +78,12 @@ func hasRequiresReplaceModifier(modifiers []planmodifier.String) bool {
 
 // business_units must be emitted only when the Spec opts in via SupportsBusinessUnits.
 func TestBuildSchema_BusinessUnitsGatedOnSupport(t *testing.T) {
-	off := buildSchema(newSpec(false, nil))
+	off := buildSchema(context.Background(), newSpec(false, nil))
 	if _, ok := off.Attributes["business_units"]; ok {
 		t.Error("business_units must be absent when SupportsBusinessUnits is false")
 	}
 
-	on := buildSchema(newSpec(true, nil))
+	on := buildSchema(context.Background(), newSpec(true, nil))
 	attr, ok := on.Attributes["business_units"]
 	if !ok {
 		t.Fatal("business_units must be present when SupportsBusinessUnits is true")
@@ -97,7 +99,7 @@ func TestBuildSchema_VariantAttributesMerged(t *testing.T) {
 		"api_token": schema.StringAttribute{Required: true, Sensitive: true},
 		"host":      schema.StringAttribute{Required: true},
 	}
-	s := buildSchema(newSpec(false, variant))
+	s := buildSchema(context.Background(), newSpec(false, variant))
 
 	for name := range variant {
 		if _, ok := s.Attributes[name]; !ok {
@@ -202,7 +204,7 @@ func TestErrorWrap_GerundTitlesForAllVerbs(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.action, func(t *testing.T) {
 			var diags diag.Diagnostics
-			errorWrap(&diags, tc.action, "Widget", errors.New("boom"))
+			errorWrap(context.Background(), &diags, nil, tc.action, "Widget", errors.New("boom"))
 			if len(diags) != 1 {
 				t.Fatalf("expected exactly one diagnostic, got %d", len(diags))
 			}
@@ -216,3 +218,81 @@ func TestErrorWrap_GerundTitlesForAllVerbs(t *testing.T) {
 		})
 	}
 }
+
+// woState is a variant state with one secret that also has a write-only form.
+type woState struct {
+	CommonFields
+	APIToken          types.String `tfsdk:"api_token"`
+	APITokenWO        types.String `tfsdk:"api_token_wo"`
+	APITokenWOVersion types.Int64  `tfsdk:"api_token_wo_version"`
+}
+
+func newWriteOnlySpec() Spec[struct{}] {
+	spec := newSpec(false, map[string]schema.Attribute{
+		"api_token": schema.StringAttribute{Required: true, Sensitive: true, Description: "Token."},
+	})
+	spec.WriteOnlySecrets = []string{"api_token"}
+	spec.NewState = func() State { return &woState{} }
+	return spec
+}
+
+// Each write-only secret becomes optional and gains a write-only twin plus a version trigger.
+func TestBuildSchema_WriteOnlySecrets(t *testing.T) {
+	s := buildSchema(context.Background(), newWriteOnlySpec())
+
+	secret, ok := s.Attributes["api_token"].(schema.StringAttribute)
+	if !ok || secret.Required || !secret.Optional || !secret.Sensitive {
+		t.Errorf("api_token must become an Optional sensitive attribute, got %#v", s.Attributes["api_token"])
+	}
+	wo, ok := s.Attributes["api_token_wo"].(schema.StringAttribute)
+	if !ok || !wo.WriteOnly || !wo.Optional || wo.Required || !wo.Sensitive {
+		t.Errorf("api_token_wo must be an Optional write-only sensitive attribute, got %#v", s.Attributes["api_token_wo"])
+	}
+	if v, ok := s.Attributes["api_token_wo_version"].(schema.Int64Attribute); !ok || !v.Optional {
+		t.Errorf("api_token_wo_version must be an Optional int64 attribute, got %#v", s.Attributes["api_token_wo_version"])
+	}
+	if got := len((&genericResource[struct{}]{spec: newWriteOnlySpec()}).ConfigValidators(context.Background())); got != 1 {
+		t.Errorf("expected one ExactlyOneOf validator per write-only secret, got %d", got)
+	}
+}
+
+// payloadState must hand BuildPayload the write-only value from config while leaving the
+// plan that is persisted to state without it.
+func TestPayloadState_UsesWriteOnlyValueFromConfig(t *testing.T) {
+	ctx := context.Background()
+	spec := newWriteOnlySpec()
+	r := &genericResource[struct{}]{spec: spec}
+	sch := buildSchema(ctx, spec)
+
+	planned := &woState{APIToken: types.StringNull(), APITokenWO: types.StringNull(), APITokenWOVersion: types.Int64Value(1)}
+	planned.TemplateName = types.StringValue("tn")
+	planned.Timeouts = timeouts_common.Null(ctx)
+	plan := tfsdk.Plan{Schema: sch}
+	if d := plan.Set(ctx, planned); d.HasError() {
+		t.Fatalf("seed plan: %v", d)
+	}
+	configured := *planned
+	configured.APITokenWO = types.StringValue("s3cret")
+	seed := tfsdk.Plan{Schema: sch}
+	if d := seed.Set(ctx, &configured); d.HasError() {
+		t.Fatalf("seed config: %v", d)
+	}
+	config := tfsdk.Config{Schema: sch, Raw: seed.Raw}
+
+	var diags diag.Diagnostics
+	got := r.payloadState(ctx, plan, config, &diags).(*woState)
+	if diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+	if got.APIToken.ValueString() != "s3cret" {
+		t.Errorf("payload state must carry the write-only value, got %v", got.APIToken)
+	}
+
+	var persisted woState
+	if d := plan.Get(ctx, &persisted); d.HasError() {
+		t.Fatalf("read plan: %v", d)
+	}
+	if !persisted.APIToken.IsNull() {
+		t.Errorf("plan must not be modified, got api_token %v", persisted.APIToken)
+	}
+}
@@ -0,0 +1,88 @@
+package config_integration_common
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	"terraform-provider-orcasecurity/orcasecurity/list_common"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type listFilter struct {
+	TemplateNameContains types.String `tfsdk:"template_name_contains"`
+	IsEnabled            types.Bool   `tfsdk:"is_enabled"`
+}
+
+// lister is implemented by every genericResource regardless of its payload type, which lets
+// NewList reach the variant's Spec through the plain resource constructor.
+type lister interface {
+	uiName() string
+	listItems(ctx context.Context, client *api_client.APIClient, filter listFilter) ([]list_common.Item, diag.Diagnostics)
+}
+
+// NewList returns the list resource for the integration built by newResource, which must come
+// from New with a Spec that sets List.
+func NewList(newResource func() resource.Resource) list.ListResource {
+	l := newResource().(lister)
+	return list_common.New(list_common.Spec{
+		NewResource: newResource,
+		Description: fmt.Sprintf("Lists %ss, optionally filtered by template name and enablement.", l.uiName()),
+		Attributes: map[string]schema.Attribute{
+			"template_name_contains": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list integrations whose template name contains this value (case-insensitive).",
+			},
+			"is_enabled": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Only list enabled (`true`) or disabled (`false`) integrations.",
+			},
+		},
+		Fetch: func(ctx context.Context, client *api_client.APIClient, config tfsdk.Config) ([]list_common.Item, diag.Diagnostics) {
+			var filter listFilter
+			diags := config.Get(ctx, &filter)
+			if diags.HasError() {
+				return nil, diags
+			}
+			items, listDiags := newResource().(lister).listItems(ctx, client, filter)
+			diags.Append(listDiags...)
+			return items, diags
+		},
+	})
+}
+
+func (r *genericResource[P]) uiName() string { return r.spec.UIName }
+
+func (r *genericResource[P]) listItems(ctx context.Context, client *api_client.APIClient, filter listFilter) ([]list_common.Item, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if r.spec.List == nil {
+		diags.AddError(fmt.Sprintf("Error listing %ss", r.spec.UIName), "This integration does not support listing.")
+		return nil, diags
+	}
+	configs, err := r.spec.List(client, ctx)
+	if err != nil {
+		errorWrap(ctx, &diags, nil, "list", r.spec.UIName+"s", err)
+		return nil, diags
+	}
+	items := make([]list_common.Item, 0, len(configs))
+	for i := range configs {
+		obj := r.spec.Extract(ctx, &configs[i], r.spec.NewState(), &diags)
+		if !list_common.Contains(obj.TemplateName, filter.TemplateNameContains) || !list_common.BoolIs(obj.IsEnabled, filter.IsEnabled) {
+			continue
+		}
+		items = append(items, list_common.Item{
+			Identity: map[string]string{
+				identityServiceName:  r.spec.ServiceName,
+				identityTemplateName: obj.TemplateName,
+			},
+			DisplayName: obj.TemplateName,
+		})
+	}
+	return items, diags
+}
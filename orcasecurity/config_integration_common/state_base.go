@@ -1,6 +1,10 @@
 package config_integration_common
 
-import "github.com/hashicorp/terraform-plugin-framework/types"
+import (
+	"terraform-provider-orcasecurity/orcasecurity/timeouts_common"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
 
 // CommonFields is meant to be embedded in a per-variant state struct so the cross-variant
 // GetCommon/SetCommon implementations live in this package exactly once. Use this variant for
@@ -15,6 +19,8 @@ type CommonFields struct {
 	TemplateName types.String `tfsdk:"template_name"`
 	IsEnabled    types.Bool   `tfsdk:"is_enabled"`
 	IsDefault    types.Bool   `tfsdk:"is_default"`
+
+	Timeouts timeouts_common.Value `tfsdk:"timeouts"`
 }
 
 func (c *CommonFields) GetCommon() *Common {
@@ -24,11 +30,13 @@ func (c *CommonFields) GetCommon() *Common {
 		IsEnabled:     c.IsEnabled,
 		IsDefault:     c.IsDefault,
 		BusinessUnits: types.SetNull(types.StringType),
+		Timeouts:      c.Timeouts,
 	}
 }
 
 func (c *CommonFields) SetCommon(v Common) {
 	c.ID, c.TemplateName, c.IsEnabled, c.IsDefault = v.ID, v.TemplateName, v.IsEnabled, v.IsDefault
+	c.Timeouts = v.Timeouts
 }
 
 // CommonFieldsWithBU is the variant for integrations whose Orca service config accepts the
@@ -39,6 +47,8 @@ type CommonFieldsWithBU struct {
 	IsEnabled     types.Bool   `tfsdk:"is_enabled"`
 	IsDefault     types.Bool   `tfsdk:"is_default"`
 	BusinessUnits types.Set    `tfsdk:"business_units"`
+
+	Timeouts timeouts_common.Value `tfsdk:"timeouts"`
 }
 
 func (c *CommonFieldsWithBU) GetCommon() *Common {
@@ -48,9 +58,11 @@ func (c *CommonFieldsWithBU) GetCommon() *Common {
 		IsEnabled:     c.IsEnabled,
 		IsDefault:     c.IsDefault,
 		BusinessUnits: c.BusinessUnits,
+		Timeouts:      c.Timeouts,
 	}
 }
 
 func (c *CommonFieldsWithBU) SetCommon(v Common) {
 	c.ID, c.TemplateName, c.IsEnabled, c.IsDefault, c.BusinessUnits = v.ID, v.TemplateName, v.IsEnabled, v.IsDefault, v.BusinessUnits
+	c.Timeouts = v.Timeouts
 }
@@ -0,0 +1,61 @@
+package cribl
+
+import (
+	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	cc "terraform-provider-orcasecurity/orcasecurity/config_integration_common"
+	"terraform-provider-orcasecurity/orcasecurity/internal/testutils"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The shared suite covers the envelope plumbing; the closures below describe Cribl's config
+// fields: the token is write-only, the API round-trips the URL, and the self-signed-cert flag
+// round-trips unconditionally.
+func TestMapping(t *testing.T) {
+	testutils.RunMappingSuite(t, testutils.MappingSuite[api_client.CriblConfig]{
+		BuildPayload: buildPayload,
+		Extract:      extract,
+		TemplateName: "tf-acc-test-cribl",
+		FilledState: func() cc.State {
+			return &state{
+				URL:                 types.StringValue("https://default.main.example.cribl.cloud:10080/cribl/_bulk"),
+				Token:               types.StringValue("cribl-token"),
+				AllowSelfSignedCert: types.BoolValue(true),
+			}
+		},
+		CheckConfig: func(t *testing.T, c api_client.CriblConfig) {
+			testutils.AssertEq(t, "url", c.URL, "https://default.main.example.cribl.cloud:10080/cribl/_bulk")
+			testutils.AssertEq(t, "token", c.Token, "cribl-token")
+			if !c.AllowSelfSignedCert {
+				t.Error("allow_self_signed_cert not forwarded")
+			}
+		},
+		EchoConfig: api_client.CriblConfig{URL: "https://returned.example/cribl/_bulk", AllowSelfSignedCert: true},
+		EchoState: func() cc.State {
+			return &state{URL: types.StringValue("https://planned"), AllowSelfSignedCert: types.BoolValue(false)}
+		},
+		CheckEchoed: func(t *testing.T, st cc.State) {
+			s := st.(*state)
+			testutils.AssertEq(t, "echoed url", s.URL.ValueString(), "https://returned.example/cribl/_bulk")
+			if !s.AllowSelfSignedCert.ValueBool() {
+				t.Error("echoed allow_self_signed_cert not applied")
+			}
+		},
+		ZeroConfigChecks: []testutils.StateCheck{
+			{
+				Name: "empty config keeps planned and secrets untouched",
+				State: func() cc.State {
+					return &state{URL: types.StringValue("https://planned"), Token: types.StringValue("planned-token")}
+				},
+				Check: func(t *testing.T, st cc.State) {
+					s := st.(*state)
+					testutils.AssertEq(t, "planned url", s.URL.ValueString(), "https://planned")
+					testutils.AssertEq(t, "planned token", s.Token.ValueString(), "planned-token")
+				},
+			},
+		},
+	})
+}
+
+var _ cc.State = &state{}
@@ -0,0 +1,93 @@
+package cribl
+
+import (
+	"context"
+	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	cc "terraform-provider-orcasecurity/orcasecurity/config_integration_common"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type state struct {
+	cc.CommonFields
+	URL                 types.String `tfsdk:"url"`
+	Token               types.String `tfsdk:"token"`
+	TokenWO             types.String `tfsdk:"token_wo"`
+	TokenWOVersion      types.Int64  `tfsdk:"token_wo_version"`
+	AllowSelfSignedCert types.Bool   `tfsdk:"allow_self_signed_cert"`
+}
+
+// buildPayload converts the planned state into the Cribl API payload.
+func buildPayload(_ context.Context, st cc.State, _ *diag.Diagnostics) api_client.CriblExternalServiceConfig {
+	s := st.(*state)
+	return api_client.CriblExternalServiceConfig{
+		TemplateName: s.TemplateName.ValueString(),
+		IsEnabled:    s.IsEnabled.ValueBool(),
+		IsDefault:    s.IsDefault.ValueBool(),
+		Config: api_client.CriblConfig{
+			URL:                 s.URL.ValueString(),
+			Token:               s.Token.ValueString(),
+			AllowSelfSignedCert: s.AllowSelfSignedCert.ValueBool(),
+		},
+	}
+}
+
+// extract maps the API envelope back onto state; an empty URL never clobbers the plan, and the
+// self-signed-cert flag always round-trips from the response.
+func extract(_ context.Context, o *api_client.CriblExternalServiceConfig, st cc.State, _ *diag.Diagnostics) cc.APIObject {
+	s := st.(*state)
+	if o.Config.URL != "" {
+		s.URL = types.StringValue(o.Config.URL)
+	}
+	s.AllowSelfSignedCert = types.BoolValue(o.Config.AllowSelfSignedCert)
+	return cc.APIObject{ID: o.ID, TemplateName: o.TemplateName, IsEnabled: o.IsEnabled, IsDefault: o.IsDefault}
+}
+
+func NewCriblResource() resource.Resource {
+	return cc.New(cc.Spec[api_client.CriblExternalServiceConfig]{
+		TypeNameSuffix: "_integration_cribl",
+		ServiceName:    api_client.CriblServiceName,
+		UIName:         "Cribl integration",
+		Description:    "Manage a Cribl Stream integration in Orca. Creates an external service config of `service_name = \"cribl\"` that streams alerts to a Cribl HTTP source. The auth token is stored in Orca's secret store and is never returned by the API.",
+		VariantAttributes: map[string]schema.Attribute{
+			"url": schema.StringAttribute{
+				Required:    true,
+				Description: "URL of the Cribl Stream HTTP source (for example, `https://<workspace>.cribl.cloud:10080/cribl/_bulk`).",
+				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"token": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "Auth token configured on the HTTP source. Stored in Orca's secret store; never returned by the API.",
+				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"allow_self_signed_cert": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether Orca should accept self-signed TLS certificates when calling the Cribl source. Defaults to `false`.",
+				Default:     booldefault.StaticBool(false),
+			},
+		},
+		WriteOnlySecrets: []string{"token"},
+		NewState:         func() cc.State { return &state{} },
+		BuildPayload:     buildPayload,
+		Extract:          extract,
+		Create:           (*api_client.APIClient).CreateCriblConfig,
+		Get:              (*api_client.APIClient).GetCriblConfig,
+		Update:           (*api_client.APIClient).UpdateCriblConfig,
+		Delete:           (*api_client.APIClient).DeleteCriblConfig,
+		List:             (*api_client.APIClient).ListCriblConfigs,
+	})
+}
+
+// NewCriblListResource lists the integrations managed by NewCriblResource.
+func NewCriblListResource() list.ListResource {
+	return cc.NewList(NewCriblResource)
+}
@@ -0,0 +1,24 @@
+package cribl_test
+
+import (
+	"terraform-provider-orcasecurity/orcasecurity/internal/acctest"
+	"testing"
+)
+
+func TestAccCriblResource(t *testing.T) {
+	acctest.RunIntegrationTest(t, criblSpec)
+}
+
+func TestCriblResource_Offline(t *testing.T) {
+	acctest.RunIntegrationTestOffline(t, criblSpec)
+}
+
+var criblSpec = acctest.IntegrationSpec{
+	ResourceType: "orcasecurity_integration_cribl",
+	TemplateName: "tf-acc-test-cribl",
+	Attributes: map[string]string{
+		"token": `"tf-acc-test-cribl-token"`,
+		"url":   `"https://default.main.tf-acc-test.cribl.cloud:10080/cribl/_bulk"`,
+	},
+	Secrets: []string{"token"},
+}
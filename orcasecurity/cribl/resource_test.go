@@ -0,0 +1,19 @@
+package cribl
+
+import (
+	"terraform-provider-orcasecurity/orcasecurity/internal/testutils"
+	"testing"
+)
+
+// The auth token is a secret with a write-only variant; url is required but not sensitive.
+// The variant uses the no-BU CommonFields flavour, so business_units must be absent.
+func TestCriblResource_SchemaContract(t *testing.T) {
+	testutils.CheckVariantResource(t, testutils.VariantResourceSpec{
+		NewResource:      NewCriblResource,
+		TypeName:         "orcasecurity_integration_cribl",
+		WriteOnlySecrets: []string{"token"},
+		PlainRequired:    []string{"url"},
+		Forbidden:        []string{"business_units"},
+		State:            &state{},
+	})
+}
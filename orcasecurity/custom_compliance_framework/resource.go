@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	"terraform-provider-orcasecurity/orcasecurity/apierror_common"
+	"terraform-provider-orcasecurity/orcasecurity/identity_common"
+	"terraform-provider-orcasecurity/orcasecurity/timeouts_common"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -22,6 +24,7 @@ var (
 	_ resource.Resource                = &customComplianceFrameworkResource{}
 	_ resource.ResourceWithConfigure   = &customComplianceFrameworkResource{}
 	_ resource.ResourceWithImportState = &customComplianceFrameworkResource{}
+	_ resource.ResourceWithIdentity    = &customComplianceFrameworkResource{}
 )
 
 type customComplianceFrameworkResource struct {
@@ -43,6 +46,8 @@ type customComplianceFrameworkResourceModel struct {
 	Name        types.String   `tfsdk:"name"`
 	Description types.String   `tfsdk:"description"`
 	Sections    []sectionModel `tfsdk:"sections"`
+
+	Timeouts timeouts_common.Value `tfsdk:"timeouts"`
 }
 
 func NewCustomComplianceFrameworkResource() resource.Resource {
@@ -61,10 +66,14 @@ func (r *customComplianceFrameworkResource) Configure(_ context.Context, req res
 }
 
 func (r *customComplianceFrameworkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	identity_common.ImportID(ctx, req, resp)
+}
+
+func (r *customComplianceFrameworkResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity_common.IDSchema()
 }
 
-func (r *customComplianceFrameworkResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *customComplianceFrameworkResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Provides a custom compliance framework resource. " +
 			"Note: sections and their tests are write-only. The API does not return " +
@@ -119,6 +128,9 @@ func (r *customComplianceFrameworkResource) Schema(_ context.Context, _ resource
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts_common.Block(ctx),
+		},
 	}
 }
 
@@ -130,6 +142,9 @@ func (r *customComplianceFrameworkResource) Create(ctx context.Context, req reso
 		return
 	}
 
+	ctx, cancel := timeouts_common.Create(ctx, plan.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
 	createReq := api_client.CustomComplianceFrameworkCreateRequest{
 		Name:        plan.Name.ValueString(),
 		Description: plan.Description.ValueString(),
@@ -137,19 +152,16 @@ func (r *customComplianceFrameworkResource) Create(ctx context.Context, req reso
 		CheckedKeys: []string{},
 	}
 
-	instance, err := r.apiClient.CreateCustomComplianceFramework(createReq)
+	instance, err := r.apiClient.CreateCustomComplianceFramework(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating custom compliance framework",
-			"Could not create custom compliance framework, unexpected error: "+err.Error(),
-		)
+		apierror_common.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, "Error creating custom compliance framework", "Could not create custom compliance framework", err)
 		return
 	}
 
 	id := instance.ID.String()
 
 	// Refresh from GET to get canonical server values
-	readInstance, err := r.apiClient.GetCustomComplianceFramework(id)
+	readInstance, err := r.apiClient.GetCustomComplianceFramework(ctx, id)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			errReadingFramework,
@@ -159,6 +171,7 @@ func (r *customComplianceFrameworkResource) Create(ctx context.Context, req reso
 	}
 
 	plan.ID = types.StringValue(id)
+	resp.Diagnostics.Append(identity_common.SetID(ctx, resp.Identity, plan.ID.ValueString())...)
 	if readInstance != nil {
 		plan.Name = types.StringValue(readInstance.DisplayName)
 		plan.Description = types.StringValue(readInstance.Description)
@@ -170,6 +183,7 @@ func (r *customComplianceFrameworkResource) Create(ctx context.Context, req reso
 }
 
 func (r *customComplianceFrameworkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(identity_common.SetIDFromState(ctx, resp.Identity, req.State)...)
 	var state customComplianceFrameworkResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -177,7 +191,10 @@ func (r *customComplianceFrameworkResource) Read(ctx context.Context, req resour
 		return
 	}
 
-	instance, err := r.apiClient.GetCustomComplianceFramework(state.ID.ValueString())
+	ctx, cancel := timeouts_common.Read(ctx, state.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
+	instance, err := r.apiClient.GetCustomComplianceFramework(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			errReadingFramework,
@@ -201,6 +218,7 @@ func (r *customComplianceFrameworkResource) Read(ctx context.Context, req resour
 }
 
 func (r *customComplianceFrameworkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(identity_common.SetIDFromState(ctx, resp.Identity, req.State)...)
 	var plan customComplianceFrameworkResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -208,23 +226,23 @@ func (r *customComplianceFrameworkResource) Update(ctx context.Context, req reso
 		return
 	}
 
+	ctx, cancel := timeouts_common.Update(ctx, plan.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
 	updateReq := api_client.CustomComplianceFrameworkUpdateRequest{
 		Name:        plan.Name.ValueString(),
 		Description: plan.Description.ValueString(),
 		Sections:    sectionsToAPI(plan.Sections),
 	}
 
-	_, err := r.apiClient.UpdateCustomComplianceFramework(plan.ID.ValueString(), updateReq)
+	_, err := r.apiClient.UpdateCustomComplianceFramework(ctx, plan.ID.ValueString(), updateReq)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating custom compliance framework",
-			"Could not update custom compliance framework, unexpected error: "+err.Error(),
-		)
+		apierror_common.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, "Error updating custom compliance framework", "Could not update custom compliance framework", err)
 		return
 	}
 
 	// Refresh from GET to get canonical server values
-	readInstance, err := r.apiClient.GetCustomComplianceFramework(plan.ID.ValueString())
+	readInstance, err := r.apiClient.GetCustomComplianceFramework(ctx, plan.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			errReadingFramework,
@@ -251,7 +269,10 @@ func (r *customComplianceFrameworkResource) Delete(ctx context.Context, req reso
 		return
 	}
 
-	err := r.apiClient.DeleteCustomComplianceFramework(state.ID.ValueString())
+	ctx, cancel := timeouts_common.Delete(ctx, state.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
+	err := r.apiClient.DeleteCustomComplianceFramework(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting custom compliance framework",
@@ -5,10 +5,12 @@ import (
 	"fmt"
 	"strings"
 	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	"terraform-provider-orcasecurity/orcasecurity/apierror_common"
+	"terraform-provider-orcasecurity/orcasecurity/identity_common"
+	"terraform-provider-orcasecurity/orcasecurity/timeouts_common"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
@@ -23,6 +25,7 @@ var (
 	_ resource.Resource                = &customDashboardResource{}
 	_ resource.ResourceWithConfigure   = &customDashboardResource{}
 	_ resource.ResourceWithImportState = &customDashboardResource{}
+	_ resource.ResourceWithIdentity    = &customDashboardResource{}
 )
 
 const (
@@ -52,6 +55,8 @@ type customDashboardResourceModel struct {
 	ExtraParameters   *customDashboardExtraParametersModel `tfsdk:"extra_params"`
 	OrganizationLevel types.Bool                           `tfsdk:"organization_level"`
 	ViewType          types.String                         `tfsdk:"view_type"`
+
+	Timeouts timeouts_common.Value `tfsdk:"timeouts"`
 }
 
 func NewCustomDashboardResource() resource.Resource {
@@ -70,7 +75,11 @@ func (r *customDashboardResource) Configure(_ context.Context, req resource.Conf
 }
 
 func (r *customDashboardResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	identity_common.ImportID(ctx, req, resp)
+}
+
+func (r *customDashboardResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity_common.IDSchema()
 }
 
 func (r *customDashboardResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -134,6 +143,9 @@ func (r *customDashboardResource) Schema(ctx context.Context, req resource.Schem
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts_common.Block(ctx),
+		},
 	}
 }
 
@@ -269,6 +281,9 @@ func (r *customDashboardResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	ctx, cancel := timeouts_common.Create(ctx, plan.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
 	filterData := make(map[string]interface{})
 
 	extra := generateExtraParameters(&plan)
@@ -284,16 +299,13 @@ func (r *customDashboardResource) Create(ctx context.Context, req resource.Creat
 		ViewType:          plan.ViewType.ValueString(),
 	}
 
-	instance, err := r.apiClient.CreateCustomDashboard(createReq)
+	instance, err := r.apiClient.CreateCustomDashboard(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating custom dashboard",
-			"Could not create custom dashboard, unexpected error: "+err.Error(),
-		)
+		apierror_common.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, "Error creating custom dashboard", "Could not create custom dashboard", err)
 		return
 	}
 
-	instance, err = r.apiClient.GetCustomDashboard(instance.ID)
+	instance, err = r.apiClient.GetCustomDashboard(ctx, instance.ID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error refreshing custom dashboard",
@@ -303,6 +315,7 @@ func (r *customDashboardResource) Create(ctx context.Context, req resource.Creat
 	}
 
 	plan.ID = types.StringValue(instance.ID)
+	resp.Diagnostics.Append(identity_common.SetID(ctx, resp.Identity, plan.ID.ValueString())...)
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -312,6 +325,7 @@ func (r *customDashboardResource) Create(ctx context.Context, req resource.Creat
 }
 
 func (r *customDashboardResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(identity_common.SetIDFromState(ctx, resp.Identity, req.State)...)
 	var state customDashboardResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -319,7 +333,10 @@ func (r *customDashboardResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
-	exists, err := r.apiClient.DoesCustomDashboardExist(state.ID.ValueString())
+	ctx, cancel := timeouts_common.Read(ctx, state.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
+	exists, err := r.apiClient.DoesCustomDashboardExist(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			errReadingCustomDashboard,
@@ -334,7 +351,7 @@ func (r *customDashboardResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
-	instance, err := r.apiClient.GetCustomDashboard(state.ID.ValueString())
+	instance, err := r.apiClient.GetCustomDashboard(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			errReadingCustomDashboard,
@@ -376,6 +393,7 @@ func (r *customDashboardResource) Read(ctx context.Context, req resource.ReadReq
 }
 
 func (r *customDashboardResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(identity_common.SetIDFromState(ctx, resp.Identity, req.State)...)
 	var plan customDashboardResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -383,6 +401,9 @@ func (r *customDashboardResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	ctx, cancel := timeouts_common.Update(ctx, plan.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
 	if plan.ID.String() == "" {
 		resp.Diagnostics.AddError(
 			"ID is null",
@@ -392,7 +413,7 @@ func (r *customDashboardResource) Update(ctx context.Context, req resource.Updat
 	}
 
 	// Get current instance so we can preserve widget slots (V2) when building the update payload
-	currentInstance, err := r.apiClient.GetCustomDashboard(plan.ID.ValueString())
+	currentInstance, err := r.apiClient.GetCustomDashboard(ctx, plan.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			errReadingCustomDashboard,
@@ -419,16 +440,13 @@ func (r *customDashboardResource) Update(ctx context.Context, req resource.Updat
 		ViewType:          plan.ViewType.ValueString(),
 	}
 
-	_, err = r.apiClient.UpdateCustomDashboard(updateReq)
+	_, err = r.apiClient.UpdateCustomDashboard(ctx, updateReq)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating custom dashboard",
-			"Could not create custom dashboard, unexpected error: "+err.Error(),
-		)
+		apierror_common.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, "Error updating custom dashboard", "Could not create custom dashboard", err)
 		return
 	}
 
-	instance, err := r.apiClient.GetCustomDashboard(plan.ID.ValueString())
+	instance, err := r.apiClient.GetCustomDashboard(ctx, plan.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating discovery view",
@@ -473,7 +491,10 @@ func (r *customDashboardResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
-	err := r.apiClient.DeleteCustomDashboard(state.ID.String()[1 : len(state.ID.String())-1])
+	ctx, cancel := timeouts_common.Delete(ctx, state.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
+	err := r.apiClient.DeleteCustomDashboard(ctx, state.ID.String()[1:len(state.ID.String())-1])
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting custom dashboard",
@@ -8,9 +8,11 @@ import (
 	"strings"
 	"terraform-provider-orcasecurity/orcasecurity/alert_common"
 	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	"terraform-provider-orcasecurity/orcasecurity/apierror_common"
+	"terraform-provider-orcasecurity/orcasecurity/identity_common"
+	"terraform-provider-orcasecurity/orcasecurity/timeouts_common"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -24,6 +26,7 @@ var (
 	_ resource.Resource                     = &customDiscoveryAlertResource{}
 	_ resource.ResourceWithConfigure        = &customDiscoveryAlertResource{}
 	_ resource.ResourceWithImportState      = &customDiscoveryAlertResource{}
+	_ resource.ResourceWithIdentity         = &customDiscoveryAlertResource{}
 	_ resource.ResourceWithConfigValidators = &customDiscoveryAlertResource{}
 )
 
@@ -57,6 +60,8 @@ type stateModel struct {
 	ContextScore    types.Bool                 `tfsdk:"context_score"`
 	Frameworks      []frameworkStateModel      `tfsdk:"compliance_frameworks"`
 	RemediationText *remediationTextStateModel `tfsdk:"remediation_text"`
+
+	Timeouts timeouts_common.Value `tfsdk:"timeouts"`
 }
 
 func NewCustomDiscoveryAlertResource() resource.Resource {
@@ -79,10 +84,14 @@ func (r *customDiscoveryAlertResource) ConfigValidators(_ context.Context) []res
 }
 
 func (r *customDiscoveryAlertResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	identity_common.ImportID(ctx, req, resp)
+}
+
+func (r *customDiscoveryAlertResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity_common.IDSchema()
 }
 
-func (r *customDiscoveryAlertResource) Schema(_ context.Context, req resource.SchemaRequest, res *resource.SchemaResponse) {
+func (r *customDiscoveryAlertResource) Schema(ctx context.Context, req resource.SchemaRequest, res *resource.SchemaResponse) {
 	res.Schema = schema.Schema{
 		Description: "Provides a custom discovery-based alert.",
 		Attributes: map[string]schema.Attribute{
@@ -169,6 +178,9 @@ func (r *customDiscoveryAlertResource) Schema(_ context.Context, req resource.Sc
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts_common.Block(ctx),
+		},
 	}
 }
 
@@ -180,7 +192,10 @@ func (r *customDiscoveryAlertResource) Create(ctx context.Context, req resource.
 		return
 	}
 
-	if err := validateCategory(r.apiClient, plan.Category.ValueString()); err != nil {
+	ctx, cancel := timeouts_common.Create(ctx, plan.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
+	if err := validateCategory(ctx, r.apiClient, plan.Category.ValueString()); err != nil {
 		resp.Diagnostics.AddError("Invalid category", err.Error())
 		return
 	}
@@ -190,10 +205,7 @@ func (r *customDiscoveryAlertResource) Create(ctx context.Context, req resource.
 	query := make(map[string]interface{})
 	err := json.Unmarshal([]byte(queryString), &query)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating custom alert",
-			"Could not create custom alert, unexpected error: "+err.Error(),
-		)
+		apierror_common.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, "Error creating custom alert", "Could not create custom alert", err)
 		return
 	}
 
@@ -216,16 +228,13 @@ func (r *customDiscoveryAlertResource) Create(ctx context.Context, req resource.
 		}
 	}
 
-	instance, err := r.apiClient.CreateCustomDiscoveryAlert(createReq)
+	instance, err := r.apiClient.CreateCustomDiscoveryAlert(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating Alert",
-			"Could not create Alert, unexpected error: "+err.Error(),
-		)
+		apierror_common.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, "Error creating Alert", "Could not create Alert", err)
 		return
 	}
 
-	instance, err = r.apiClient.GetCustomDiscoveryAlert(instance.ID)
+	instance, err = r.apiClient.GetCustomDiscoveryAlert(ctx, instance.ID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error refreshing Alert",
@@ -235,6 +244,7 @@ func (r *customDiscoveryAlertResource) Create(ctx context.Context, req resource.
 	}
 
 	plan.ID = types.StringValue(instance.ID)
+	resp.Diagnostics.Append(identity_common.SetID(ctx, resp.Identity, plan.ID.ValueString())...)
 	plan.RuleType = types.StringValue(instance.RuleType)
 	plan.OrganizationID = types.StringValue(instance.OrganizationID)
 
@@ -246,6 +256,7 @@ func (r *customDiscoveryAlertResource) Create(ctx context.Context, req resource.
 }
 
 func (r *customDiscoveryAlertResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(identity_common.SetIDFromState(ctx, resp.Identity, req.State)...)
 	var state stateModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -253,7 +264,10 @@ func (r *customDiscoveryAlertResource) Read(ctx context.Context, req resource.Re
 		return
 	}
 
-	exists, err := r.apiClient.DoesCustomDiscoveryAlertExist(state.ID.ValueString())
+	ctx, cancel := timeouts_common.Read(ctx, state.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
+	exists, err := r.apiClient.DoesCustomDiscoveryAlertExist(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			errReadingAlert,
@@ -268,7 +282,7 @@ func (r *customDiscoveryAlertResource) Read(ctx context.Context, req resource.Re
 		return
 	}
 
-	instance, err := r.apiClient.GetCustomDiscoveryAlert(state.ID.ValueString())
+	instance, err := r.apiClient.GetCustomDiscoveryAlert(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			errReadingAlert,
@@ -333,6 +347,7 @@ func (r *customDiscoveryAlertResource) Read(ctx context.Context, req resource.Re
 }
 
 func (r *customDiscoveryAlertResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(identity_common.SetIDFromState(ctx, resp.Identity, req.State)...)
 	var plan stateModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -340,6 +355,9 @@ func (r *customDiscoveryAlertResource) Update(ctx context.Context, req resource.
 		return
 	}
 
+	ctx, cancel := timeouts_common.Update(ctx, plan.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
 	var state stateModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -354,7 +372,7 @@ func (r *customDiscoveryAlertResource) Update(ctx context.Context, req resource.
 		return
 	}
 
-	if err := validateCategory(r.apiClient, plan.Category.ValueString()); err != nil {
+	if err := validateCategory(ctx, r.apiClient, plan.Category.ValueString()); err != nil {
 		resp.Diagnostics.AddError("Invalid category", err.Error())
 		return
 	}
@@ -364,10 +382,7 @@ func (r *customDiscoveryAlertResource) Update(ctx context.Context, req resource.
 	query := make(map[string]interface{})
 	err := json.Unmarshal([]byte(queryString), &query)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating custom alert",
-			"Could not update custom alert, unexpected error: "+err.Error(),
-		)
+		apierror_common.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, "Error updating custom alert", "Could not update custom alert", err)
 		return
 	}
 
@@ -395,11 +410,11 @@ func (r *customDiscoveryAlertResource) Update(ctx context.Context, req resource.
 		func() error {
 			clearReq := updateReq
 			clearReq.ComplianceFrameworks = nil
-			_, err := r.apiClient.UpdateCustomDiscoveryAlert(plan.ID.ValueString(), clearReq)
+			_, err := r.apiClient.UpdateCustomDiscoveryAlert(ctx, plan.ID.ValueString(), clearReq)
 			return err
 		},
 		func() error {
-			_, err := r.apiClient.UpdateCustomDiscoveryAlert(plan.ID.ValueString(), updateReq)
+			_, err := r.apiClient.UpdateCustomDiscoveryAlert(ctx, plan.ID.ValueString(), updateReq)
 			return err
 		},
 	)
@@ -432,7 +447,10 @@ func (r *customDiscoveryAlertResource) Delete(ctx context.Context, req resource.
 		return
 	}
 
-	err := r.apiClient.DeleteCustomDiscoveryAlert(state.ID.ValueString())
+	ctx, cancel := timeouts_common.Delete(ctx, state.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
+	err := r.apiClient.DeleteCustomDiscoveryAlert(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting Alert",
@@ -442,8 +460,8 @@ func (r *customDiscoveryAlertResource) Delete(ctx context.Context, req resource.
 	}
 }
 
-func validateCategory(client *api_client.APIClient, category string) error {
-	categories, err := client.GetAlertCategories()
+func validateCategory(ctx context.Context, client *api_client.APIClient, category string) error {
+	categories, err := client.GetAlertCategories(ctx)
 	if err != nil {
 		return err
 	}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	"terraform-provider-orcasecurity/orcasecurity/apierror_common"
+	"terraform-provider-orcasecurity/orcasecurity/identity_common"
+	"terraform-provider-orcasecurity/orcasecurity/timeouts_common"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -20,6 +22,7 @@ var (
 	_ resource.Resource                = &customRoleResource{}
 	_ resource.ResourceWithConfigure   = &customRoleResource{}
 	_ resource.ResourceWithImportState = &customRoleResource{}
+	_ resource.ResourceWithIdentity    = &customRoleResource{}
 )
 
 type customRoleResource struct {
@@ -39,6 +42,8 @@ type customRoleResourceModel struct {
 	//ExpirationDate   types.String `tfsdk:"expiration_date"`
 	Description types.String `tfsdk:"description"`
 	//CreatedBy        createdByResourceModel `tfsdk:"created_by"`
+
+	Timeouts timeouts_common.Value `tfsdk:"timeouts"`
 }
 
 func NewCustomRoleResource() resource.Resource {
@@ -57,7 +62,11 @@ func (r *customRoleResource) Configure(_ context.Context, req resource.Configure
 }
 
 func (r *customRoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	identity_common.ImportID(ctx, req, resp)
+}
+
+func (r *customRoleResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity_common.IDSchema()
 }
 
 func (r *customRoleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -110,6 +119,9 @@ func (r *customRoleResource) Schema(ctx context.Context, req resource.SchemaRequ
 				},
 			},*/
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts_common.Block(ctx),
+		},
 	}
 }
 
@@ -121,6 +133,9 @@ func (r *customRoleResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	ctx, cancel := timeouts_common.Create(ctx, plan.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
 	var permissions []string
 
 	for _, item := range plan.PermissionGroups.Elements() {
@@ -133,16 +148,13 @@ func (r *customRoleResource) Create(ctx context.Context, req resource.CreateRequ
 		Description:      plan.Description.ValueString(),
 	}
 
-	instance, err := r.apiClient.CreateCustomRole(createReq)
+	instance, err := r.apiClient.CreateCustomRole(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating custom role",
-			"Could not create custom role, unexpected error: "+err.Error(),
-		)
+		apierror_common.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, "Error creating custom role", "Could not create custom role", err)
 		return
 	}
 
-	instance, err = r.apiClient.GetCustomRole(instance.ID)
+	instance, err = r.apiClient.GetCustomRole(ctx, instance.ID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error refreshing custom role",
@@ -152,6 +164,7 @@ func (r *customRoleResource) Create(ctx context.Context, req resource.CreateRequ
 	}
 
 	plan.ID = types.StringValue(instance.ID)
+	resp.Diagnostics.Append(identity_common.SetID(ctx, resp.Identity, plan.ID.ValueString())...)
 
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -161,6 +174,7 @@ func (r *customRoleResource) Create(ctx context.Context, req resource.CreateRequ
 }
 
 func (r *customRoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(identity_common.SetIDFromState(ctx, resp.Identity, req.State)...)
 	var state customRoleResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -168,7 +182,10 @@ func (r *customRoleResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	exists, err := r.apiClient.DoesCustomRoleExist(state.ID.ValueString())
+	ctx, cancel := timeouts_common.Read(ctx, state.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
+	exists, err := r.apiClient.DoesCustomRoleExist(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading custom role",
@@ -183,7 +200,7 @@ func (r *customRoleResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	instance, err := r.apiClient.GetCustomRole(state.ID.ValueString())
+	instance, err := r.apiClient.GetCustomRole(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading custom role",
@@ -214,6 +231,7 @@ func (r *customRoleResource) Read(ctx context.Context, req resource.ReadRequest,
 }
 
 func (r *customRoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(identity_common.SetIDFromState(ctx, resp.Identity, req.State)...)
 	var plan customRoleResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -221,6 +239,9 @@ func (r *customRoleResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	ctx, cancel := timeouts_common.Update(ctx, plan.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
 	var permissions []string
 
 	for _, item := range plan.PermissionGroups.Elements() {
@@ -234,16 +255,13 @@ func (r *customRoleResource) Update(ctx context.Context, req resource.UpdateRequ
 		Description:      plan.Description.ValueString(),
 	}
 
-	_, err := r.apiClient.UpdateCustomRole(updateReq)
+	_, err := r.apiClient.UpdateCustomRole(ctx, updateReq)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating custom role",
-			"Could not update custom role, unexpected error: "+err.Error(),
-		)
+		apierror_common.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, "Error updating custom role", "Could not update custom role", err)
 		return
 	}
 
-	instance, err := r.apiClient.GetCustomRole(plan.ID.ValueString())
+	instance, err := r.apiClient.GetCustomRole(ctx, plan.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating custom role",
@@ -274,7 +292,10 @@ func (r *customRoleResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
-	err := r.apiClient.DeleteCustomRole(state.ID.String()[1 : len(state.ID.String())-1])
+	ctx, cancel := timeouts_common.Delete(ctx, state.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
+	err := r.apiClient.DeleteCustomRole(ctx, state.ID.String()[1:len(state.ID.String())-1])
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting custom role",
@@ -0,0 +1,66 @@
+package custom_sonar_alert
+
+import (
+	"context"
+
+	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	"terraform-provider-orcasecurity/orcasecurity/identity_common"
+	"terraform-provider-orcasecurity/orcasecurity/list_common"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type listFilterModel struct {
+	NameContains types.String `tfsdk:"name_contains"`
+	Category     types.String `tfsdk:"category"`
+	Enabled      types.Bool   `tfsdk:"enabled"`
+}
+
+// NewCustomSonarAlertListResource lists the organization's custom Sonar alerts.
+func NewCustomSonarAlertListResource() list.ListResource {
+	return list_common.New(list_common.Spec{
+		NewResource: NewCustomSonarAlertResource,
+		Description: "Lists custom Sonar alerts, optionally filtered by name, category and enablement.",
+		Attributes: map[string]schema.Attribute{
+			"name_contains": list_common.NameContainsAttribute("alerts"),
+			"category": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list alerts in this category (case-insensitive).",
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Only list enabled (`true`) or disabled (`false`) alerts.",
+			},
+		},
+		Fetch: listAlerts,
+	})
+}
+
+func listAlerts(ctx context.Context, client *api_client.APIClient, config tfsdk.Config) ([]list_common.Item, diag.Diagnostics) {
+	var filter listFilterModel
+	diags := config.Get(ctx, &filter)
+	if diags.HasError() {
+		return nil, diags
+	}
+	alerts, err := client.ListCustomSonarAlerts(ctx)
+	if err != nil {
+		diags.AddError("Error listing Alerts", "Could not list Alerts: "+err.Error())
+		return nil, diags
+	}
+	var items []list_common.Item
+	for _, a := range alerts {
+		if !list_common.Contains(a.Name, filter.NameContains) || !list_common.Is(a.Category, filter.Category) ||
+			!list_common.BoolIs(a.Enabled, filter.Enabled) {
+			continue
+		}
+		items = append(items, list_common.Item{
+			Identity:    map[string]string{identity_common.IDAttribute: a.ID},
+			DisplayName: a.Name,
+		})
+	}
+	return items, diags
+}
@@ -0,0 +1,61 @@
+package custom_sonar_alert
+
+import (
+	"context"
+	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	"terraform-provider-orcasecurity/orcasecurity/internal/fakeorca"
+	"terraform-provider-orcasecurity/orcasecurity/internal/testutils"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestList_Filters(t *testing.T) {
+	client := fakeorca.New(t).Client()
+	ids := map[string]string{}
+	for _, a := range []api_client.CustomAlert{
+		{Name: "Public S3 bucket", Category: "Data at risk", Rule: "AwsS3Bucket", Enabled: true},
+		{Name: "Public RDS snapshot", Category: "Data at risk", Rule: "AwsRdsSnapshot", Enabled: false},
+		{Name: "Root login", Category: "Authentication", Rule: "AwsIamUser", Enabled: true},
+	} {
+		created, err := client.CreateCustomSonarAlert(context.Background(), a)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids[a.Name] = created.ID
+	}
+
+	cases := []struct {
+		name    string
+		filters map[string]tftypes.Value
+		want    []string
+	}{
+		{"no filters", nil, []string{"Public S3 bucket", "Public RDS snapshot", "Root login"}},
+		{"name", map[string]tftypes.Value{"name_contains": tftypes.NewValue(tftypes.String, "public")}, []string{"Public S3 bucket", "Public RDS snapshot"}},
+		{"category", map[string]tftypes.Value{"category": tftypes.NewValue(tftypes.String, "data at risk")}, []string{"Public S3 bucket", "Public RDS snapshot"}},
+		{"enabled", map[string]tftypes.Value{"enabled": tftypes.NewValue(tftypes.Bool, true)}, []string{"Public S3 bucket", "Root login"}},
+		{"combined", map[string]tftypes.Value{
+			"category": tftypes.NewValue(tftypes.String, "Data at risk"),
+			"enabled":  tftypes.NewValue(tftypes.Bool, false),
+		}, []string{"Public RDS snapshot"}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			results := testutils.RunList(t, NewCustomSonarAlertListResource(), NewCustomSonarAlertResource, client, testutils.ListQuery{Filters: tc.filters})
+			if len(results) != len(tc.want) {
+				t.Fatalf("got %d results, want %d", len(results), len(tc.want))
+			}
+			for i, r := range results {
+				if r.Diagnostics.HasError() {
+					t.Fatalf("result %d: %v", i, r.Diagnostics)
+				}
+				if r.DisplayName != tc.want[i] {
+					t.Errorf("result %d display name = %q, want %q", i, r.DisplayName, tc.want[i])
+				}
+				if got := testutils.IdentityString(t, r, "id"); got != ids[tc.want[i]] {
+					t.Errorf("result %d id = %q, want %q", i, got, ids[tc.want[i]])
+				}
+			}
+		})
+	}
+}
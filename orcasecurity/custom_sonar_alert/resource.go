@@ -7,6 +7,10 @@ import (
 	"strings"
 	"terraform-provider-orcasecurity/orcasecurity/alert_common"
 	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	"terraform-provider-orcasecurity/orcasecurity/apierror_common"
+	"terraform-provider-orcasecurity/orcasecurity/identity_common"
+	"terraform-provider-orcasecurity/orcasecurity/sonar_query"
+	"terraform-provider-orcasecurity/orcasecurity/timeouts_common"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -23,7 +27,9 @@ var (
 	_ resource.Resource                     = &customSonarAlertResource{}
 	_ resource.ResourceWithConfigure        = &customSonarAlertResource{}
 	_ resource.ResourceWithImportState      = &customSonarAlertResource{}
+	_ resource.ResourceWithIdentity         = &customSonarAlertResource{}
 	_ resource.ResourceWithConfigValidators = &customSonarAlertResource{}
+	_ resource.ResourceWithModifyPlan       = &customSonarAlertResource{}
 )
 
 type customSonarAlertResource struct {
@@ -54,6 +60,8 @@ type stateModel struct {
 	Enabled         types.Bool                 `tfsdk:"enabled"`
 	Frameworks      []frameworkStateModel      `tfsdk:"compliance_frameworks"`
 	RemediationText *remediationTextStateModel `tfsdk:"remediation_text"`
+
+	Timeouts timeouts_common.Value `tfsdk:"timeouts"`
 }
 
 func NewCustomSonarAlertResource() resource.Resource {
@@ -75,11 +83,32 @@ func (r *customSonarAlertResource) ConfigValidators(_ context.Context) []resourc
 	return []resource.ConfigValidator{}
 }
 
+// ModifyPlan checks a new or changed rule with the Orca query parser, so syntax errors and
+// unknown models or fields fail the plan rather than the apply.
+func (r *customSonarAlertResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var planned, prior types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("rule"), &planned)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("rule"), &prior)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	sonar_query.ValidatePlanned(ctx, r.apiClient, path.Root("rule"), planned, prior, true, &resp.Diagnostics)
+}
+
 func (r *customSonarAlertResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	identity_common.ImportID(ctx, req, resp)
 }
 
-func (r *customSonarAlertResource) Schema(_ context.Context, req resource.SchemaRequest, res *resource.SchemaResponse) {
+func (r *customSonarAlertResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity_common.IDSchema()
+}
+
+func (r *customSonarAlertResource) Schema(ctx context.Context, req resource.SchemaRequest, res *resource.SchemaResponse) {
 	res.Schema = schema.Schema{
 		Description: "Provides a custom sonar-based alert.",
 		Attributes: map[string]schema.Attribute{
@@ -171,6 +200,9 @@ func (r *customSonarAlertResource) Schema(_ context.Context, req resource.Schema
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts_common.Block(ctx),
+		},
 	}
 }
 
@@ -182,7 +214,10 @@ func (r *customSonarAlertResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
-	if err := validateCategory(r.apiClient, plan.Category.ValueString()); err != nil {
+	ctx, cancel := timeouts_common.Create(ctx, plan.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
+	if err := validateCategory(ctx, r.apiClient, plan.Category.ValueString()); err != nil {
 		resp.Diagnostics.AddError("Invalid category", err.Error())
 		return
 	}
@@ -212,16 +247,13 @@ func (r *customSonarAlertResource) Create(ctx context.Context, req resource.Crea
 		}
 	}
 
-	instance, err := r.apiClient.CreateCustomSonarAlert(createReq)
+	instance, err := r.apiClient.CreateCustomSonarAlert(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating Alert",
-			"Could not create Alert, unexpected error: "+err.Error(),
-		)
+		apierror_common.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, "Error creating Alert", "Could not create Alert", err)
 		return
 	}
 
-	instance, err = r.apiClient.GetCustomSonarAlert(instance.ID)
+	instance, err = r.apiClient.GetCustomSonarAlert(ctx, instance.ID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error refreshing Alert",
@@ -231,6 +263,7 @@ func (r *customSonarAlertResource) Create(ctx context.Context, req resource.Crea
 	}
 
 	plan.ID = types.StringValue(instance.ID)
+	resp.Diagnostics.Append(identity_common.SetID(ctx, resp.Identity, plan.ID.ValueString())...)
 	plan.RuleType = types.StringValue(instance.RuleType)
 	plan.OrganizationID = types.StringValue(instance.OrganizationID)
 	plan.Enabled = types.BoolValue(instance.Enabled)
@@ -243,6 +276,7 @@ func (r *customSonarAlertResource) Create(ctx context.Context, req resource.Crea
 }
 
 func (r *customSonarAlertResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(identity_common.SetIDFromState(ctx, resp.Identity, req.State)...)
 	var state stateModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -250,7 +284,10 @@ func (r *customSonarAlertResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
-	exists, err := r.apiClient.DoesCustomSonarAlertExist(state.ID.ValueString())
+	ctx, cancel := timeouts_common.Read(ctx, state.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
+	exists, err := r.apiClient.DoesCustomSonarAlertExist(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading Alert",
@@ -265,7 +302,7 @@ func (r *customSonarAlertResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
-	instance, err := r.apiClient.GetCustomSonarAlert(state.ID.ValueString())
+	instance, err := r.apiClient.GetCustomSonarAlert(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading Alert",
@@ -311,6 +348,7 @@ func (r *customSonarAlertResource) Read(ctx context.Context, req resource.ReadRe
 }
 
 func (r *customSonarAlertResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(identity_common.SetIDFromState(ctx, resp.Identity, req.State)...)
 	var plan stateModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -318,6 +356,9 @@ func (r *customSonarAlertResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	ctx, cancel := timeouts_common.Update(ctx, plan.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
 	var state stateModel
 	diags = req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -333,7 +374,7 @@ func (r *customSonarAlertResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
-	if err := validateCategory(r.apiClient, plan.Category.ValueString()); err != nil {
+	if err := validateCategory(ctx, r.apiClient, plan.Category.ValueString()); err != nil {
 		resp.Diagnostics.AddError("Invalid category", err.Error())
 		return
 	}
@@ -369,11 +410,11 @@ func (r *customSonarAlertResource) Update(ctx context.Context, req resource.Upda
 		func() error {
 			clearReq := updateReq
 			clearReq.ComplianceFrameworks = nil
-			_, err := r.apiClient.UpdateCustomSonarAlert(plan.ID.ValueString(), clearReq)
+			_, err := r.apiClient.UpdateCustomSonarAlert(ctx, plan.ID.ValueString(), clearReq)
 			return err
 		},
 		func() error {
-			_, err := r.apiClient.UpdateCustomSonarAlert(plan.ID.ValueString(), updateReq)
+			_, err := r.apiClient.UpdateCustomSonarAlert(ctx, plan.ID.ValueString(), updateReq)
 			return err
 		},
 	)
@@ -404,7 +445,10 @@ func (r *customSonarAlertResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
-	err := r.apiClient.DeleteCustomSonarAlert(state.ID.ValueString())
+	ctx, cancel := timeouts_common.Delete(ctx, state.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
+	err := r.apiClient.DeleteCustomSonarAlert(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting Alert",
@@ -414,8 +458,8 @@ func (r *customSonarAlertResource) Delete(ctx context.Context, req resource.Dele
 	}
 }
 
-func validateCategory(client *api_client.APIClient, category string) error {
-	categories, err := client.GetAlertCategories()
+func validateCategory(ctx context.Context, client *api_client.APIClient, category string) error {
+	categories, err := client.GetAlertCategories(ctx)
 	if err != nil {
 		return err
 	}
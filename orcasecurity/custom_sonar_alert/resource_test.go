@@ -3,6 +3,7 @@ package custom_sonar_alert_test
 import (
 	"fmt"
 	"terraform-provider-orcasecurity/orcasecurity"
+	"terraform-provider-orcasecurity/orcasecurity/internal/acctest"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -36,7 +37,17 @@ resource "orcasecurity_custom_compliance_framework" "test_framework" {
 `, frameworkName)
 
 func TestAccCustomSonarAlertResource_Basic(t *testing.T) {
-	resource.Test(t, resource.TestCase{
+	resource.Test(t, customSonarAlertBasicTestCase())
+}
+
+// TestCustomSonarAlertResource_BasicOffline runs the basic lifecycle against the in-memory fake API.
+func TestCustomSonarAlertResource_BasicOffline(t *testing.T) {
+	acctest.Offline(t)
+	resource.UnitTest(t, customSonarAlertBasicTestCase())
+}
+
+func customSonarAlertBasicTestCase() resource.TestCase {
+	return resource.TestCase{
 		ProtoV6ProviderFactories: orcasecurity.TestAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			// create
@@ -92,7 +103,7 @@ resource "%s" "%s" {
 				),
 			},
 		},
-	})
+	}
 }
 
 func TestAccCustomSonarAlertResource_AddRemediationText(t *testing.T) {
@@ -6,10 +6,12 @@ import (
 	"fmt"
 	"reflect"
 	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	"terraform-provider-orcasecurity/orcasecurity/apierror_common"
+	"terraform-provider-orcasecurity/orcasecurity/identity_common"
+	"terraform-provider-orcasecurity/orcasecurity/timeouts_common"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -25,6 +27,7 @@ var (
 	_ resource.Resource                = &customTagRuleResource{}
 	_ resource.ResourceWithConfigure   = &customTagRuleResource{}
 	_ resource.ResourceWithImportState = &customTagRuleResource{}
+	_ resource.ResourceWithIdentity    = &customTagRuleResource{}
 )
 
 type customTagRuleResource struct {
@@ -39,6 +42,8 @@ type stateModel struct {
 	Rule        types.String      `tfsdk:"rule"`
 	RuleType    types.String      `tfsdk:"rule_type"`
 	Disabled    types.Bool        `tfsdk:"disabled"`
+
+	Timeouts timeouts_common.Value `tfsdk:"timeouts"`
 }
 
 func NewCustomTagRuleResource() resource.Resource {
@@ -57,10 +62,14 @@ func (r *customTagRuleResource) Configure(_ context.Context, req resource.Config
 }
 
 func (r *customTagRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	identity_common.ImportID(ctx, req, resp)
+}
+
+func (r *customTagRuleResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity_common.IDSchema()
 }
 
-func (r *customTagRuleResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *customTagRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Provides a custom tag rule. Custom tag rules automatically apply custom tags to all assets that match a discovery (Sonar) query.",
 		Attributes: map[string]schema.Attribute{
@@ -117,6 +126,9 @@ func (r *customTagRuleResource) Schema(_ context.Context, req resource.SchemaReq
 				Default:     booldefault.StaticBool(false),
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts_common.Block(ctx),
+		},
 	}
 }
 
@@ -139,16 +151,17 @@ func (r *customTagRuleResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
-	instance, err := r.apiClient.CreateCustomTagRule(generateAPIRequest(plan))
+	ctx, cancel := timeouts_common.Create(ctx, plan.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
+	instance, err := r.apiClient.CreateCustomTagRule(ctx, generateAPIRequest(plan))
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating custom tag rule",
-			"Could not create custom tag rule, unexpected error: "+err.Error(),
-		)
+		apierror_common.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, "Error creating custom tag rule", "Could not create custom tag rule", err)
 		return
 	}
 
 	plan.ID = types.StringValue(instance.ID)
+	resp.Diagnostics.Append(identity_common.SetID(ctx, resp.Identity, plan.ID.ValueString())...)
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -158,6 +171,7 @@ func (r *customTagRuleResource) Create(ctx context.Context, req resource.CreateR
 }
 
 func (r *customTagRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(identity_common.SetIDFromState(ctx, resp.Identity, req.State)...)
 	var state stateModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -165,7 +179,10 @@ func (r *customTagRuleResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
-	instance, err := r.apiClient.GetCustomTagRule(state.ID.ValueString())
+	ctx, cancel := timeouts_common.Read(ctx, state.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
+	instance, err := r.apiClient.GetCustomTagRule(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading custom tag rule",
@@ -205,6 +222,7 @@ func (r *customTagRuleResource) Read(ctx context.Context, req resource.ReadReque
 }
 
 func (r *customTagRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(identity_common.SetIDFromState(ctx, resp.Identity, req.State)...)
 	var plan stateModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -212,12 +230,12 @@ func (r *customTagRuleResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
-	_, err := r.apiClient.UpdateCustomTagRule(plan.ID.ValueString(), generateAPIRequest(plan))
+	ctx, cancel := timeouts_common.Update(ctx, plan.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
+	_, err := r.apiClient.UpdateCustomTagRule(ctx, plan.ID.ValueString(), generateAPIRequest(plan))
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating custom tag rule",
-			"Could not update custom tag rule, unexpected error: "+err.Error(),
-		)
+		apierror_common.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, "Error updating custom tag rule", "Could not update custom tag rule", err)
 		return
 	}
 
@@ -236,7 +254,10 @@ func (r *customTagRuleResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
-	err := r.apiClient.DeleteCustomTagRule(state.ID.ValueString())
+	ctx, cancel := timeouts_common.Delete(ctx, state.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
+	err := r.apiClient.DeleteCustomTagRule(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting custom tag rule",
@@ -5,10 +5,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	"terraform-provider-orcasecurity/orcasecurity/apierror_common"
+	"terraform-provider-orcasecurity/orcasecurity/identity_common"
+	"terraform-provider-orcasecurity/orcasecurity/timeouts_common"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -25,6 +27,7 @@ var (
 	_ resource.Resource                = &customWidgetResource{}
 	_ resource.ResourceWithConfigure   = &customWidgetResource{}
 	_ resource.ResourceWithImportState = &customWidgetResource{}
+	_ resource.ResourceWithIdentity    = &customWidgetResource{}
 )
 
 const (
@@ -91,6 +94,8 @@ type customWidgetResourceModel struct {
 	ExtraParameters   *customWidgetExtraParametersModel `tfsdk:"extra_params"`
 	ViewType          types.String                      `tfsdk:"view_type"`
 	OrganizationLevel types.Bool                        `tfsdk:"organization_level"`
+
+	Timeouts timeouts_common.Value `tfsdk:"timeouts"`
 }
 
 func NewCustomWidgetResource() resource.Resource {
@@ -109,7 +114,11 @@ func (r *customWidgetResource) Configure(_ context.Context, req resource.Configu
 }
 
 func (r *customWidgetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	identity_common.ImportID(ctx, req, resp)
+}
+
+func (r *customWidgetResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity_common.IDSchema()
 }
 
 func (r *customWidgetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -304,6 +313,9 @@ func (r *customWidgetResource) Schema(ctx context.Context, req resource.SchemaRe
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts_common.Block(ctx),
+		},
 	}
 }
 
@@ -783,6 +795,9 @@ func (r *customWidgetResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	ctx, cancel := timeouts_common.Create(ctx, plan.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
 	filterData := make(map[string]interface{})
 
 	createReq := api_client.CustomWidget{
@@ -793,7 +808,7 @@ func (r *customWidgetResource) Create(ctx context.Context, req resource.CreateRe
 		ViewType:          "customs_widgets",
 	}
 
-	instance, err := r.apiClient.CreateCustomWidget(createReq)
+	instance, err := r.apiClient.CreateCustomWidget(ctx, createReq)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			errCreatingCustomWidget,
@@ -802,7 +817,7 @@ func (r *customWidgetResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
-	created, err := r.apiClient.GetCustomWidget(instance.ID)
+	created, err := r.apiClient.GetCustomWidget(ctx, instance.ID)
 	if err != nil || created == nil {
 		resp.Diagnostics.AddError(
 			errCreatingCustomWidget,
@@ -819,6 +834,7 @@ func (r *customWidgetResource) Create(ctx context.Context, req resource.CreateRe
 		)
 		return
 	}
+	state.Timeouts = plan.Timeouts
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
@@ -828,6 +844,7 @@ func (r *customWidgetResource) Create(ctx context.Context, req resource.CreateRe
 }
 
 func (r *customWidgetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(identity_common.SetIDFromState(ctx, resp.Identity, req.State)...)
 	tflog.Info(ctx, "Starting Read operation")
 
 	var state customWidgetResourceModel
@@ -837,8 +854,11 @@ func (r *customWidgetResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
+	ctx, cancel := timeouts_common.Read(ctx, state.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
 	id := state.ID.ValueString()
-	exists, err := r.apiClient.DoesCustomWidgetExist(id)
+	exists, err := r.apiClient.DoesCustomWidgetExist(ctx, id)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			errReadingCustomWidget,
@@ -853,7 +873,7 @@ func (r *customWidgetResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
-	instance, err := r.apiClient.GetCustomWidget(id)
+	instance, err := r.apiClient.GetCustomWidget(ctx, id)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			errReadingCustomWidget,
@@ -862,6 +882,7 @@ func (r *customWidgetResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
+	timeouts := state.Timeouts
 	state, err = instanceToState(ctx, instance)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -870,11 +891,13 @@ func (r *customWidgetResource) Read(ctx context.Context, req resource.ReadReques
 		)
 		return
 	}
+	state.Timeouts = timeouts
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 }
 
 func (r *customWidgetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(identity_common.SetIDFromState(ctx, resp.Identity, req.State)...)
 
 	tflog.Info(ctx, "Starting Update operation")
 
@@ -887,6 +910,9 @@ func (r *customWidgetResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	ctx, cancel := timeouts_common.Update(ctx, plan.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
 	if plan.ID.String() == "" {
 		resp.Diagnostics.AddError(
 			"ID is null",
@@ -906,19 +932,17 @@ func (r *customWidgetResource) Update(ctx context.Context, req resource.UpdateRe
 		ViewType:          "customs_widgets",
 	}
 
-	instance, err := r.apiClient.UpdateCustomWidget(updateReq)
+	instance, err := r.apiClient.UpdateCustomWidget(ctx, updateReq)
 
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating custom widget",
-			"Could not update custom widget, unexpected error: "+err.Error(),
-		)
+		apierror_common.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, "Error updating custom widget", "Could not update custom widget", err)
 		return
 	}
 
 	tflog.Info(ctx, fmt.Sprintf("Received instance ID after update: %s", instance.ID))
 
 	plan.ID = types.StringValue(instance.ID)
+	resp.Diagnostics.Append(identity_common.SetID(ctx, resp.Identity, plan.ID.ValueString())...)
 	tflog.Info(ctx, fmt.Sprintf("Plan ID being set in state: %s", plan.ID.ValueString()))
 	plan.OrganizationLevel = types.BoolValue(instance.OrganizationLevel)
 	plan.Name = types.StringValue(instance.Name)
@@ -941,7 +965,10 @@ func (r *customWidgetResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
-	err := r.apiClient.DeleteCustomWidget(state.ID.String()[1 : len(state.ID.String())-1])
+	ctx, cancel := timeouts_common.Delete(ctx, state.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
+	err := r.apiClient.DeleteCustomWidget(ctx, state.ID.String()[1:len(state.ID.String())-1])
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting custom widget",
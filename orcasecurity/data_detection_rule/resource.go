@@ -4,7 +4,10 @@ import (
 	"context"
 	"fmt"
 	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	"terraform-provider-orcasecurity/orcasecurity/apierror_common"
+	"terraform-provider-orcasecurity/orcasecurity/identity_common"
 	"terraform-provider-orcasecurity/orcasecurity/tfconv"
+	"terraform-provider-orcasecurity/orcasecurity/timeouts_common"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
@@ -26,6 +29,7 @@ var (
 	_ resource.Resource                     = &dataDetectionRuleResource{}
 	_ resource.ResourceWithConfigure        = &dataDetectionRuleResource{}
 	_ resource.ResourceWithImportState      = &dataDetectionRuleResource{}
+	_ resource.ResourceWithIdentity         = &dataDetectionRuleResource{}
 	_ resource.ResourceWithConfigValidators = &dataDetectionRuleResource{}
 )
 
@@ -53,6 +57,8 @@ type stateModel struct {
 	SelectorBusinessUnits types.Set    `tfsdk:"selector_business_units"`
 	Tags                  []tagModel   `tfsdk:"tags"`
 	IsDefaultRule         types.Bool   `tfsdk:"is_default_rule"`
+
+	Timeouts timeouts_common.Value `tfsdk:"timeouts"`
 }
 
 func NewDataDetectionRuleResource() resource.Resource {
@@ -84,10 +90,14 @@ func (r *dataDetectionRuleResource) ConfigValidators(_ context.Context) []resour
 }
 
 func (r *dataDetectionRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	identity_common.ImportID(ctx, req, resp)
+}
+
+func (r *dataDetectionRuleResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity_common.IDSchema()
 }
 
-func (r *dataDetectionRuleResource) Schema(_ context.Context, req resource.SchemaRequest, res *resource.SchemaResponse) {
+func (r *dataDetectionRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, res *resource.SchemaResponse) {
 	res.Schema = schema.Schema{
 		Description: "Provides a DSPM data detection rule (scan configuration rule with feature `DSPM Scanning`). A rule binds data protection policies to a scan scope.",
 		Attributes: map[string]schema.Attribute{
@@ -179,6 +189,9 @@ func (r *dataDetectionRuleResource) Schema(_ context.Context, req resource.Schem
 				Computed:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts_common.Block(ctx),
+		},
 	}
 }
 
@@ -237,19 +250,19 @@ func (r *dataDetectionRuleResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	ctx, cancel := timeouts_common.Create(ctx, plan.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
 	// non-standard REST: create is PUT on the collection, returns data.rule_id
-	ruleID, err := r.apiClient.CreateDataDetectionRule(generateRulePayload(ctx, plan))
+	ruleID, err := r.apiClient.CreateDataDetectionRule(ctx, generateRulePayload(ctx, plan))
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating Data Detection Rule",
-			"Could not create Data Detection Rule, unexpected error: "+err.Error(),
-		)
+		apierror_common.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, "Error creating Data Detection Rule", "Could not create Data Detection Rule", err)
 		return
 	}
 
 	// the create response only carries the id — refresh to resolve the
 	// server-assigned priority, organization and default flag
-	instance, err := r.apiClient.GetDataDetectionRule(ruleID)
+	instance, err := r.apiClient.GetDataDetectionRule(ctx, ruleID)
 	if err != nil || instance == nil {
 		message := "rule vanished right after creation"
 		if err != nil {
@@ -263,6 +276,7 @@ func (r *dataDetectionRuleResource) Create(ctx context.Context, req resource.Cre
 	}
 
 	plan.ID = types.StringValue(instance.ID)
+	resp.Diagnostics.Append(identity_common.SetID(ctx, resp.Identity, plan.ID.ValueString())...)
 	plan.OrganizationID = types.StringValue(instance.OrganizationID)
 	plan.Priority = tfconv.Int64FromAPIPtr(instance.Priority)
 	plan.IsDefaultRule = types.BoolValue(instance.IsDefaultRule)
@@ -272,6 +286,7 @@ func (r *dataDetectionRuleResource) Create(ctx context.Context, req resource.Cre
 }
 
 func (r *dataDetectionRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(identity_common.SetIDFromState(ctx, resp.Identity, req.State)...)
 	var state stateModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -279,7 +294,10 @@ func (r *dataDetectionRuleResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
-	instance, err := r.apiClient.GetDataDetectionRule(state.ID.ValueString())
+	ctx, cancel := timeouts_common.Read(ctx, state.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
+	instance, err := r.apiClient.GetDataDetectionRule(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading Data Detection Rule",
@@ -323,6 +341,7 @@ func (r *dataDetectionRuleResource) Read(ctx context.Context, req resource.ReadR
 }
 
 func (r *dataDetectionRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(identity_common.SetIDFromState(ctx, resp.Identity, req.State)...)
 	var plan stateModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -330,6 +349,9 @@ func (r *dataDetectionRuleResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
+	ctx, cancel := timeouts_common.Update(ctx, plan.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
 	if plan.ID.ValueString() == "" {
 		resp.Diagnostics.AddError(
 			"ID is null",
@@ -341,15 +363,12 @@ func (r *dataDetectionRuleResource) Update(ctx context.Context, req resource.Upd
 	// non-standard REST: update goes through POST /bulk_rules
 	updateReq := generateRulePayload(ctx, plan)
 	updateReq.ID = plan.ID.ValueString()
-	if err := r.apiClient.UpdateDataDetectionRule(updateReq); err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating Data Detection Rule",
-			"Could not update Data Detection Rule, unexpected error: "+err.Error(),
-		)
+	if err := r.apiClient.UpdateDataDetectionRule(ctx, updateReq); err != nil {
+		apierror_common.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, "Error updating Data Detection Rule", "Could not update Data Detection Rule", err)
 		return
 	}
 
-	instance, err := r.apiClient.GetDataDetectionRule(plan.ID.ValueString())
+	instance, err := r.apiClient.GetDataDetectionRule(ctx, plan.ID.ValueString())
 	if err != nil || instance == nil {
 		message := "rule vanished right after update"
 		if err != nil {
@@ -379,7 +398,10 @@ func (r *dataDetectionRuleResource) Delete(ctx context.Context, req resource.Del
 		return
 	}
 
-	err := r.apiClient.DeleteDataDetectionRule(state.ID.ValueString())
+	ctx, cancel := timeouts_common.Delete(ctx, state.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
+	err := r.apiClient.DeleteDataDetectionRule(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting Data Detection Rule",
@@ -0,0 +1,75 @@
+package datadog
+
+import (
+	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	cc "terraform-provider-orcasecurity/orcasecurity/config_integration_common"
+	"terraform-provider-orcasecurity/orcasecurity/internal/testutils"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The shared suite covers the envelope plumbing; the closures below describe Datadog's config
+// fields: the API key is write-only, and the API round-trips site, tags, service and source.
+func TestMapping(t *testing.T) {
+	testutils.RunMappingSuite(t, testutils.MappingSuite[api_client.DatadogConfig]{
+		BuildPayload: buildPayload,
+		Extract:      extract,
+		TemplateName: "tf-acc-test-datadog",
+		FilledState: func() cc.State {
+			return &state{
+				Site:    types.StringValue("datadoghq.eu"),
+				APIKey:  types.StringValue("dd-api-key"),
+				Tags:    testutils.StringSet(t, "env:prod"),
+				Service: types.StringValue("orca"),
+				Source:  types.StringValue("orca-security"),
+			}
+		},
+		CheckConfig: func(t *testing.T, c api_client.DatadogConfig) {
+			testutils.AssertEq(t, "site", c.Site, "datadoghq.eu")
+			testutils.AssertEq(t, "api_key", c.APIKey, "dd-api-key")
+			testutils.AssertEq(t, "service", c.Service, "orca")
+			testutils.AssertEq(t, "source", c.Source, "orca-security")
+			if len(c.Tags) != 1 || c.Tags[0] != "env:prod" {
+				t.Errorf("tags mismatch: %v", c.Tags)
+			}
+		},
+		EchoConfig: api_client.DatadogConfig{Site: "us5.datadoghq.com", Tags: []string{"team:sec"}, Service: "returned", Source: "returned-src"},
+		EchoState: func() cc.State {
+			return &state{Site: types.StringValue("datadoghq.com"), Tags: types.SetNull(types.StringType)}
+		},
+		CheckEchoed: func(t *testing.T, st cc.State) {
+			s := st.(*state)
+			testutils.AssertEq(t, "echoed site", s.Site.ValueString(), "us5.datadoghq.com")
+			testutils.AssertEq(t, "echoed service", s.Service.ValueString(), "returned")
+			testutils.AssertEq(t, "echoed source", s.Source.ValueString(), "returned-src")
+			if !s.Tags.Equal(testutils.StringSet(t, "team:sec")) {
+				t.Errorf("echoed tags = %v", s.Tags)
+			}
+		},
+		ZeroConfigChecks: []testutils.StateCheck{
+			{
+				Name: "empty config keeps planned",
+				State: func() cc.State {
+					return &state{Site: types.StringValue("datadoghq.eu"), Tags: types.SetNull(types.StringType), Service: types.StringNull()}
+				},
+				Check: func(t *testing.T, st cc.State) {
+					s := st.(*state)
+					testutils.AssertEq(t, "planned site", s.Site.ValueString(), "datadoghq.eu")
+					if !s.Tags.IsNull() || !s.Service.IsNull() {
+						t.Errorf("unset tags/service must stay null, got %v / %v", s.Tags, s.Service)
+					}
+				},
+			},
+			{
+				Name:  "sensitive api key untouched",
+				State: func() cc.State { return &state{APIKey: types.StringValue("planned-key")} },
+				Check: func(t *testing.T, st cc.State) {
+					testutils.AssertEq(t, "planned api_key", st.(*state).APIKey.ValueString(), "planned-key")
+				},
+			},
+		},
+	})
+}
+
+var _ cc.State = &state{}
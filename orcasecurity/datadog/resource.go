@@ -0,0 +1,130 @@
+package datadog
+
+import (
+	"context"
+	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	cc "terraform-provider-orcasecurity/orcasecurity/config_integration_common"
+	common "terraform-provider-orcasecurity/orcasecurity/integrations_common"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// sites are the Datadog site domains Orca can deliver to, one per Datadog region.
+var sites = []string{
+	"datadoghq.com",
+	"us3.datadoghq.com",
+	"us5.datadoghq.com",
+	"datadoghq.eu",
+	"ap1.datadoghq.com",
+	"ap2.datadoghq.com",
+	"ddog-gov.com",
+}
+
+const defaultSite = "datadoghq.com"
+
+type state struct {
+	cc.CommonFields
+	Site            types.String `tfsdk:"site"`
+	APIKey          types.String `tfsdk:"api_key"`
+	APIKeyWO        types.String `tfsdk:"api_key_wo"`
+	APIKeyWOVersion types.Int64  `tfsdk:"api_key_wo_version"`
+	Tags            types.Set    `tfsdk:"tags"`
+	Service         types.String `tfsdk:"service"`
+	Source          types.String `tfsdk:"source"`
+}
+
+// buildPayload converts the planned state into the Datadog API payload.
+func buildPayload(ctx context.Context, st cc.State, diags *diag.Diagnostics) api_client.DatadogExternalServiceConfig {
+	s := st.(*state)
+	tags, d := common.StringSliceFromSet(ctx, s.Tags)
+	diags.Append(d...)
+	return api_client.DatadogExternalServiceConfig{
+		TemplateName: s.TemplateName.ValueString(),
+		IsEnabled:    s.IsEnabled.ValueBool(),
+		IsDefault:    s.IsDefault.ValueBool(),
+		Config: api_client.DatadogConfig{
+			Site:    s.Site.ValueString(),
+			APIKey:  s.APIKey.ValueString(),
+			Tags:    tags,
+			Service: s.Service.ValueString(),
+			Source:  s.Source.ValueString(),
+		},
+	}
+}
+
+// extract maps the API envelope back onto state. The API key is never returned; empty optional
+// fields never turn a null plan into "".
+func extract(ctx context.Context, o *api_client.DatadogExternalServiceConfig, st cc.State, diags *diag.Diagnostics) cc.APIObject {
+	s := st.(*state)
+	if o.Config.Site != "" {
+		s.Site = types.StringValue(o.Config.Site)
+	}
+	tags, d := common.OptionalSetMatchPlan(ctx, s.Tags, o.Config.Tags)
+	diags.Append(d...)
+	s.Tags = tags
+	if o.Config.Service != "" {
+		s.Service = types.StringValue(o.Config.Service)
+	}
+	if o.Config.Source != "" {
+		s.Source = types.StringValue(o.Config.Source)
+	}
+	return cc.APIObject{ID: o.ID, TemplateName: o.TemplateName, IsEnabled: o.IsEnabled, IsDefault: o.IsDefault}
+}
+
+func NewDatadogResource() resource.Resource {
+	return cc.New(cc.Spec[api_client.DatadogExternalServiceConfig]{
+		TypeNameSuffix: "_integration_datadog",
+		ServiceName:    api_client.DatadogServiceName,
+		UIName:         "Datadog integration",
+		Description:    "Manage a Datadog integration in Orca. Creates an external service config of `service_name = \"datadog\"` that automations send alerts to as Datadog events or logs. The API key is stored in Orca's secret store and is never returned by the API.",
+		VariantAttributes: map[string]schema.Attribute{
+			"site": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(defaultSite),
+				Description: "Datadog site (region) the account lives on, as its domain: `datadoghq.com` (US1), `us3.datadoghq.com`, `us5.datadoghq.com`, `datadoghq.eu` (EU1), `ap1.datadoghq.com`, `ap2.datadoghq.com` or `ddog-gov.com` (US1-FED). Defaults to `datadoghq.com`.",
+				Validators:  []validator.String{stringvalidator.OneOf(sites...)},
+			},
+			"api_key": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "Datadog API key. Stored in Orca's secret store; never returned by the API.",
+				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"tags": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Tags added to every event or log Orca sends, in Datadog `key:value` form (for example, `env:prod`).",
+			},
+			"service": schema.StringAttribute{
+				Optional:    true,
+				Description: "Value of the `service` attribute on logs Orca sends.",
+			},
+			"source": schema.StringAttribute{
+				Optional:    true,
+				Description: "Value of the `ddsource` attribute on logs Orca sends (for example, `orca`).",
+			},
+		},
+		WriteOnlySecrets: []string{"api_key"},
+		NewState:         func() cc.State { return &state{} },
+		BuildPayload:     buildPayload,
+		Extract:          extract,
+		Create:           (*api_client.APIClient).CreateDatadogConfig,
+		Get:              (*api_client.APIClient).GetDatadogConfig,
+		Update:           (*api_client.APIClient).UpdateDatadogConfig,
+		Delete:           (*api_client.APIClient).DeleteDatadogConfig,
+		List:             (*api_client.APIClient).ListDatadogConfigs,
+	})
+}
+
+// NewDatadogListResource lists the integrations managed by NewDatadogResource.
+func NewDatadogListResource() list.ListResource {
+	return cc.NewList(NewDatadogResource)
+}
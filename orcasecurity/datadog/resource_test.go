@@ -0,0 +1,18 @@
+package datadog
+
+import (
+	"terraform-provider-orcasecurity/orcasecurity/internal/testutils"
+	"testing"
+)
+
+// The API key is a secret with a write-only variant. Datadog uses the no-BU CommonFields
+// flavour, so business_units must be absent.
+func TestDatadogResource_SchemaContract(t *testing.T) {
+	testutils.CheckVariantResource(t, testutils.VariantResourceSpec{
+		NewResource:      NewDatadogResource,
+		TypeName:         "orcasecurity_integration_datadog",
+		WriteOnlySecrets: []string{"api_key"},
+		Forbidden:        []string{"business_units"},
+		State:            &state{},
+	})
+}
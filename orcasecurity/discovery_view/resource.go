@@ -5,6 +5,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	"terraform-provider-orcasecurity/orcasecurity/apierror_common"
+	"terraform-provider-orcasecurity/orcasecurity/identity_common"
+	"terraform-provider-orcasecurity/orcasecurity/timeouts_common"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -22,6 +25,7 @@ var (
 	_ resource.Resource                = &discoveryViewResource{}
 	_ resource.ResourceWithConfigure   = &discoveryViewResource{}
 	_ resource.ResourceWithImportState = &discoveryViewResource{}
+	_ resource.ResourceWithIdentity    = &discoveryViewResource{}
 )
 
 const errReadingDiscoveryView = "Error reading discovery view"
@@ -71,6 +75,8 @@ type discoveryViewResourceModel struct {
 	GroupBy2          []groupByEntryModel          `tfsdk:"group_by_2"`
 	OrganizationLevel types.Bool                   `tfsdk:"organization_level"`
 	ViewType          types.String                 `tfsdk:"view_type"`
+
+	Timeouts timeouts_common.Value `tfsdk:"timeouts"`
 }
 
 type groupByEntryModel struct {
@@ -370,7 +376,11 @@ func (r *discoveryViewResource) Configure(_ context.Context, req resource.Config
 }
 
 func (r *discoveryViewResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	identity_common.ImportID(ctx, req, resp)
+}
+
+func (r *discoveryViewResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity_common.IDSchema()
 }
 
 func (r *discoveryViewResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -487,6 +497,9 @@ func (r *discoveryViewResource) Schema(ctx context.Context, req resource.SchemaR
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts_common.Block(ctx),
+		},
 	}
 }
 
@@ -507,15 +520,15 @@ func (r *discoveryViewResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	ctx, cancel := timeouts_common.Create(ctx, plan.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
 	//Generate API request body from plan
 	queryString := plan.FilterData.Data.ValueString()
 	query := make(map[string]interface{})
 	err := json.Unmarshal([]byte(queryString), &query)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating discovery view",
-			"Could not create discovery view, unexpected error: "+err.Error(),
-		)
+		apierror_common.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, "Error creating discovery view", "Could not create discovery view", err)
 		return
 	}
 
@@ -527,19 +540,16 @@ func (r *discoveryViewResource) Create(ctx context.Context, req resource.CreateR
 		FilterData:        api_client.DiscoveryQuery{Data: query},
 	}
 
-	instance, err := r.apiClient.CreateDiscoveryView(createReq)
+	instance, err := r.apiClient.CreateDiscoveryView(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating discovery view",
-			"Could not create discovery view, unexpected error: "+err.Error(),
-		)
+		apierror_common.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, "Error creating discovery view", "Could not create discovery view", err)
 		return
 	}
 
 	//plan.OrganizationLevel = types.BoolValue(instance.OrganizationLevel)
 	//plan.ViewType = types.StringValue(instance.ViewType)
 
-	instance, err = r.apiClient.GetDiscoveryView(instance.ID)
+	instance, err = r.apiClient.GetDiscoveryView(ctx, instance.ID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error refreshing Discovery view",
@@ -556,6 +566,7 @@ func (r *discoveryViewResource) Create(ctx context.Context, req resource.CreateR
 	}
 
 	plan.ID = types.StringValue(instance.ID)
+	resp.Diagnostics.Append(identity_common.SetID(ctx, resp.Identity, plan.ID.ValueString())...)
 
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -565,6 +576,7 @@ func (r *discoveryViewResource) Create(ctx context.Context, req resource.CreateR
 }
 
 func (r *discoveryViewResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(identity_common.SetIDFromState(ctx, resp.Identity, req.State)...)
 	var state discoveryViewResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -572,7 +584,10 @@ func (r *discoveryViewResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
-	exists, err := r.apiClient.DoesDiscoveryViewExist(state.ID.ValueString())
+	ctx, cancel := timeouts_common.Read(ctx, state.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
+	exists, err := r.apiClient.DoesDiscoveryViewExist(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			errReadingDiscoveryView,
@@ -587,7 +602,7 @@ func (r *discoveryViewResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
-	instance, err := r.apiClient.GetDiscoveryView(state.ID.ValueString())
+	instance, err := r.apiClient.GetDiscoveryView(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			errReadingDiscoveryView,
@@ -689,6 +704,7 @@ func applyGroupByToState(ctx context.Context, state *discoveryViewResourceModel,
 }
 
 func (r *discoveryViewResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(identity_common.SetIDFromState(ctx, resp.Identity, req.State)...)
 	var plan discoveryViewResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -696,6 +712,9 @@ func (r *discoveryViewResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	ctx, cancel := timeouts_common.Update(ctx, plan.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
 	if plan.ID.String() == "" {
 		resp.Diagnostics.AddError(
 			"ID is null",
@@ -726,7 +745,7 @@ func (r *discoveryViewResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
-	instance, err2 := r.apiClient.UpdateDiscoveryView(updateReq)
+	instance, err2 := r.apiClient.UpdateDiscoveryView(ctx, updateReq)
 	if err2 != nil {
 		resp.Diagnostics.AddError(
 			"Error updating discovery view",
@@ -735,7 +754,7 @@ func (r *discoveryViewResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
-	instance, err2 = r.apiClient.GetDiscoveryView(plan.ID.ValueString())
+	instance, err2 = r.apiClient.GetDiscoveryView(ctx, plan.ID.ValueString())
 	if err2 != nil {
 		resp.Diagnostics.AddError(
 			"Error updating discovery view",
@@ -772,7 +791,10 @@ func (r *discoveryViewResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
-	err := r.apiClient.DeleteDiscoveryView(state.ID.String()[1 : len(state.ID.String())-1])
+	ctx, cancel := timeouts_common.Delete(ctx, state.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
+	err := r.apiClient.DeleteDiscoveryView(ctx, state.ID.String()[1:len(state.ID.String())-1])
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting discovery view",
@@ -4,11 +4,13 @@ import (
 	"context"
 	"fmt"
 	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	"terraform-provider-orcasecurity/orcasecurity/apierror_common"
+	"terraform-provider-orcasecurity/orcasecurity/identity_common"
 	"terraform-provider-orcasecurity/orcasecurity/tfconv"
+	"terraform-provider-orcasecurity/orcasecurity/timeouts_common"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -23,6 +25,7 @@ var (
 	_ resource.Resource                = &dspmPolicyResource{}
 	_ resource.ResourceWithConfigure   = &dspmPolicyResource{}
 	_ resource.ResourceWithImportState = &dspmPolicyResource{}
+	_ resource.ResourceWithIdentity    = &dspmPolicyResource{}
 )
 
 type dspmPolicyResource struct {
@@ -50,6 +53,8 @@ type stateModel struct {
 	Tags            types.List     `tfsdk:"tags"`
 	Document        *documentModel `tfsdk:"document"`
 	IsDefaultPolicy types.Bool     `tfsdk:"is_default_policy"`
+
+	Timeouts timeouts_common.Value `tfsdk:"timeouts"`
 }
 
 func NewDspmPolicyResource() resource.Resource {
@@ -68,10 +73,14 @@ func (r *dspmPolicyResource) Configure(_ context.Context, req resource.Configure
 }
 
 func (r *dspmPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	identity_common.ImportID(ctx, req, resp)
+}
+
+func (r *dspmPolicyResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity_common.IDSchema()
 }
 
-func (r *dspmPolicyResource) Schema(_ context.Context, req resource.SchemaRequest, res *resource.SchemaResponse) {
+func (r *dspmPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, res *resource.SchemaResponse) {
 	res.Schema = schema.Schema{
 		Description: "Provides a DSPM data protection policy. A policy selects which sensitive data identifiers apply and in which context. Orca-managed default policies (`is_default_policy = true`) cannot be updated or deleted; importing one makes every change fail with a 400 error. The server also rejects a policy whose `document` matches an existing policy in the organization — even under a different name — with `Policy with the same document already exists`.",
 		Attributes: map[string]schema.Attribute{
@@ -167,6 +176,9 @@ func (r *dspmPolicyResource) Schema(_ context.Context, req resource.SchemaReques
 				Computed:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts_common.Block(ctx),
+		},
 	}
 }
 
@@ -198,16 +210,17 @@ func (r *dspmPolicyResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	instance, err := r.apiClient.CreateDSPMPolicy(generatePolicyPayload(ctx, plan))
+	ctx, cancel := timeouts_common.Create(ctx, plan.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
+	instance, err := r.apiClient.CreateDSPMPolicy(ctx, generatePolicyPayload(ctx, plan))
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating DSPM Policy",
-			"Could not create DSPM Policy, unexpected error: "+err.Error(),
-		)
+		apierror_common.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, "Error creating DSPM Policy", "Could not create DSPM Policy", err)
 		return
 	}
 
 	plan.ID = types.StringValue(instance.ID)
+	resp.Diagnostics.Append(identity_common.SetID(ctx, resp.Identity, plan.ID.ValueString())...)
 	plan.OrganizationID = types.StringValue(instance.OrganizationID)
 	plan.IsDefaultPolicy = types.BoolValue(instance.IsDefaultPolicy)
 
@@ -216,6 +229,7 @@ func (r *dspmPolicyResource) Create(ctx context.Context, req resource.CreateRequ
 }
 
 func (r *dspmPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(identity_common.SetIDFromState(ctx, resp.Identity, req.State)...)
 	var state stateModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -223,7 +237,10 @@ func (r *dspmPolicyResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	instance, err := r.apiClient.GetDSPMPolicy(state.ID.ValueString())
+	ctx, cancel := timeouts_common.Read(ctx, state.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
+	instance, err := r.apiClient.GetDSPMPolicy(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading DSPM Policy",
@@ -281,6 +298,7 @@ func (r *dspmPolicyResource) Read(ctx context.Context, req resource.ReadRequest,
 }
 
 func (r *dspmPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(identity_common.SetIDFromState(ctx, resp.Identity, req.State)...)
 	var plan stateModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -288,6 +306,9 @@ func (r *dspmPolicyResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	ctx, cancel := timeouts_common.Update(ctx, plan.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
 	if plan.ID.ValueString() == "" {
 		resp.Diagnostics.AddError(
 			"ID is null",
@@ -296,12 +317,9 @@ func (r *dspmPolicyResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
-	instance, err := r.apiClient.UpdateDSPMPolicy(plan.ID.ValueString(), generatePolicyPayload(ctx, plan))
+	instance, err := r.apiClient.UpdateDSPMPolicy(ctx, plan.ID.ValueString(), generatePolicyPayload(ctx, plan))
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating DSPM Policy",
-			"Could not update DSPM Policy, unexpected error: "+err.Error(),
-		)
+		apierror_common.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, "Error updating DSPM Policy", "Could not update DSPM Policy", err)
 		return
 	}
 
@@ -321,7 +339,10 @@ func (r *dspmPolicyResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
-	err := r.apiClient.DeleteDSPMPolicy(state.ID.ValueString())
+	ctx, cancel := timeouts_common.Delete(ctx, state.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
+	err := r.apiClient.DeleteDSPMPolicy(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting DSPM Policy",
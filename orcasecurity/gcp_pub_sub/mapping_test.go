@@ -0,0 +1,60 @@
+package gcp_pub_sub
+
+import (
+	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	cc "terraform-provider-orcasecurity/orcasecurity/config_integration_common"
+	"terraform-provider-orcasecurity/orcasecurity/internal/testutils"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The shared suite covers the envelope plumbing; the closures below describe the Pub/Sub
+// config fields: the service account key is write-only and the API round-trips project and
+// topic.
+func TestMapping(t *testing.T) {
+	testutils.RunMappingSuite(t, testutils.MappingSuite[api_client.GcpPubSubConfig]{
+		BuildPayload: buildPayload,
+		Extract:      extract,
+		TemplateName: "tf-acc-test-gcp-pub-sub",
+		FilledState: func() cc.State {
+			return &state{
+				ProjectID:          types.StringValue("orca-project"),
+				TopicID:            types.StringValue("orca-alerts"),
+				ServiceAccountJSON: types.StringValue(`{"type":"service_account"}`),
+			}
+		},
+		CheckConfig: func(t *testing.T, c api_client.GcpPubSubConfig) {
+			testutils.AssertEq(t, "project_id", c.ProjectID, "orca-project")
+			testutils.AssertEq(t, "topic_id", c.TopicID, "orca-alerts")
+			testutils.AssertEq(t, "service_account_json", c.ServiceAccountJSON, `{"type":"service_account"}`)
+		},
+		EchoConfig: api_client.GcpPubSubConfig{ProjectID: "returned-project", TopicID: "returned-topic"},
+		EchoState: func() cc.State {
+			return &state{ProjectID: types.StringValue("planned"), TopicID: types.StringValue("planned")}
+		},
+		CheckEchoed: func(t *testing.T, st cc.State) {
+			s := st.(*state)
+			testutils.AssertEq(t, "echoed project_id", s.ProjectID.ValueString(), "returned-project")
+			testutils.AssertEq(t, "echoed topic_id", s.TopicID.ValueString(), "returned-topic")
+		},
+		ZeroConfigChecks: []testutils.StateCheck{
+			{
+				Name: "empty config keeps planned and secrets untouched",
+				State: func() cc.State {
+					return &state{
+						ProjectID:          types.StringValue("planned"),
+						ServiceAccountJSON: types.StringValue("planned-key"),
+					}
+				},
+				Check: func(t *testing.T, st cc.State) {
+					s := st.(*state)
+					testutils.AssertEq(t, "planned project_id", s.ProjectID.ValueString(), "planned")
+					testutils.AssertEq(t, "planned service_account_json", s.ServiceAccountJSON.ValueString(), "planned-key")
+				},
+			},
+		},
+	})
+}
+
+var _ cc.State = &state{}
@@ -0,0 +1,93 @@
+package gcp_pub_sub
+
+import (
+	"context"
+	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	cc "terraform-provider-orcasecurity/orcasecurity/config_integration_common"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type state struct {
+	cc.CommonFields
+	ProjectID                   types.String `tfsdk:"project_id"`
+	TopicID                     types.String `tfsdk:"topic_id"`
+	ServiceAccountJSON          types.String `tfsdk:"service_account_json"`
+	ServiceAccountJSONWO        types.String `tfsdk:"service_account_json_wo"`
+	ServiceAccountJSONWOVersion types.Int64  `tfsdk:"service_account_json_wo_version"`
+}
+
+// buildPayload converts the planned state into the GCP Pub/Sub API payload.
+func buildPayload(_ context.Context, st cc.State, _ *diag.Diagnostics) api_client.GcpPubSubExternalServiceConfig {
+	s := st.(*state)
+	return api_client.GcpPubSubExternalServiceConfig{
+		TemplateName: s.TemplateName.ValueString(),
+		IsEnabled:    s.IsEnabled.ValueBool(),
+		IsDefault:    s.IsDefault.ValueBool(),
+		Config: api_client.GcpPubSubConfig{
+			ProjectID:          s.ProjectID.ValueString(),
+			TopicID:            s.TopicID.ValueString(),
+			ServiceAccountJSON: s.ServiceAccountJSON.ValueString(),
+		},
+	}
+}
+
+// extract maps the API envelope back onto state; the service account key is never returned and
+// empty fields never clobber the plan.
+func extract(_ context.Context, o *api_client.GcpPubSubExternalServiceConfig, st cc.State, _ *diag.Diagnostics) cc.APIObject {
+	s := st.(*state)
+	if o.Config.ProjectID != "" {
+		s.ProjectID = types.StringValue(o.Config.ProjectID)
+	}
+	if o.Config.TopicID != "" {
+		s.TopicID = types.StringValue(o.Config.TopicID)
+	}
+	return cc.APIObject{ID: o.ID, TemplateName: o.TemplateName, IsEnabled: o.IsEnabled, IsDefault: o.IsDefault}
+}
+
+func NewGcpPubSubResource() resource.Resource {
+	return cc.New(cc.Spec[api_client.GcpPubSubExternalServiceConfig]{
+		TypeNameSuffix: "_integration_gcp_pub_sub",
+		ServiceName:    api_client.GcpPubSubServiceName,
+		UIName:         "GCP Pub/Sub integration",
+		Description:    "Manage a GCP Pub/Sub integration in Orca. Creates an external service config of `service_name = \"gcp_pub_sub\"` that publishes alerts to a Pub/Sub topic. The service account key is stored in Orca's secret store and is never returned by the API.",
+		VariantAttributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the Google Cloud project that owns the topic.",
+				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"topic_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the Pub/Sub topic Orca publishes alerts to (the last segment of `projects/<project>/topics/<topic>`).",
+				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"service_account_json": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "JSON key of a service account with `roles/pubsub.publisher` on the topic. Stored in Orca's secret store; never returned by the API.",
+				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+		},
+		WriteOnlySecrets: []string{"service_account_json"},
+		NewState:         func() cc.State { return &state{} },
+		BuildPayload:     buildPayload,
+		Extract:          extract,
+		Create:           (*api_client.APIClient).CreateGcpPubSubConfig,
+		Get:              (*api_client.APIClient).GetGcpPubSubConfig,
+		Update:           (*api_client.APIClient).UpdateGcpPubSubConfig,
+		Delete:           (*api_client.APIClient).DeleteGcpPubSubConfig,
+		List:             (*api_client.APIClient).ListGcpPubSubConfigs,
+	})
+}
+
+// NewGcpPubSubListResource lists the integrations managed by NewGcpPubSubResource.
+func NewGcpPubSubListResource() list.ListResource {
+	return cc.NewList(NewGcpPubSubResource)
+}
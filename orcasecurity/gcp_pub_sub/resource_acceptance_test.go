@@ -0,0 +1,25 @@
+package gcp_pub_sub_test
+
+import (
+	"terraform-provider-orcasecurity/orcasecurity/internal/acctest"
+	"testing"
+)
+
+func TestAccGcpPubSubResource(t *testing.T) {
+	acctest.RunIntegrationTest(t, gcpPubSubSpec)
+}
+
+func TestGcpPubSubResource_Offline(t *testing.T) {
+	acctest.RunIntegrationTestOffline(t, gcpPubSubSpec)
+}
+
+var gcpPubSubSpec = acctest.IntegrationSpec{
+	ResourceType: "orcasecurity_integration_gcp_pub_sub",
+	TemplateName: "tf-acc-test-gcp-pub-sub",
+	Attributes: map[string]string{
+		"project_id":           `"tf-acc-test-project"`,
+		"service_account_json": `jsonencode({ type = "service_account", project_id = "tf-acc-test-project" })`,
+		"topic_id":             `"tf-acc-test-topic"`,
+	},
+	Secrets: []string{"service_account_json"},
+}
@@ -0,0 +1,20 @@
+package gcp_pub_sub
+
+import (
+	"terraform-provider-orcasecurity/orcasecurity/internal/testutils"
+	"testing"
+)
+
+// The service account key is a secret with a write-only variant; project and topic are
+// required but not sensitive. The variant uses the no-BU CommonFields flavour, so
+// business_units must be absent.
+func TestGcpPubSubResource_SchemaContract(t *testing.T) {
+	testutils.CheckVariantResource(t, testutils.VariantResourceSpec{
+		NewResource:      NewGcpPubSubResource,
+		TypeName:         "orcasecurity_integration_gcp_pub_sub",
+		WriteOnlySecrets: []string{"service_account_json"},
+		PlainRequired:    []string{"project_id", "topic_id"},
+		Forbidden:        []string{"business_units"},
+		State:            &state{},
+	})
+}
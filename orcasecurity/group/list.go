@@ -0,0 +1,60 @@
+package group
+
+import (
+	"context"
+
+	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	"terraform-provider-orcasecurity/orcasecurity/identity_common"
+	"terraform-provider-orcasecurity/orcasecurity/list_common"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type listFilterModel struct {
+	NameContains types.String `tfsdk:"name_contains"`
+	SSOGroup     types.Bool   `tfsdk:"sso_group"`
+}
+
+// NewGroupListResource lists the organization's RBAC groups.
+func NewGroupListResource() list.ListResource {
+	return list_common.New(list_common.Spec{
+		NewResource: NewGroupResource,
+		Description: "Lists groups, optionally filtered by name and SSO origin.",
+		Attributes: map[string]schema.Attribute{
+			"name_contains": list_common.NameContainsAttribute("groups"),
+			"sso_group": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Only list SSO-provisioned (`true`) or local (`false`) groups.",
+			},
+		},
+		Fetch: listGroups,
+	})
+}
+
+func listGroups(ctx context.Context, client *api_client.APIClient, config tfsdk.Config) ([]list_common.Item, diag.Diagnostics) {
+	var filter listFilterModel
+	diags := config.Get(ctx, &filter)
+	if diags.HasError() {
+		return nil, diags
+	}
+	groups, err := client.ListGroups(ctx)
+	if err != nil {
+		diags.AddError("Error listing groups", "Could not list groups: "+err.Error())
+		return nil, diags
+	}
+	var items []list_common.Item
+	for _, g := range groups {
+		if !list_common.Contains(g.Name, filter.NameContains) || !list_common.BoolIs(g.SSOGroup, filter.SSOGroup) {
+			continue
+		}
+		items = append(items, list_common.Item{
+			Identity:    map[string]string{identity_common.IDAttribute: g.ID},
+			DisplayName: g.Name,
+		})
+	}
+	return items, diags
+}
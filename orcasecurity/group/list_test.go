@@ -0,0 +1,50 @@
+package group
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"terraform-provider-orcasecurity/orcasecurity/internal/testutils"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestList_Filters(t *testing.T) {
+	client := testutils.NewStubAPIClient(func(req *http.Request) *http.Response {
+		if req.URL.Path != "/api/rbac/group" {
+			t.Errorf("unexpected request %s", req.URL)
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body: io.NopCloser(strings.NewReader(`{"data":[
+				{"id":"g1","name":"Platform Admins","sso_group":true},
+				{"id":"g2","name":"platform readers","sso_group":false},
+				{"id":"g3","name":"Security","sso_group":false}
+			]}`)),
+		}
+	})
+	cases := []struct {
+		name    string
+		filters map[string]tftypes.Value
+		want    []string
+	}{
+		{"no filters", nil, []string{"g1", "g2", "g3"}},
+		{"name", map[string]tftypes.Value{"name_contains": tftypes.NewValue(tftypes.String, "PLATFORM")}, []string{"g1", "g2"}},
+		{"sso", map[string]tftypes.Value{"sso_group": tftypes.NewValue(tftypes.Bool, false)}, []string{"g2", "g3"}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			results := testutils.RunList(t, NewGroupListResource(), NewGroupResource, client, testutils.ListQuery{Filters: tc.filters})
+			if len(results) != len(tc.want) {
+				t.Fatalf("got %d results, want %d", len(results), len(tc.want))
+			}
+			for i, r := range results {
+				if got := testutils.IdentityString(t, r, "id"); got != tc.want[i] {
+					t.Errorf("result %d id = %q, want %q", i, got, tc.want[i])
+				}
+			}
+		})
+	}
+}
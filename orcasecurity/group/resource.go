@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	"terraform-provider-orcasecurity/orcasecurity/apierror_common"
+	"terraform-provider-orcasecurity/orcasecurity/identity_common"
+	"terraform-provider-orcasecurity/orcasecurity/timeouts_common"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -22,6 +24,7 @@ var (
 	_ resource.Resource                = &groupResource{}
 	_ resource.ResourceWithConfigure   = &groupResource{}
 	_ resource.ResourceWithImportState = &groupResource{}
+	_ resource.ResourceWithIdentity    = &groupResource{}
 )
 
 type groupResource struct {
@@ -34,6 +37,8 @@ type groupResourceModel struct {
 	Description types.String `tfsdk:"description"`
 	SSOGroup    types.Bool   `tfsdk:"sso_group"`
 	Users       types.Set    `tfsdk:"users"`
+
+	Timeouts timeouts_common.Value `tfsdk:"timeouts"`
 }
 
 func NewGroupResource() resource.Resource {
@@ -52,7 +57,11 @@ func (r *groupResource) Configure(_ context.Context, req resource.ConfigureReque
 }
 
 func (r *groupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	identity_common.ImportID(ctx, req, resp)
+}
+
+func (r *groupResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity_common.IDSchema()
 }
 
 func (r *groupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -92,6 +101,9 @@ func (r *groupResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Optional:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts_common.Block(ctx),
+		},
 	}
 }
 
@@ -103,6 +115,9 @@ func (r *groupResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	ctx, cancel := timeouts_common.Create(ctx, plan.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
 	users := userIDsFromSet(plan.Users)
 
 	createReq := api_client.Group{
@@ -111,18 +126,16 @@ func (r *groupResource) Create(ctx context.Context, req resource.CreateRequest,
 		Description: plan.Description.ValueString(),
 	}
 
-	instance, err := r.apiClient.CreateGroup(createReq)
+	instance, err := r.apiClient.CreateGroup(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating group",
-			"Could not create group, unexpected error: "+err.Error(),
-		)
+		apierror_common.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, "Error creating group", "Could not create group", err)
 		return
 	}
 	plan.ID = types.StringValue(instance.ID)
+	resp.Diagnostics.Append(identity_common.SetID(ctx, resp.Identity, plan.ID.ValueString())...)
 
 	// Create API ignores users; set via AddGroupUsers.
-	if err := r.apiClient.AddGroupUsers(instance.ID, users); err != nil {
+	if err := r.apiClient.AddGroupUsers(ctx, instance.ID, users); err != nil {
 		// The group already exists remotely even though membership failed to apply.
 		// Persist the ID and known fields so Terraform keeps tracking it instead of
 		// leaking it — the next apply would otherwise 400 on the name, which is
@@ -137,7 +150,7 @@ func (r *groupResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	instance, err = r.apiClient.GetGroup(instance.ID)
+	instance, err = r.apiClient.GetGroup(ctx, instance.ID)
 	if err != nil {
 		// Create and AddGroupUsers both already succeeded remotely; persist what we
 		// know rather than leaking the group over a refresh failure.
@@ -200,6 +213,7 @@ func setGroupStateFromAPI(ctx context.Context, m *groupResourceModel, instance *
 }
 
 func (r *groupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(identity_common.SetIDFromState(ctx, resp.Identity, req.State)...)
 	var state groupResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -207,7 +221,10 @@ func (r *groupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	exists, err := r.apiClient.DoesGroupExist(state.ID.ValueString())
+	ctx, cancel := timeouts_common.Read(ctx, state.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
+	exists, err := r.apiClient.DoesGroupExist(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading group",
@@ -222,7 +239,7 @@ func (r *groupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	instance, err := r.apiClient.GetGroup(state.ID.ValueString())
+	instance, err := r.apiClient.GetGroup(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading group",
@@ -247,6 +264,7 @@ func (r *groupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 }
 
 func (r *groupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(identity_common.SetIDFromState(ctx, resp.Identity, req.State)...)
 	var plan groupResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -254,6 +272,9 @@ func (r *groupResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	ctx, cancel := timeouts_common.Update(ctx, plan.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
 	var prior groupResourceModel
 	diags = req.State.Get(ctx, &prior)
 	resp.Diagnostics.Append(diags...)
@@ -268,11 +289,8 @@ func (r *groupResource) Update(ctx context.Context, req resource.UpdateRequest,
 		Description: plan.Description.ValueString(),
 	}
 
-	if _, err := r.apiClient.UpdateGroup(updateReq); err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating group",
-			"Could not update group, unexpected error: "+err.Error(),
-		)
+	if _, err := r.apiClient.UpdateGroup(ctx, updateReq); err != nil {
+		apierror_common.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, "Error updating group", "Could not update group", err)
 		return
 	}
 
@@ -282,7 +300,7 @@ func (r *groupResource) Update(ctx context.Context, req resource.UpdateRequest,
 	priorUsers := userIDsFromSet(prior.Users)
 	toAdd, toRemove := diffUserIDs(priorUsers, userIDsFromSet(plan.Users))
 
-	if err := r.apiClient.AddGroupUsers(plan.ID.ValueString(), toAdd); err != nil {
+	if err := r.apiClient.AddGroupUsers(ctx, plan.ID.ValueString(), toAdd); err != nil {
 		// Name/description already changed remotely; membership did not. Persist the
 		// unchanged membership alongside the new name/description instead of losing them.
 		plan.SSOGroup = types.BoolValue(updateReq.SSOGroup)
@@ -295,7 +313,7 @@ func (r *groupResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	if err := r.apiClient.RemoveGroupUsers(plan.ID.ValueString(), toRemove); err != nil {
+	if err := r.apiClient.RemoveGroupUsers(ctx, plan.ID.ValueString(), toRemove); err != nil {
 		// Additions above already landed; removals did not. Persist that partial
 		// membership instead of the fully-planned set, which would overstate what
 		// actually changed.
@@ -311,7 +329,7 @@ func (r *groupResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	instance, err := r.apiClient.GetGroup(plan.ID.ValueString())
+	instance, err := r.apiClient.GetGroup(ctx, plan.ID.ValueString())
 	if err != nil {
 		// Name/description and membership all already changed remotely; persist them
 		// instead of losing track over a refresh failure.
@@ -369,7 +387,10 @@ func (r *groupResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	err := r.apiClient.DeleteGroup(state.ID.String()[1 : len(state.ID.String())-1])
+	ctx, cancel := timeouts_common.Delete(ctx, state.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
+	err := r.apiClient.DeleteGroup(ctx, state.ID.String()[1:len(state.ID.String())-1])
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting group",
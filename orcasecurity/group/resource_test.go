@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"terraform-provider-orcasecurity/orcasecurity"
+	"terraform-provider-orcasecurity/orcasecurity/internal/acctest"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
@@ -24,7 +25,17 @@ func requireUserID(t *testing.T, envVar string) string {
 }
 
 func TestAccGroupResource_Basic(t *testing.T) {
-	resource.Test(t, resource.TestCase{
+	resource.Test(t, groupBasicTestCase())
+}
+
+// TestGroupResource_BasicOffline runs the basic lifecycle against the in-memory fake API.
+func TestGroupResource_BasicOffline(t *testing.T) {
+	acctest.Offline(t)
+	resource.UnitTest(t, groupBasicTestCase())
+}
+
+func groupBasicTestCase() resource.TestCase {
+	return resource.TestCase{
 		ProtoV6ProviderFactories: orcasecurity.TestAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			// create
@@ -66,7 +77,7 @@ resource "orcasecurity_group" "tf-group-1" {
 				),
 			},
 		},
-	})
+	}
 }
 
 // TestAccGroupResource_UpdateUsers covers replacing a group's membership. It needs two user IDs
@@ -0,0 +1,116 @@
+package group_access
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"terraform-provider-orcasecurity/orcasecurity/internal/testutils"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+const testImportAccessList = `{"total_items":3,"data":[
+	{"id":"asg-1","all_cloud_accounts":true,"group":{"id":"g1"},"role":{"id":"r1"}},
+	{"id":"asg-2","all_cloud_accounts":true,"group":{"id":"g1"},"role":{"id":"r2"}},
+	{"id":"asg-3","all_cloud_accounts":false,"group":{"id":"g1"},"role":{"id":"r2"},"cloud_accounts":[{"id":"ca1"}]}
+]}`
+
+// importState runs ImportState with the given import ID, or with an {id} identity when
+// identityID is set, and returns the imported assignment id. The framework copies an imported
+// identity into the response itself, so only the group/role path writes resp.Identity.
+func importState(t *testing.T, importID, identityID string) (string, *resource.ImportStateResponse) {
+	t.Helper()
+	ctx := context.Background()
+	r := &groupAccessResource{apiClient: testutils.NewStubAPIClient(func(*http.Request) *http.Response {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(testImportAccessList)),
+		}
+	})}
+
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	identityResp := resource.IdentitySchemaResponse{}
+	r.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identityResp)
+
+	identityType := identityResp.IdentitySchema.Type().TerraformType(ctx)
+	newIdentity := func(id string) *tfsdk.ResourceIdentity {
+		raw := tftypes.NewValue(identityType, nil)
+		if id != "" {
+			raw = tftypes.NewValue(identityType, map[string]tftypes.Value{"id": tftypes.NewValue(tftypes.String, id)})
+		}
+		return &tfsdk.ResourceIdentity{Schema: identityResp.IdentitySchema, Raw: raw}
+	}
+	req := resource.ImportStateRequest{ID: importID}
+	if identityID != "" {
+		req.Identity = newIdentity(identityID)
+	}
+	resp := &resource.ImportStateResponse{
+		State:    tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)},
+		Identity: newIdentity(""),
+	}
+	r.ImportState(ctx, req, resp)
+
+	var id types.String
+	if !resp.Diagnostics.HasError() {
+		resp.State.GetAttribute(ctx, path.Root("id"), &id)
+	}
+	return id.ValueString(), resp
+}
+
+func TestImportState_AssignmentID(t *testing.T) {
+	for _, tc := range []struct{ name, importID, identityID string }{
+		{"import id", "asg-3", ""},
+		{"identity", "", "asg-3"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			id, resp := importState(t, tc.importID, tc.identityID)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if id != "asg-3" {
+				t.Errorf("id = %q, want asg-3", id)
+			}
+		})
+	}
+}
+
+func TestImportState_GroupAndRoleResolveAssignment(t *testing.T) {
+	id, resp := importState(t, "g1/r1", "")
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if id != "asg-1" {
+		t.Errorf("id = %q, want asg-1", id)
+	}
+	var identity types.String
+	resp.Identity.GetAttribute(context.Background(), path.Root("id"), &identity)
+	if identity.ValueString() != "asg-1" {
+		t.Errorf("identity id = %s, want asg-1", identity)
+	}
+}
+
+func TestImportState_GroupAndRoleErrors(t *testing.T) {
+	for _, tc := range []struct{ name, roleID, want string }{
+		{"not found", "r9", "No assignment of role r9"},
+		{"ambiguous", "r2", "asg-2, asg-3"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, resp := importState(t, "g1/"+tc.roleID, "")
+			if !resp.Diagnostics.HasError() {
+				t.Fatal("expected an error")
+			}
+			if detail := resp.Diagnostics[0].Detail(); !strings.Contains(detail, tc.want) {
+				t.Errorf("detail %q does not mention %q", detail, tc.want)
+			}
+		})
+	}
+}
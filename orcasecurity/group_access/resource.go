@@ -3,8 +3,12 @@ package group_access
 import (
 	"context"
 	"fmt"
+	"strings"
 	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	"terraform-provider-orcasecurity/orcasecurity/apierror_common"
+	"terraform-provider-orcasecurity/orcasecurity/identity_common"
 	common "terraform-provider-orcasecurity/orcasecurity/integrations_common"
+	"terraform-provider-orcasecurity/orcasecurity/timeouts_common"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -19,6 +23,7 @@ var (
 	_ resource.Resource                = &groupAccessResource{}
 	_ resource.ResourceWithConfigure   = &groupAccessResource{}
 	_ resource.ResourceWithImportState = &groupAccessResource{}
+	_ resource.ResourceWithIdentity    = &groupAccessResource{}
 )
 
 type groupAccessResource struct {
@@ -33,6 +38,8 @@ type groupAccessResourceModel struct {
 	CloudAccounts     types.List   `tfsdk:"cloud_accounts"`
 	ShiftleftProjects types.List   `tfsdk:"shiftleft_projects"`
 	UserFilters       types.List   `tfsdk:"user_filters"`
+
+	Timeouts timeouts_common.Value `tfsdk:"timeouts"`
 }
 
 func NewGroupAccessResource() resource.Resource {
@@ -41,6 +48,9 @@ func NewGroupAccessResource() resource.Resource {
 
 func (r *groupAccessResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_group_access"
+	// Read falls back to matching by role and scope when Orca has reissued the assignment id,
+	// so the identity can change on refresh.
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 func (r *groupAccessResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -50,11 +60,49 @@ func (r *groupAccessResource) Configure(_ context.Context, req resource.Configur
 	r.apiClient = req.ProviderData.(*api_client.APIClient)
 }
 
+// IdentitySchema addresses an assignment by its id. The group and role do not identify an
+// assignment on their own: Orca lets the same role be assigned several times with different scopes.
+func (r *groupAccessResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity_common.IDSchema()
+}
+
+// ImportState accepts the assignment id, as the import ID or the identity. As a convenience the
+// import ID may instead be <group_id>/<role_id>, which must resolve to exactly one assignment.
 func (r *groupAccessResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	ownerID, roleID, ok := strings.Cut(req.ID, "/")
+	if !ok {
+		identity_common.ImportID(ctx, req, resp)
+		return
+	}
+	assignments, err := r.apiClient.ListGroupAccessForGroup(ctx, ownerID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing group access", err.Error())
+		return
+	}
+	var ids []string
+	for _, a := range assignments {
+		if a.RoleID == roleID {
+			ids = append(ids, a.ID)
+		}
+	}
+	if len(ids) == 0 {
+		resp.Diagnostics.AddError("Error importing group access",
+			fmt.Sprintf("No assignment of role %s to group %s found.", roleID, ownerID))
+		return
+	}
+	if len(ids) > 1 {
+		resp.Diagnostics.AddError("Error importing group access",
+			fmt.Sprintf("Role %s is assigned to group %s %d times with different scopes; import by assignment id instead (one of %s).",
+				roleID, ownerID, len(ids), strings.Join(ids, ", ")))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), ids[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_id"), ownerID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role_id"), roleID)...)
+	resp.Diagnostics.Append(identity_common.SetID(ctx, resp.Identity, ids[0])...)
 }
 
-func (r *groupAccessResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *groupAccessResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Assigns an RBAC role to a group with optional scope: all cloud accounts, specific cloud accounts, Shift Left projects, or user filters (business unit IDs from /api/filters). Backed by POST /api/rbac/access/group.",
 		Attributes: map[string]schema.Attribute{
@@ -99,6 +147,9 @@ func (r *groupAccessResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Optional:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts_common.Block(ctx),
+		},
 	}
 }
 
@@ -144,6 +195,7 @@ func (r *groupAccessResource) apiToModel(ctx context.Context, ga *api_client.Gro
 		CloudAccounts:     cloudList,
 		ShiftleftProjects: shiftList,
 		UserFilters:       filterList,
+		Timeouts:          ref.Timeouts,
 	}, diags
 }
 
@@ -179,15 +231,18 @@ func (r *groupAccessResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	ctx, cancel := timeouts_common.Create(ctx, plan.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
 	payload, diags := r.modelToAPI(ctx, plan, "")
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	created, err := r.apiClient.CreateGroupAccess(payload)
+	created, err := r.apiClient.CreateGroupAccess(ctx, payload)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating group access", err.Error())
+		apierror_common.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, "Error creating group access", "Could not create group access", err)
 		return
 	}
 
@@ -196,7 +251,7 @@ func (r *groupAccessResource) Create(ctx context.Context, req resource.CreateReq
 	var refreshed *api_client.GroupAccess
 	if !resp.Diagnostics.HasError() {
 		var err error
-		refreshed, err = r.apiClient.FindGroupAccess(created.ID, matchWant)
+		refreshed, err = r.apiClient.FindGroupAccess(ctx, created.ID, matchWant)
 		if err != nil {
 			resp.Diagnostics.AddWarning("Could not read group access after create", err.Error())
 		}
@@ -209,22 +264,27 @@ func (r *groupAccessResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(identity_common.SetIDFromState(ctx, resp.Identity, resp.State)...)
 }
 
 func (r *groupAccessResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(identity_common.SetIDFromState(ctx, resp.Identity, req.State)...)
 	var prior groupAccessResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := timeouts_common.Read(ctx, prior.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
 	want, diags := r.modelToAPI(ctx, prior, prior.ID.ValueString())
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	ga, err := r.apiClient.FindGroupAccess(prior.ID.ValueString(), want)
+	ga, err := r.apiClient.FindGroupAccess(ctx, prior.ID.ValueString(), want)
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading group access", fmt.Sprintf("Could not read id %s: %s", prior.ID.ValueString(), err.Error()))
 		return
@@ -240,29 +300,35 @@ func (r *groupAccessResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+	resp.Diagnostics.Append(identity_common.SetIDFromState(ctx, resp.Identity, resp.State)...)
 }
 
 func (r *groupAccessResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(identity_common.SetIDFromState(ctx, resp.Identity, req.State)...)
 	var plan groupAccessResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
 	var prior groupAccessResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := timeouts_common.Update(ctx, plan.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
 	payload, diags := r.modelToAPI(ctx, plan, prior.ID.ValueString())
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	updated, err := r.apiClient.UpdateGroupAccess(payload)
+	updated, err := r.apiClient.UpdateGroupAccess(ctx, payload)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating group access", err.Error())
+		apierror_common.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, "Error updating group access", "Could not update group access", err)
 		return
 	}
 
@@ -272,6 +338,7 @@ func (r *groupAccessResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(identity_common.SetIDFromState(ctx, resp.Identity, resp.State)...)
 }
 
 func (r *groupAccessResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -281,7 +348,10 @@ func (r *groupAccessResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
-	if err := r.apiClient.DeleteGroupAccess(state.ID.ValueString()); err != nil {
+	ctx, cancel := timeouts_common.Delete(ctx, state.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
+	if err := r.apiClient.DeleteGroupAccess(ctx, state.ID.ValueString()); err != nil {
 		resp.Diagnostics.AddError("Error deleting group access", err.Error())
 	}
 }
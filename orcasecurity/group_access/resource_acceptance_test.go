@@ -1,6 +1,7 @@
 package group_access_test
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"testing"
@@ -33,7 +34,7 @@ func testAccCheckGroupAccessDestroyed(t *testing.T) resource.TestCheckFunc {
 		}
 		id := rs.Primary.ID
 		groupID := rs.Primary.Attributes["group_id"]
-		list, err := testAccGroupAccessClient(t).ListGroupAccessForGroup(groupID)
+		list, err := testAccGroupAccessClient(t).ListGroupAccessForGroup(context.Background(), groupID)
 		if err != nil {
 			return fmt.Errorf("verifying destroy: %s", err)
 		}
@@ -0,0 +1,113 @@
+// Package identity_common holds the resource identity plumbing shared by resources that declare
+// an identity schema. Identity is what `import { identity = {...} }` blocks and list resources
+// (`terraform query`) address a remote object by, so every identity attribute must be derivable
+// from state alone.
+//
+// Once a resource declares an identity schema the framework rejects Create, Read and Update
+// responses that leave the identity null, so resources call Set as soon as the identifying
+// values are known — in Read that is before the API call, so a resource removed out of band
+// still carries an identity when its state predates identity support.
+package identity_common
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// IDAttribute is the identity attribute of resources addressed by a single Orca ID.
+const IDAttribute = "id"
+
+// Attribute is one identity attribute. Identity attributes are strings named after the state
+// attribute holding the same value, which lets SetFromState copy them across.
+type Attribute struct {
+	Name        string
+	Description string
+}
+
+// Schema declares an identity made of attrs, all required for import.
+func Schema(attrs ...Attribute) identityschema.Schema {
+	out := identityschema.Schema{Attributes: map[string]identityschema.Attribute{}}
+	for _, a := range attrs {
+		out.Attributes[a.Name] = identityschema.StringAttribute{
+			RequiredForImport: true,
+			Description:       a.Description,
+		}
+	}
+	return out
+}
+
+// IDSchema is the identity schema of resources addressed by a single Orca ID.
+func IDSchema() identityschema.Schema {
+	return Schema(Attribute{Name: IDAttribute, Description: "Orca identifier of the object."})
+}
+
+// Set writes each attribute of values to identity. A nil identity (resources driven directly
+// in unit tests) is ignored.
+func Set(ctx context.Context, identity *tfsdk.ResourceIdentity, values map[string]string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if identity == nil {
+		return diags
+	}
+	for name, value := range values {
+		diags.Append(identity.SetAttribute(ctx, path.Root(name), value)...)
+	}
+	return diags
+}
+
+// SetID writes the single-ID identity.
+func SetID(ctx context.Context, identity *tfsdk.ResourceIdentity, id string) diag.Diagnostics {
+	return Set(ctx, identity, map[string]string{IDAttribute: id})
+}
+
+// SetIDFromState writes the single-ID identity from the `id` attribute of state. Read and
+// Update use it with the prior state, whose ID is known even when the plan marks it unknown.
+func SetIDFromState(ctx context.Context, identity *tfsdk.ResourceIdentity, state tfsdk.State) diag.Diagnostics {
+	return SetFromState(ctx, identity, state, IDAttribute)
+}
+
+// SetFromState writes each named identity attribute from the state attribute of the same name.
+// A value that is null in state is written as an empty string.
+func SetFromState(ctx context.Context, identity *tfsdk.ResourceIdentity, state tfsdk.State, names ...string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if identity == nil {
+		return diags
+	}
+	values := make(map[string]string, len(names))
+	for _, name := range names {
+		var v types.String
+		diags.Append(state.GetAttribute(ctx, path.Root(name), &v)...)
+		values[name] = v.ValueString()
+	}
+	if diags.HasError() {
+		return diags
+	}
+	diags.Append(Set(ctx, identity, values)...)
+	return diags
+}
+
+// ImportedID returns the ID being imported, taken from the import ID or the identity `id`, for
+// resources whose ImportState does more than pass the ID through.
+func ImportedID(ctx context.Context, req resource.ImportStateRequest, diags *diag.Diagnostics) string {
+	return Imported(ctx, req, IDAttribute, diags)
+}
+
+// Imported returns the import ID, or the named identity attribute when importing by identity.
+func Imported(ctx context.Context, req resource.ImportStateRequest, name string, diags *diag.Diagnostics) string {
+	if req.ID != "" || req.Identity == nil {
+		return req.ID
+	}
+	var v types.String
+	diags.Append(req.Identity.GetAttribute(ctx, path.Root(name), &v)...)
+	return v.ValueString()
+}
+
+// ImportID imports a single-ID resource from either the import ID or the identity `id`.
+func ImportID(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root(IDAttribute), req, resp)
+}
@@ -0,0 +1,38 @@
+package integrations_common
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// IAMRoleArnPattern matches an IAM role ARN in any AWS partition.
+var IAMRoleArnPattern = regexp.MustCompile(`^arn:aws[a-z-]*:iam::\d{12}:role/.+`)
+
+// AWSRegionPattern matches an AWS region name such as us-east-1 or us-gov-west-1.
+var AWSRegionPattern = regexp.MustCompile(`^[a-z]{2}(-[a-z]+)+-\d$`)
+
+// AWSRoleAttributes returns the role_arn / external_id / region attributes shared by the
+// integrations Orca reaches by assuming a role in the customer's account (SQS, SNS, Security
+// Lake). The role's trust policy is rendered by orcasecurity_integration_aws_trust_policy.
+func AWSRoleAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"role_arn": schema.StringAttribute{
+			Required:    true,
+			Description: "ARN of the IAM role in your account that Orca assumes. Its trust policy must allow Orca's AWS account with the `external_id` condition; see the `orcasecurity_integration_aws_trust_policy` data source.",
+			Validators:  []validator.String{stringvalidator.RegexMatches(IAMRoleArnPattern, "must be an IAM role ARN")},
+		},
+		"external_id": schema.StringAttribute{
+			Required:    true,
+			Description: "External ID Orca presents when assuming `role_arn` (the `sts:ExternalId` condition in the role's trust policy).",
+			Validators:  []validator.String{stringvalidator.LengthBetween(2, 1224)},
+		},
+		"region": schema.StringAttribute{
+			Required:    true,
+			Description: "AWS region of the target, for example `us-east-1`.",
+			Validators:  []validator.String{stringvalidator.RegexMatches(AWSRegionPattern, "must be an AWS region name")},
+		},
+	}
+}
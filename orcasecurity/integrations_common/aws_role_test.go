@@ -0,0 +1,34 @@
+package integrations_common
+
+import "testing"
+
+func TestIAMRoleArnPattern(t *testing.T) {
+	for arn, want := range map[string]bool{
+		"arn:aws:iam::123456789012:role/orca-sqs":            true,
+		"arn:aws-us-gov:iam::123456789012:role/path/orca":    true,
+		"arn:aws:iam::123456789012:user/orca":                false,
+		"arn:aws:iam::12345:role/orca":                       false,
+		"arn:aws:sqs:us-east-1:123456789012:orca-alerts":     false,
+		"arn:aws-cn:iam::123456789012:role/service-role/sl1": true,
+	} {
+		if got := IAMRoleArnPattern.MatchString(arn); got != want {
+			t.Errorf("IAMRoleArnPattern(%q) = %v, want %v", arn, got, want)
+		}
+	}
+}
+
+func TestAWSRegionPattern(t *testing.T) {
+	for region, want := range map[string]bool{
+		"us-east-1":      true,
+		"eu-central-2":   true,
+		"us-gov-west-1":  true,
+		"ap-southeast-4": true,
+		"us-east":        false,
+		"US-EAST-1":      false,
+		"useast1":        false,
+	} {
+		if got := AWSRegionPattern.MatchString(region); got != want {
+			t.Errorf("AWSRegionPattern(%q) = %v, want %v", region, got, want)
+		}
+	}
+}
@@ -3,12 +3,23 @@
 package acctest
 
 import (
+	"context"
 	"fmt"
+	"os"
+	"os/exec"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
 	"testing"
 
 	"terraform-provider-orcasecurity/orcasecurity"
+	"terraform-provider-orcasecurity/orcasecurity/internal/fakeorca"
 
 	"github.com/google/uuid"
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -26,39 +37,96 @@ type SimpleKeyIntegrationSpec struct {
 	KeyValue string
 }
 
+// IntegrationSpec describes a config-integration variant for RunIntegrationTest: any number of
+// variant attributes, some of which may be secrets the API never returns.
+type IntegrationSpec struct {
+	// ResourceType is the fully qualified resource type, e.g. orcasecurity_integration_splunk.
+	ResourceType string
+	// TemplateName is the config name used both in the HCL and as the import ID.
+	TemplateName string
+	// Attributes maps each variant attribute to its HCL expression, e.g. `"https://example.com"`
+	// or `["env:prod"]`. Quoted string expressions are also asserted in state after create.
+	Attributes map[string]string
+	// Secrets names the attributes the API never returns; import can't verify them.
+	Secrets []string
+}
+
 // RunSimpleKeyIntegrationTest exercises create (with defaults asserted), an in-place update that
 // flips both is_default and is_enabled, and import. It does not cover business_units or the
 // template_name RequiresReplace path. resource.Test runs terraform destroy at the end of the
 // TestCase so the lab config is always torn down.
 func RunSimpleKeyIntegrationTest(t *testing.T, spec SimpleKeyIntegrationSpec) {
+	RunIntegrationTest(t, spec.integrationSpec())
+}
+
+// RunSimpleKeyIntegrationTestOffline runs the same steps as RunSimpleKeyIntegrationTest against
+// an in-memory fake of the Orca API instead of a lab org.
+func RunSimpleKeyIntegrationTestOffline(t *testing.T, spec SimpleKeyIntegrationSpec) {
+	RunIntegrationTestOffline(t, spec.integrationSpec())
+}
+
+func (spec SimpleKeyIntegrationSpec) integrationSpec() IntegrationSpec {
+	return IntegrationSpec{
+		ResourceType: spec.ResourceType,
+		TemplateName: spec.TemplateName,
+		Attributes:   map[string]string{spec.KeyAttr: strconv.Quote(spec.KeyValue)},
+		Secrets:      []string{spec.KeyAttr},
+	}
+}
+
+// RunIntegrationTest is RunSimpleKeyIntegrationTest for variants with several attributes.
+func RunIntegrationTest(t *testing.T, spec IntegrationSpec) {
+	resource.Test(t, integrationTestCase(t, spec))
+}
+
+// RunIntegrationTestOffline runs the same steps as RunIntegrationTest against an in-memory fake
+// of the Orca API instead of a lab org.
+func RunIntegrationTestOffline(t *testing.T, spec IntegrationSpec) {
+	Offline(t)
+	resource.UnitTest(t, integrationTestCase(t, spec))
+}
+
+func integrationTestCase(t *testing.T, spec IntegrationSpec) resource.TestCase {
 	fullName := spec.ResourceType + ".test"
+	names := make([]string, 0, len(spec.Attributes))
+	for name := range spec.Attributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var attrs strings.Builder
+	createChecks := []resource.TestCheckFunc{
+		resource.TestCheckResourceAttr(fullName, "template_name", spec.TemplateName),
+		resource.TestCheckResourceAttr(fullName, "is_enabled", "true"),
+		resource.TestCheckResourceAttr(fullName, "is_default", "false"),
+		resource.TestCheckResourceAttrWith(fullName, "id", func(v string) error {
+			_, err := uuid.Parse(v)
+			return err
+		}),
+	}
+	for _, name := range names {
+		fmt.Fprintf(&attrs, "  %s = %s\n", name, spec.Attributes[name])
+		if v, err := strconv.Unquote(spec.Attributes[name]); err == nil {
+			createChecks = append(createChecks, resource.TestCheckResourceAttr(fullName, name, v))
+		}
+	}
 	config := func(isDefault, isEnabled bool) string {
 		return orcasecurity.TestProviderConfig + fmt.Sprintf(`
 resource "%s" "test" {
   template_name = "%s"
   is_default    = %t
   is_enabled    = %t
-  %s = "%s"
-}
-`, spec.ResourceType, spec.TemplateName, isDefault, isEnabled, spec.KeyAttr, spec.KeyValue)
+%s}
+`, spec.ResourceType, spec.TemplateName, isDefault, isEnabled, attrs.String())
 	}
 
-	resource.Test(t, resource.TestCase{
+	return resource.TestCase{
 		PreCheck:                 func() { orcasecurity.TestAccPreCheck(t) },
 		ProtoV6ProviderFactories: orcasecurity.TestAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
 				Config: config(false, true),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr(fullName, "template_name", spec.TemplateName),
-					resource.TestCheckResourceAttr(fullName, "is_enabled", "true"),
-					resource.TestCheckResourceAttr(fullName, "is_default", "false"),
-					resource.TestCheckResourceAttr(fullName, spec.KeyAttr, spec.KeyValue),
-					resource.TestCheckResourceAttrWith(fullName, "id", func(v string) error {
-						_, err := uuid.Parse(v)
-						return err
-					}),
-				),
+				Check:  resource.ComposeAggregateTestCheckFunc(createChecks...),
 			},
 			{
 				Config: config(true, false),
@@ -72,9 +140,89 @@ resource "%s" "test" {
 				ImportState:       true,
 				ImportStateId:     spec.TemplateName,
 				ImportStateVerify: true,
-				// The API never returns the secret key, so it can't be verified on import.
-				ImportStateVerifyIgnore: []string{spec.KeyAttr},
+				// The API never returns secrets, so they can't be verified on import.
+				ImportStateVerifyIgnore: spec.Secrets,
 			},
 		},
-	})
+	}
+}
+
+// Offline points `provider "orcasecurity" {}` at a fresh fakeorca server for the rest of the test
+// and returns the server. Offline tests run under resource.UnitTest, so they need no TF_ACC and
+// no network, but they do need a Terraform CLI; without one on PATH (or TF_ACC_TERRAFORM_PATH)
+// the test is skipped rather than left to download one, unless ORCASECURITY_ACC_REQUIRE_TERRAFORM
+// is set.
+func Offline(t *testing.T) *fakeorca.Server {
+	t.Helper()
+	requireTerraform(t)
+	srv := fakeorca.New(t)
+	for k, v := range srv.Env() {
+		t.Setenv(k, v)
+	}
+	return srv
+}
+
+// RequireTerraformEnvName marks a job that installs a Terraform CLI, where requireTerraform fails
+// instead of skipping when the CLI is missing.
+const RequireTerraformEnvName = "ORCASECURITY_ACC_REQUIRE_TERRAFORM"
+
+// requireTerraform skips tests that would otherwise have terraform-plugin-testing download a CLI.
+// Not every job that runs `go test` installs one, so a missing CLI is only a failure where
+// ORCASECURITY_ACC_REQUIRE_TERRAFORM says the job set one up.
+func requireTerraform(t *testing.T) {
+	t.Helper()
+	if os.Getenv("TF_ACC_TERRAFORM_PATH") != "" || os.Getenv("TF_ACC_TERRAFORM_VERSION") != "" {
+		return
+	}
+	if _, err := exec.LookPath("terraform"); err != nil {
+		reason := "offline acceptance tests need a Terraform CLI on PATH or TF_ACC_TERRAFORM_PATH"
+		if os.Getenv(RequireTerraformEnvName) != "" {
+			t.Fatal(reason)
+		}
+		t.Skip(reason)
+	}
+}
+
+// secretLiteral matches a quoted HCL string that is a whole expression or the value of a map
+// element. Keys and literals inside function calls such as jsonencode are left alone: they are
+// usually field names, and scrubbing those would corrupt every body they appear in.
+var secretLiteral = regexp.MustCompile(`(?:^|=)\s*("(?:[^"\\]|\\.)*")`)
+
+// SensitiveValues returns the string literals in the HCL of every spec attribute that the
+// resource schema marks Sensitive or spec.Secrets lists, including the values of map
+// attributes such as auth_headers. Passed to a cassette recorder's Redact, they are scrubbed
+// wherever they appear, which covers secrets whose JSON field name looks harmless (e.g. a Sumo
+// Logic collector url).
+func SensitiveValues(t testing.TB, spec IntegrationSpec) []string {
+	t.Helper()
+	ctx := context.Background()
+	var attrs map[string]schema.Attribute
+	for _, newResource := range orcasecurity.New("test")().Resources(ctx) {
+		r := newResource()
+		meta := tfresource.MetadataResponse{}
+		r.Metadata(ctx, tfresource.MetadataRequest{ProviderTypeName: "orcasecurity"}, &meta)
+		if meta.TypeName == spec.ResourceType {
+			resp := tfresource.SchemaResponse{}
+			r.Schema(ctx, tfresource.SchemaRequest{}, &resp)
+			attrs = resp.Schema.Attributes
+			break
+		}
+	}
+	if attrs == nil {
+		t.Fatalf("resource %s is not registered with the provider", spec.ResourceType)
+	}
+
+	var values []string
+	for name, expr := range spec.Attributes {
+		attr, ok := attrs[name]
+		if !slices.Contains(spec.Secrets, name) && !(ok && attr.IsSensitive()) {
+			continue
+		}
+		for _, m := range secretLiteral.FindAllStringSubmatch(strings.TrimSpace(expr), -1) {
+			if v, err := strconv.Unquote(m[1]); err == nil && v != "" {
+				values = append(values, v)
+			}
+		}
+	}
+	return values
 }
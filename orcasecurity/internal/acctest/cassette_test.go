@@ -0,0 +1,122 @@
+package acctest
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	"terraform-provider-orcasecurity/orcasecurity/internal/cassette"
+	"terraform-provider-orcasecurity/orcasecurity/internal/fakeorca"
+)
+
+// Secrets whose JSON field names look harmless (a Sumo Logic collector url, header values keyed
+// by header name) or that no name rule knows about must still stay out of recorded cassettes.
+func TestCassette_RedactsSensitiveAttributes(t *testing.T) {
+	const (
+		sumoURL        = "https://collectors.sumologic.com/receiver/v1/http/ZaVnC4dhaV0live"
+		serviceAccount = `{"type":"service_account","private_key_id":"live-key-id"}`
+		tinesURL       = "https://acme.tines.com/webhook/abc/live-path-secret"
+		tinesAuth      = "Bearer live-tines-token"
+	)
+	cases := []struct {
+		spec   IntegrationSpec
+		create func(context.Context, *api_client.APIClient) error
+	}{
+		{
+			spec: IntegrationSpec{
+				ResourceType: "orcasecurity_integration_sumo_logic",
+				Attributes:   map[string]string{"url": `"` + sumoURL + `"`, "source_category": `"orca"`},
+			},
+			create: func(ctx context.Context, c *api_client.APIClient) error {
+				_, err := c.CreateSumoLogicConfig(ctx, api_client.SumoLogicExternalServiceConfig{
+					TemplateName: "sumo", Config: api_client.SumoLogicConfig{URL: sumoURL, SourceCategory: "orca"},
+				})
+				return err
+			},
+		},
+		{
+			spec: IntegrationSpec{
+				ResourceType: "orcasecurity_integration_chronicle",
+				Attributes:   map[string]string{"service_account_json": `jsonencode(` + serviceAccount + `)`},
+			},
+			create: func(ctx context.Context, c *api_client.APIClient) error {
+				_, err := c.CreateChronicleConfig(ctx, api_client.ChronicleExternalServiceConfig{
+					TemplateName: "chronicle", Config: api_client.ChronicleConfig{ServiceAccountJSON: serviceAccount},
+				})
+				return err
+			},
+		},
+		{
+			spec: IntegrationSpec{
+				ResourceType: "orcasecurity_integration_tines",
+				Attributes: map[string]string{
+					"webhook_url":  `"` + tinesURL + `"`,
+					"auth_headers": `{ Authorization = "` + tinesAuth + `" }`,
+				},
+			},
+			create: func(ctx context.Context, c *api_client.APIClient) error {
+				_, err := c.CreateTinesConfig(ctx, api_client.WebhookExternalServiceConfig{
+					TemplateName: "tines",
+					Config: api_client.WebhookResourceConfig{
+						WebhookURL:    tinesURL,
+						CustomHeaders: map[string][]api_client.WebhookCustomHeaderValue{"Authorization": {{Custom: tinesAuth}}},
+					},
+				})
+				return err
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.spec.ResourceType, func(t *testing.T) {
+			srv := fakeorca.New(t)
+			path := filepath.Join(t.TempDir(), "cassette.json")
+			rec, err := cassette.New(path, cassette.Record, srv.Server.Client().Transport)
+			if err != nil {
+				t.Fatal(err)
+			}
+			rec.Redact(SensitiveValues(t, tc.spec)...)
+			client := srv.Client()
+			client.HTTPClient = &http.Client{Transport: rec}
+			if err := tc.create(context.Background(), client); err != nil {
+				t.Fatalf("create: %v", err)
+			}
+			if err := rec.Stop(); err != nil {
+				t.Fatal(err)
+			}
+
+			data, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, secret := range []string{sumoURL, "live-key-id", tinesURL, tinesAuth} {
+				if strings.Contains(string(data), secret) {
+					t.Errorf("cassette contains %q:\n%s", secret, data)
+				}
+			}
+		})
+	}
+}
+
+func TestSensitiveValues(t *testing.T) {
+	got := SensitiveValues(t, IntegrationSpec{
+		ResourceType: "orcasecurity_integration_tines",
+		Attributes: map[string]string{
+			"webhook_url":   `"https://acme.tines.com/webhook/x"`,
+			"auth_headers":  `{ Authorization = "Bearer a", "X-Token" = "b" }`,
+			"template_name": `"not-secret"`,
+		},
+	})
+	want := map[string]bool{"https://acme.tines.com/webhook/x": true, "Bearer a": true, "b": true}
+	if len(got) != len(want) {
+		t.Fatalf("SensitiveValues = %q, want the values of %v", got, want)
+	}
+	for _, v := range got {
+		if !want[v] {
+			t.Errorf("unexpected sensitive value %q", v)
+		}
+	}
+}
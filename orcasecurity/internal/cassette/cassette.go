@@ -0,0 +1,299 @@
+// Package cassette records Orca API traffic to a file and replays it later. A Recorder is an
+// http.RoundTripper installed into APIClient.HTTPClient: in Record mode it forwards to the real
+// transport and keeps every interaction, in Replay mode it answers from the cassette without
+// touching the network. It is imported only from _test.go files.
+//
+// Cassettes are scrubbed before they are written. Request headers (and with them the
+// Authorization token) are never stored, the endpoint host is dropped, and JSON fields that look
+// like secrets, every value under a *headers field, and the literal values passed to Redact are
+// replaced with Redacted in both request and response bodies. Replay applies
+// the same scrubbing to incoming requests, so matching on method, path and normalized JSON body
+// still works for requests that carry secrets.
+package cassette
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// Redacted replaces scrubbed values in recorded bodies.
+const Redacted = "REDACTED"
+
+// ErrNoCassette is returned by New when replaying a cassette that has not been recorded yet.
+var ErrNoCassette = errors.New("cassette not recorded")
+
+type Mode int
+
+const (
+	// Replay serves responses from an existing cassette and fails requests it has no match for.
+	Replay Mode = iota
+	// Record forwards requests to the real API and writes the cassette on Stop.
+	Record
+)
+
+// Interaction is one recorded request/response pair.
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+type Request struct {
+	Method string `json:"method"`
+	// Path includes the query string, with parameters sorted.
+	Path string `json:"path"`
+	Body string `json:"body,omitempty"`
+}
+
+type Response struct {
+	Status      int    `json:"status"`
+	ContentType string `json:"content_type,omitempty"`
+	Body        string `json:"body,omitempty"`
+}
+
+type Recorder struct {
+	path  string
+	mode  Mode
+	inner http.RoundTripper
+
+	mu           sync.Mutex
+	interactions []Interaction
+	used         []bool
+	secrets      []string
+}
+
+// New opens the cassette at path. Replay requires the file to exist; Record starts an empty
+// cassette and sends requests through inner (http.DefaultTransport when nil).
+func New(path string, mode Mode, inner http.RoundTripper) (*Recorder, error) {
+	if inner == nil {
+		inner = http.DefaultTransport
+	}
+	r := &Recorder{path: path, mode: mode, inner: inner}
+	if mode == Record {
+		return r, nil
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("%w: %s", ErrNoCassette, path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading cassette: %w", err)
+	}
+	if err := json.Unmarshal(data, &r.interactions); err != nil {
+		return nil, fmt.Errorf("parsing cassette %s: %w", path, err)
+	}
+	r.used = make([]bool, len(r.interactions))
+	return r, nil
+}
+
+// Redact adds literal values (e.g. the API token) to scrub wherever they appear in bodies.
+func (r *Recorder) Redact(values ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, v := range values {
+		if v != "" {
+			r.secrets = append(r.secrets, v)
+		}
+	}
+}
+
+// Wrap returns r as the replacement for inner, which becomes the transport recorded requests are
+// forwarded to. It has the shape orcasecurity.WithTransport expects.
+func (r *Recorder) Wrap(inner http.RoundTripper) http.RoundTripper {
+	if inner != nil {
+		r.inner = inner
+	}
+	return r
+}
+
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	key := Request{Method: req.Method, Path: normalizePath(req.URL), Body: r.scrub(body)}
+
+	if r.mode == Replay {
+		for i, in := range r.interactions {
+			if !r.used[i] && in.Request == key {
+				r.used[i] = true
+				return in.Response.toHTTP(req), nil
+			}
+		}
+		return nil, fmt.Errorf("cassette %s has no unused interaction for %s %s", r.path, key.Method, key.Path)
+	}
+
+	resp, err := r.inner.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+	r.interactions = append(r.interactions, Interaction{
+		Request: key,
+		Response: Response{
+			Status:      resp.StatusCode,
+			ContentType: resp.Header.Get("Content-Type"),
+			Body:        r.scrub(respBody),
+		},
+	})
+	return resp, nil
+}
+
+// Stop writes the cassette in Record mode. In Replay mode it reports interactions that were
+// never requested, which usually means the cassette is stale.
+func (r *Recorder) Stop() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.mode == Replay {
+		var unused []string
+		for i, in := range r.interactions {
+			if !r.used[i] {
+				unused = append(unused, in.Request.Method+" "+in.Request.Path)
+			}
+		}
+		if len(unused) > 0 {
+			return fmt.Errorf("cassette %s has %d unused interactions: %s", r.path, len(unused), strings.Join(unused, ", "))
+		}
+		return nil
+	}
+	data, err := json.MarshalIndent(r.interactions, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(r.path, append(data, '\n'), 0o644)
+}
+
+func (resp Response) toHTTP(req *http.Request) *http.Response {
+	header := http.Header{}
+	if resp.ContentType != "" {
+		header.Set("Content-Type", resp.ContentType)
+	}
+	return &http.Response{
+		StatusCode:    resp.Status,
+		Status:        fmt.Sprintf("%d %s", resp.Status, http.StatusText(resp.Status)),
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(resp.Body)),
+		ContentLength: int64(len(resp.Body)),
+		Request:       req,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+	}
+}
+
+// readBody drains req.Body and puts back a fresh reader so the request can still be sent.
+func readBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil {
+		return nil, nil
+	}
+	data, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(data))
+	return data, nil
+}
+
+// normalizePath drops the host and sorts query parameters; url.Values.Encode sorts by key.
+func normalizePath(u *url.URL) string {
+	if u.RawQuery == "" {
+		return u.Path
+	}
+	return u.Path + "?" + u.Query().Encode()
+}
+
+// scrub returns body with secret fields redacted. JSON bodies are re-encoded, which also
+// normalizes key order and whitespace so semantically equal bodies compare equal.
+func (r *Recorder) scrub(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	out := string(body)
+	var v any
+	if err := json.Unmarshal(body, &v); err == nil {
+		if data, err := json.Marshal(redactFields(v)); err == nil {
+			out = string(data)
+		}
+	}
+	for _, s := range r.secrets {
+		out = strings.ReplaceAll(out, s, Redacted)
+	}
+	return out
+}
+
+func redactFields(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, field := range v {
+			if _, isString := field.(string); isString && IsSecretField(k) {
+				v[k] = Redacted
+				continue
+			}
+			if isHeaderMap(k) {
+				v[k] = redactAll(field)
+				continue
+			}
+			v[k] = redactFields(field)
+		}
+	case []any:
+		for i := range v {
+			v[i] = redactFields(v[i])
+		}
+	}
+	return v
+}
+
+// redactAll replaces every string under v. Header maps are keyed by header name (e.g.
+// Authorization), so the field names say nothing about which values are credentials.
+func redactAll(v any) any {
+	switch v := v.(type) {
+	case string:
+		return Redacted
+	case map[string]any:
+		for k, field := range v {
+			v[k] = redactAll(field)
+		}
+	case []any:
+		for i := range v {
+			v[i] = redactAll(v[i])
+		}
+	}
+	return v
+}
+
+func isHeaderMap(name string) bool {
+	return strings.HasSuffix(strings.ToLower(name), "headers")
+}
+
+// IsSecretField reports whether a JSON field name looks like it holds a credential. The rule is
+// deliberately broad: over-redacting a harmless field only costs a little replay fidelity,
+// while missing one leaks a secret into the repository.
+func IsSecretField(name string) bool {
+	name = strings.ToLower(name)
+	for _, s := range []string{"token", "secret", "password", "credential", "private_key", "apikey", "service_account"} {
+		if strings.Contains(name, s) {
+			return true
+		}
+	}
+	return strings.HasSuffix(name, "_key") || strings.HasSuffix(name, "webhook_url")
+}
@@ -0,0 +1,146 @@
+package cassette
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"terraform-provider-orcasecurity/orcasecurity/api_client"
+)
+
+const liveToken = "live-secret-token"
+
+// record runs one PagerDuty create and a read through a Record-mode recorder and
+// returns the cassette path.
+func record(t *testing.T) string {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case http.MethodPost:
+			fmt.Fprint(w, `{"status":"success","data":{"id":"cfg-1","template_name":"oncall","config":{"integration_key":"key-from-server"}}}`)
+		default:
+			fmt.Fprint(w, `{"status":"success","echo":"`+liveToken+`","data":[]}`)
+		}
+	}))
+	defer srv.Close()
+
+	path := filepath.Join(t.TempDir(), "cassettes", "pagerduty.json")
+	rec, err := New(path, Record, srv.Client().Transport)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rec.Redact(liveToken)
+	client := &api_client.APIClient{APIEndpoint: srv.URL, APIToken: liveToken, HTTPClient: &http.Client{Transport: rec}}
+	exercise(t, client)
+	if err := rec.Stop(); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func exercise(t *testing.T, client *api_client.APIClient) {
+	t.Helper()
+	ctx := context.Background()
+	created, err := client.CreatePagerDutyConfig(ctx, api_client.PagerDutyExternalServiceConfig{
+		TemplateName: "oncall",
+		Config:       api_client.PagerDutyConfig{IntegrationKey: "key-from-config"},
+	})
+	if err != nil || created.ID != "cfg-1" {
+		t.Fatalf("create = %+v, %v", created, err)
+	}
+	if _, err := client.GetPagerDutyConfig(ctx, "oncall"); err != nil {
+		t.Fatalf("read: %v", err)
+	}
+}
+
+func TestRecorder_ScrubsSecrets(t *testing.T) {
+	data, err := os.ReadFile(record(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{liveToken, "key-from-config", "key-from-server", "127.0.0.1"} {
+		if strings.Contains(string(data), secret) {
+			t.Errorf("cassette contains %q:\n%s", secret, data)
+		}
+	}
+	if !strings.Contains(string(data), `\"integration_key\":\"REDACTED\"`) {
+		t.Errorf("cassette has no redacted key:\n%s", data)
+	}
+}
+
+func TestRecorder_ReplaysWithoutNetwork(t *testing.T) {
+	rec, err := New(record(t), Replay, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// A different endpoint, token and secret still match: none of them are part of the key.
+	client := &api_client.APIClient{APIEndpoint: "https://cassette.invalid", APIToken: "other", HTTPClient: &http.Client{Transport: rec}}
+	exercise(t, client)
+	if err := rec.Stop(); err != nil {
+		t.Errorf("stop: %v", err)
+	}
+}
+
+func TestRecorder_MatchesNormalizedBody(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "c.json")
+	cassette := `[{"request":{"method":"POST","path":"/x?a=1&b=2","body":"{\"a\":1,\"b\":[true]}"},"response":{"status":201,"body":"ok"}}]`
+	if err := os.WriteFile(path, []byte(cassette), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	rec, err := New(path, Replay, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req, _ := http.NewRequest(http.MethodPost, "https://host/x?b=2&a=1", strings.NewReader("{ \"b\": [true],\n \"a\": 1 }"))
+	resp, err := rec.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusCreated {
+		t.Fatalf("round trip = %+v, %v", resp, err)
+	}
+
+	// Each interaction is used once.
+	req, _ = http.NewRequest(http.MethodPost, "https://host/x?a=1&b=2", strings.NewReader(`{"a":1,"b":[true]}`))
+	if _, err := rec.RoundTrip(req); err == nil {
+		t.Error("interaction replayed twice")
+	}
+}
+
+func TestRecorder_ReportsUnusedInteractions(t *testing.T) {
+	rec, err := New(record(t), Replay, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := rec.Stop(); err == nil || !strings.Contains(err.Error(), "2 unused") {
+		t.Errorf("stop = %v, want 2 unused interactions", err)
+	}
+}
+
+func TestNew_MissingCassette(t *testing.T) {
+	if _, err := New(filepath.Join(t.TempDir(), "missing.json"), Replay, nil); !errors.Is(err, ErrNoCassette) {
+		t.Errorf("err = %v, want ErrNoCassette", err)
+	}
+}
+
+func TestIsSecretField(t *testing.T) {
+	for name, want := range map[string]bool{
+		"integration_key":      true,
+		"api_token":            true,
+		"password":             true,
+		"webhook_url":          true,
+		"ClientSecret":         true,
+		"service_account_json": true,
+		"template_name":        false,
+		"key":                  false,
+		"service_name":         false,
+	} {
+		if got := IsSecretField(name); got != want {
+			t.Errorf("IsSecretField(%q) = %v, want %v", name, got, want)
+		}
+	}
+}
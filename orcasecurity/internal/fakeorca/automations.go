@@ -0,0 +1,101 @@
+package fakeorca
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+// /api/automations keeps automations in evaluation order, so an automation's priority is its
+// 1-based position. New automations go last; the priority endpoint moves one and renumbers the
+// rest, clamping past-the-end values to the highest priority like the real server does.
+func (s *Server) mountAutomations(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/automations", func(w http.ResponseWriter, r *http.Request) {
+		all := s.automations
+		out := page(r, all)
+		if out == nil {
+			out = []map[string]any{}
+		}
+		writeJSON(w, http.StatusOK, map[string]any{"status": "success", "total_items": len(all), "data": out})
+	})
+	mux.HandleFunc("POST /api/automations", func(w http.ResponseWriter, r *http.Request) {
+		item, ok := readObject(w, r)
+		if !ok {
+			return
+		}
+		item["id"] = uuid.NewString()
+		item["organization"] = OrganizationID
+		s.automations = append(s.automations, item)
+		s.renumberAutomations()
+		writeData(w, http.StatusCreated, item)
+	})
+	mux.HandleFunc("GET /api/automations/{id}", func(w http.ResponseWriter, r *http.Request) {
+		_, item := s.findAutomation(r.PathValue("id"))
+		if item == nil {
+			writeError(w, http.StatusNotFound, "automation not found")
+			return
+		}
+		writeData(w, http.StatusOK, item)
+	})
+	mux.HandleFunc("PUT /api/automations/{id}", func(w http.ResponseWriter, r *http.Request) {
+		_, item := s.findAutomation(r.PathValue("id"))
+		if item == nil {
+			writeError(w, http.StatusNotFound, "automation not found")
+			return
+		}
+		patch, ok := readObject(w, r)
+		if !ok {
+			return
+		}
+		// Priority is read-only here; only the priority endpoint moves automations.
+		delete(patch, "priority")
+		merge(item, patch, "id")
+		writeData(w, http.StatusOK, item)
+	})
+	mux.HandleFunc("PUT /api/automations/{id}/priority", func(w http.ResponseWriter, r *http.Request) {
+		i, item := s.findAutomation(r.PathValue("id"))
+		if item == nil {
+			writeError(w, http.StatusNotFound, "automation not found")
+			return
+		}
+		body, ok := readObject(w, r)
+		if !ok {
+			return
+		}
+		want, _ := body["priority"].(float64)
+		if want < 1 {
+			writeError(w, http.StatusBadRequest, "priority must be at least 1")
+			return
+		}
+		pos := min(int(want), len(s.automations)) - 1
+		rest := append(s.automations[:i:i], s.automations[i+1:]...)
+		s.automations = append(rest[:pos:pos], append([]map[string]any{item}, rest[pos:]...)...)
+		s.renumberAutomations()
+		writeData(w, http.StatusOK, item)
+	})
+	mux.HandleFunc("DELETE /api/automations/{id}", func(w http.ResponseWriter, r *http.Request) {
+		i, item := s.findAutomation(r.PathValue("id"))
+		if item == nil {
+			writeError(w, http.StatusNotFound, "automation not found")
+			return
+		}
+		s.automations = append(s.automations[:i], s.automations[i+1:]...)
+		s.renumberAutomations()
+		writeJSON(w, http.StatusOK, map[string]any{"status": "success"})
+	})
+}
+
+func (s *Server) findAutomation(id string) (int, map[string]any) {
+	for i, item := range s.automations {
+		if item["id"] == id {
+			return i, item
+		}
+	}
+	return -1, nil
+}
+
+func (s *Server) renumberAutomations() {
+	for i, item := range s.automations {
+		item["priority"] = i + 1
+	}
+}
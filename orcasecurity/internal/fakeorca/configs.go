@@ -0,0 +1,100 @@
+package fakeorca
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+// /api/external_service/config stores one object per (service_name, template_name). Reads list
+// by query, writes address a single template via /{service}?template=name.
+func (s *Server) mountConfigs(mux *http.ServeMux) {
+	const path = "/api/external_service/config"
+	mux.HandleFunc("GET "+path, s.listConfigs)
+	mux.HandleFunc("POST "+path, s.createConfig)
+	mux.HandleFunc("PUT "+path+"/{service}", s.updateConfig)
+	mux.HandleFunc("DELETE "+path+"/{service}", s.deleteConfig)
+}
+
+func (s *Server) findConfig(service, template string) (int, map[string]any) {
+	for i, cfg := range s.configs {
+		if cfg["service_name"] == service && cfg["template_name"] == template {
+			return i, cfg
+		}
+	}
+	return -1, nil
+}
+
+func (s *Server) listConfigs(w http.ResponseWriter, r *http.Request) {
+	service, template := r.URL.Query().Get("service_name"), r.URL.Query().Get("template_name")
+	var out []map[string]any
+	for _, cfg := range s.configs {
+		if service != "" && cfg["service_name"] != service {
+			continue
+		}
+		if template != "" && cfg["template_name"] != template {
+			continue
+		}
+		out = append(out, cfg)
+	}
+	writeList(w, out)
+}
+
+func (s *Server) createConfig(w http.ResponseWriter, r *http.Request) {
+	cfg, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	service, _ := cfg["service_name"].(string)
+	template, _ := cfg["template_name"].(string)
+	if service == "" || template == "" {
+		writeError(w, http.StatusBadRequest, "service_name and template_name are required")
+		return
+	}
+	if _, existing := s.findConfig(service, template); existing != nil {
+		writeError(w, http.StatusBadRequest, "template name "+template+" already exists")
+		return
+	}
+	cfg["id"] = uuid.NewString()
+	if cfg["config"] == nil {
+		cfg["config"] = map[string]any{}
+	}
+	s.configs = append(s.configs, cfg)
+	writeData(w, http.StatusCreated, cfg)
+}
+
+// updateConfig merges the partial PUT body. Keys inside `config` merge one level deeper, since
+// callers omit unchanged secrets and the server keeps the stored value for them.
+func (s *Server) updateConfig(w http.ResponseWriter, r *http.Request) {
+	_, cfg := s.findConfig(r.PathValue("service"), r.URL.Query().Get("template"))
+	if cfg == nil {
+		writeError(w, http.StatusNotFound, "integration not found")
+		return
+	}
+	patch, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	if inner, ok := patch["config"].(map[string]any); ok {
+		stored, _ := cfg["config"].(map[string]any)
+		if stored == nil {
+			stored = map[string]any{}
+		}
+		merge(stored, inner, "")
+		patch["config"] = stored
+	}
+	delete(patch, "service_name")
+	delete(patch, "template_name")
+	merge(cfg, patch, "id")
+	writeData(w, http.StatusOK, cfg)
+}
+
+func (s *Server) deleteConfig(w http.ResponseWriter, r *http.Request) {
+	i, cfg := s.findConfig(r.PathValue("service"), r.URL.Query().Get("template"))
+	if cfg == nil {
+		writeError(w, http.StatusNotFound, "integration not found")
+		return
+	}
+	s.configs = append(s.configs[:i], s.configs[i+1:]...)
+	writeJSON(w, http.StatusOK, map[string]any{"status": "success"})
+}
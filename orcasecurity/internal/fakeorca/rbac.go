@@ -0,0 +1,141 @@
+package fakeorca
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+// Unknown group and role ids answer 400 rather than 404, which GetGroup and GetCustomRole
+// rely on to report a deleted object.
+func (s *Server) mountRBAC(mux *http.ServeMux) {
+	groups := &collection{name: "group", idKey: "id", envelope: true, missing: http.StatusBadRequest}
+	s.mountCollection(mux, "/api/rbac/group", groups)
+	members := func(w http.ResponseWriter, r *http.Request, apply func(users []any, ids []any) []any) {
+		_, group := groups.find(r.PathValue("id"))
+		if group == nil {
+			writeError(w, groups.missingStatus(), "group not found")
+			return
+		}
+		body, ok := readObject(w, r)
+		if !ok {
+			return
+		}
+		ids, _ := body["user_ids"].([]any)
+		users, _ := group["users"].([]any)
+		group["users"] = apply(users, ids)
+		writeJSON(w, http.StatusOK, map[string]any{"status": "success"})
+	}
+	mux.HandleFunc("POST /api/rbac/group/{id}/users", func(w http.ResponseWriter, r *http.Request) {
+		members(w, r, func(users, ids []any) []any {
+			for _, id := range ids {
+				if !slices.Contains(users, id) {
+					users = append(users, id)
+				}
+			}
+			return users
+		})
+	})
+	mux.HandleFunc("DELETE /api/rbac/group/{id}/users", func(w http.ResponseWriter, r *http.Request) {
+		members(w, r, func(users, ids []any) []any {
+			return slices.DeleteFunc(users, func(u any) bool { return slices.Contains(ids, u) })
+		})
+	})
+
+	roles := &collection{name: "role", idKey: "id", envelope: true, missing: http.StatusBadRequest}
+	s.mountCollection(mux, "/api/rbac/roles", roles)
+	mux.HandleFunc("GET /api/rbac/role", func(w http.ResponseWriter, _ *http.Request) {
+		out := make([]map[string]any, 0, len(roles.items))
+		for _, role := range roles.items {
+			out = append(out, map[string]any{"id": role["id"], "name": role["name"]})
+		}
+		writeList(w, out)
+	})
+
+	s.groupAccess.mount(mux, "/api/rbac/access/group")
+	s.userAccess.mount(mux, "/api/rbac/access/user")
+}
+
+// accessStore is one of the RBAC access collections. They have no /{id} route: updates and
+// deletes carry the id in the body, and reads list the whole collection with the owner and
+// role nested as {"id": ...} objects.
+type accessStore struct {
+	ownerKey  string
+	paginated bool
+	rows      []map[string]any
+}
+
+func (a *accessStore) mount(mux *http.ServeMux, path string) {
+	mux.HandleFunc("GET "+path, func(w http.ResponseWriter, r *http.Request) {
+		out := a.rows
+		if a.paginated {
+			out = page(r, a.rows)
+		}
+		if out == nil {
+			out = []map[string]any{}
+		}
+		writeJSON(w, http.StatusOK, map[string]any{"status": "success", "total_items": len(a.rows), "data": out})
+	})
+	mux.HandleFunc("POST "+path, func(w http.ResponseWriter, r *http.Request) {
+		body, ok := readObject(w, r)
+		if !ok {
+			return
+		}
+		row := map[string]any{"id": uuid.NewString()}
+		a.apply(row, body)
+		a.rows = append(a.rows, row)
+		writeData(w, http.StatusCreated, map[string]any{"id": row["id"]})
+	})
+	mux.HandleFunc("PUT "+path, func(w http.ResponseWriter, r *http.Request) {
+		body, ok := readObject(w, r)
+		if !ok {
+			return
+		}
+		_, row := a.find(body["id"])
+		if row == nil {
+			writeError(w, http.StatusNotFound, a.ownerKey+" access not found")
+			return
+		}
+		a.apply(row, body)
+		writeData(w, http.StatusOK, row)
+	})
+	mux.HandleFunc("DELETE "+path, func(w http.ResponseWriter, r *http.Request) {
+		body, ok := readObject(w, r)
+		if !ok {
+			return
+		}
+		i, row := a.find(body["id"])
+		if row == nil {
+			writeError(w, http.StatusNotFound, a.ownerKey+" access not found")
+			return
+		}
+		a.rows = append(a.rows[:i], a.rows[i+1:]...)
+		writeJSON(w, http.StatusOK, map[string]any{"status": "success"})
+	})
+}
+
+func (a *accessStore) find(id any) (int, map[string]any) {
+	for i, row := range a.rows {
+		if row["id"] == id {
+			return i, row
+		}
+	}
+	return -1, nil
+}
+
+// apply converts the flat create/update body into the nested list-row shape.
+func (a *accessStore) apply(row, body map[string]any) {
+	row[a.ownerKey] = map[string]any{"id": body[a.ownerKey+"_id"]}
+	row["role"] = map[string]any{"id": body["role_id"]}
+	row["all_cloud_accounts"] = body["all_cloud_accounts"] == true
+	accounts := []any{}
+	if ids, ok := body["cloud_accounts"].([]any); ok {
+		for _, id := range ids {
+			accounts = append(accounts, map[string]any{"id": id})
+		}
+	}
+	row["cloud_accounts"] = accounts
+	row["shiftleft_projects"] = body["shiftleft_projects"]
+	row["user_filters"] = body["user_filters"]
+}
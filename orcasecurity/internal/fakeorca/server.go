@@ -0,0 +1,294 @@
+// Package fakeorca is a stateful, in-memory stand-in for the Orca Security API. It implements the
+// CRUD semantics of the endpoints the provider's resources use, closely enough for a full
+// resource.Test lifecycle (create, update, import, destroy) to run against it with no network.
+// It is imported only from _test.go files.
+//
+// Objects are kept as decoded JSON maps, so fields the fake does not know about round-trip
+// unchanged. Server-side behavior the provider depends on is modeled explicitly: generated ids,
+// automation priority clamping and renumbering, RBAC access ids carried in the body, and the
+// status codes each endpoint family answers for unknown ids.
+package fakeorca
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"terraform-provider-orcasecurity/orcasecurity/api_client"
+
+	"github.com/google/uuid"
+)
+
+// Token is the API token the fake accepts. Requests carrying any other token get a 401.
+const Token = "fakeorca-token"
+
+// OrganizationID is the organization every object created on the fake belongs to.
+const OrganizationID = "00000000-0000-4000-8000-000000000001"
+
+// Server is a running fake. All state is guarded by mu; handlers take it for their whole run,
+// which keeps multi-object updates such as priority renumbering atomic.
+type Server struct {
+	*httptest.Server
+
+	mu sync.Mutex
+
+	configs      []map[string]any
+	automations  []map[string]any
+	groupAccess  *accessStore
+	userAccess   *accessStore
+	remediations map[string]map[string]any
+	unhandled    []string
+}
+
+// New starts a fake and stops it when the test ends. Requests the fake has no handler for get a
+// 501 and fail the test at cleanup, so a provider change that starts calling a new endpoint
+// shows up as a missing fake route rather than a confusing downstream error.
+func New(t testing.TB) *Server {
+	t.Helper()
+	s := &Server{
+		groupAccess:  &accessStore{ownerKey: "group", paginated: true},
+		userAccess:   &accessStore{ownerKey: "user"},
+		remediations: map[string]map[string]any{},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/user/action", s.handleUserAction)
+	s.mountConfigs(mux)
+	s.mountAutomations(mux)
+	s.mountRBAC(mux)
+	s.mountSonar(mux)
+	s.mountCollection(mux, "/api/filters", &collection{name: "business unit", idKey: "filter_id", envelope: true})
+	s.mountCollection(mux, "/api/shiftleft/projects/", &collection{name: "shift left project", idKey: "id", onCreate: shiftLeftProjectDefaults})
+	s.mountCollection(mux, "/api/shiftleft/exceptions/", &collection{name: "cve exception list", idKey: "id"})
+	mux.HandleFunc("/", s.handleUnknown)
+
+	s.Server = httptest.NewServer(s.authenticated(mux))
+	t.Cleanup(func() {
+		s.Close()
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		for _, r := range s.unhandled {
+			t.Errorf("fakeorca: no handler for %s", r)
+		}
+	})
+	return s
+}
+
+// Client returns an API client pointed at the fake.
+func (s *Server) Client() *api_client.APIClient {
+	return &api_client.APIClient{
+		APIEndpoint: s.URL,
+		APIToken:    Token,
+		HTTPClient:  s.Server.Client(),
+	}
+}
+
+// Env returns the provider environment variables that point `provider "orcasecurity" {}` at
+// the fake. Tests apply them with t.Setenv. Plan-time Sonar query validation is switched off:
+// the fake only serves endpoints of the documented API and has no query parser to stand in for it.
+func (s *Server) Env() map[string]string {
+	return map[string]string{
+		"ORCASECURITY_API_ENDPOINT":                s.URL,
+		"ORCASECURITY_API_TOKEN":                   Token,
+		"ORCASECURITY_SKIP_SONAR_QUERY_VALIDATION": "true",
+	}
+}
+
+func (s *Server) authenticated(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Token "+Token {
+			writeError(w, http.StatusUnauthorized, "invalid token")
+			return
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (s *Server) handleUnknown(w http.ResponseWriter, r *http.Request) {
+	s.unhandled = append(s.unhandled, r.Method+" "+r.URL.String())
+	writeError(w, http.StatusNotImplemented, "fakeorca has no handler for "+r.Method+" "+r.URL.Path)
+}
+
+func (s *Server) handleUserAction(w http.ResponseWriter, _ *http.Request) {
+	writeData(w, http.StatusOK, map[string]any{
+		"organization_id":   OrganizationID,
+		"organization_name": "fakeorca",
+		"permissions":       []string{},
+	})
+}
+
+// collection is a plain id-keyed REST collection: list and create on the prefix, get, update
+// and delete on prefix/{id}. Most Orca endpoints the provider uses follow this shape and differ
+// only in the id field name, whether single objects come wrapped in {"data": ...}, and the
+// status returned for unknown ids.
+type collection struct {
+	name     string
+	idKey    string
+	envelope bool
+	// missing is the status for unknown ids; zero means 404.
+	missing int
+	// onCreate fills in server-computed fields of a new object.
+	onCreate func(item map[string]any)
+
+	items []map[string]any
+}
+
+func (c *collection) find(id string) (int, map[string]any) {
+	for i, item := range c.items {
+		if item[c.idKey] == id {
+			return i, item
+		}
+	}
+	return -1, nil
+}
+
+func (c *collection) missingStatus() int {
+	if c.missing != 0 {
+		return c.missing
+	}
+	return http.StatusNotFound
+}
+
+func (c *collection) write(w http.ResponseWriter, status int, item map[string]any) {
+	if c.envelope {
+		writeData(w, status, item)
+		return
+	}
+	writeJSON(w, status, item)
+}
+
+// mountCollection registers c under prefix. A prefix ending in "/" also puts a trailing slash
+// on the item route, matching the shift-left endpoints.
+func (s *Server) mountCollection(mux *http.ServeMux, prefix string, c *collection) {
+	itemRoute := strings.TrimSuffix(prefix, "/") + "/{id}"
+	if strings.HasSuffix(prefix, "/") {
+		itemRoute += "/{$}"
+		prefix += "{$}"
+	}
+
+	mux.HandleFunc("GET "+prefix, func(w http.ResponseWriter, _ *http.Request) {
+		writeList(w, c.items)
+	})
+	mux.HandleFunc("POST "+prefix, func(w http.ResponseWriter, r *http.Request) {
+		item, ok := readObject(w, r)
+		if !ok {
+			return
+		}
+		item[c.idKey] = uuid.NewString()
+		if c.onCreate != nil {
+			c.onCreate(item)
+		}
+		c.items = append(c.items, item)
+		c.write(w, http.StatusCreated, item)
+	})
+	mux.HandleFunc("GET "+itemRoute, func(w http.ResponseWriter, r *http.Request) {
+		_, item := c.find(r.PathValue("id"))
+		if item == nil {
+			writeError(w, c.missingStatus(), c.name+" not found")
+			return
+		}
+		c.write(w, http.StatusOK, item)
+	})
+	update := func(w http.ResponseWriter, r *http.Request) {
+		_, item := c.find(r.PathValue("id"))
+		if item == nil {
+			writeError(w, c.missingStatus(), c.name+" not found")
+			return
+		}
+		patch, ok := readObject(w, r)
+		if !ok {
+			return
+		}
+		merge(item, patch, c.idKey)
+		c.write(w, http.StatusOK, item)
+	}
+	mux.HandleFunc("PUT "+itemRoute, update)
+	mux.HandleFunc("PATCH "+itemRoute, update)
+	mux.HandleFunc("DELETE "+itemRoute, func(w http.ResponseWriter, r *http.Request) {
+		i, item := c.find(r.PathValue("id"))
+		if item == nil {
+			writeError(w, c.missingStatus(), c.name+" not found")
+			return
+		}
+		c.items = append(c.items[:i], c.items[i+1:]...)
+		writeJSON(w, http.StatusOK, map[string]any{"status": "success"})
+	})
+}
+
+// shiftLeftProjectDefaults mirrors the server attaching the built-in policy set when a project
+// is created with default_policies, which GetShiftLeftProject reads back from `policies`.
+func shiftLeftProjectDefaults(item map[string]any) {
+	if item["default_policies"] == true {
+		item["policies"] = []any{map[string]any{"id": uuid.NewString(), "builtin": true}}
+	}
+}
+
+// merge applies a PUT/PATCH body to a stored object. Orca's update endpoints accept partial
+// bodies, so keys the body leaves out keep their stored value. The id is never overwritten.
+func merge(item, patch map[string]any, idKey string) {
+	for k, v := range patch {
+		if k == idKey {
+			continue
+		}
+		item[k] = v
+	}
+}
+
+func readObject(w http.ResponseWriter, r *http.Request) (map[string]any, bool) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return nil, false
+	}
+	item := map[string]any{}
+	if len(body) > 0 && string(body) != "null" {
+		if err := json.Unmarshal(body, &item); err != nil {
+			writeError(w, http.StatusBadRequest, "invalid JSON body: "+err.Error())
+			return nil, false
+		}
+	}
+	return item, true
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeData(w http.ResponseWriter, status int, data any) {
+	writeJSON(w, status, map[string]any{"status": "success", "data": data})
+}
+
+func writeList(w http.ResponseWriter, items []map[string]any) {
+	if items == nil {
+		items = []map[string]any{}
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"status": "success", "total_items": len(items), "data": items})
+}
+
+func writeError(w http.ResponseWriter, status int, msg string) {
+	writeJSON(w, status, map[string]any{"status": "failure", "error": msg})
+}
+
+// page applies the limit/start_at_index offset paginator some list endpoints use.
+func page(r *http.Request, items []map[string]any) []map[string]any {
+	var limit, start int
+	fmt.Sscan(r.URL.Query().Get("limit"), &limit)
+	fmt.Sscan(r.URL.Query().Get("start_at_index"), &start)
+	if start > len(items) {
+		start = len(items)
+	}
+	end := len(items)
+	if limit > 0 && start+limit < end {
+		end = start + limit
+	}
+	return items[start:end]
+}
@@ -0,0 +1,209 @@
+package fakeorca
+
+import (
+	"context"
+	"testing"
+
+	"terraform-provider-orcasecurity/orcasecurity/api_client"
+)
+
+func TestServer_RejectsUnknownToken(t *testing.T) {
+	s := New(t)
+	client := s.Client()
+	client.APIToken = "wrong"
+	if _, err := client.GetCurrentOrganization(context.Background()); err == nil {
+		t.Fatal("expected a 401 for an unknown token")
+	}
+	if org, err := s.Client().GetCurrentOrganization(context.Background()); err != nil || org.ID != OrganizationID {
+		t.Fatalf("GetCurrentOrganization = %+v, %v", org, err)
+	}
+}
+
+func TestServer_ExternalServiceConfigLifecycle(t *testing.T) {
+	ctx := context.Background()
+	client := New(t).Client()
+
+	created, err := client.CreatePagerDutyConfig(ctx, api_client.PagerDutyExternalServiceConfig{
+		TemplateName: "oncall",
+		IsEnabled:    true,
+		Config:       api_client.PagerDutyConfig{IntegrationKey: "key-1"},
+	})
+	if err != nil || created.ID == "" {
+		t.Fatalf("create: %+v, %v", created, err)
+	}
+	if _, err := client.CreatePagerDutyConfig(ctx, api_client.PagerDutyExternalServiceConfig{TemplateName: "oncall"}); err == nil {
+		t.Error("duplicate template name was accepted")
+	}
+
+	// An update without the key keeps the stored one.
+	updated, err := client.UpdatePagerDutyConfig(ctx, "oncall", api_client.PagerDutyExternalServiceConfig{IsEnabled: false, IsDefault: true})
+	if err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	if updated.IsEnabled || !updated.IsDefault || updated.Config.IntegrationKey != "key-1" || updated.ID != created.ID {
+		t.Errorf("update result = %+v", updated)
+	}
+
+	if err := client.DeletePagerDutyConfig(ctx, "oncall"); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if got, err := client.GetPagerDutyConfig(ctx, "oncall"); err != nil || got != nil {
+		t.Errorf("get after delete = %+v, %v", got, err)
+	}
+}
+
+func TestServer_AutomationPriorities(t *testing.T) {
+	ctx := context.Background()
+	client := New(t).Client()
+
+	var ids []string
+	for _, name := range []string{"a", "b", "c"} {
+		a, err := client.CreateAutomationV2(ctx, api_client.AutomationV2{Name: name, Status: "enabled"}, false)
+		if err != nil {
+			t.Fatalf("create %s: %v", name, err)
+		}
+		if a.Priority == nil || *a.Priority != int64(len(ids)+1) {
+			t.Errorf("%s created with priority %v", name, a.Priority)
+		}
+		ids = append(ids, a.ID)
+	}
+
+	// Past-the-end priorities clamp to the last position.
+	moved, err := client.SetAutomationV2Priority(ctx, ids[0], 10)
+	if err != nil || moved.Priority == nil || *moved.Priority != 3 {
+		t.Fatalf("set priority = %+v, %v", moved, err)
+	}
+	assertOrder(t, client, ids[1], ids[2], ids[0])
+
+	if err := client.DeleteAutomationV2(ctx, ids[1]); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	assertOrder(t, client, ids[2], ids[0])
+}
+
+func assertOrder(t *testing.T, client *api_client.APIClient, want ...string) {
+	t.Helper()
+	all, err := client.ListAutomationsV2(context.Background())
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if len(all) != len(want) {
+		t.Fatalf("got %d automations, want %d", len(all), len(want))
+	}
+	for i, a := range all {
+		if a.ID != want[i] || a.Priority == nil || *a.Priority != int64(i+1) {
+			t.Errorf("position %d = %s (priority %v), want %s", i+1, a.ID, a.Priority, want[i])
+		}
+	}
+}
+
+func TestServer_GroupLifecycle(t *testing.T) {
+	ctx := context.Background()
+	client := New(t).Client()
+
+	group, err := client.CreateGroup(ctx, api_client.Group{Name: "g", Description: "d"})
+	if err != nil || group.ID == "" {
+		t.Fatalf("create: %+v, %v", group, err)
+	}
+	if err := client.AddGroupUsers(ctx, group.ID, []string{"u1", "u2"}); err != nil {
+		t.Fatalf("add users: %v", err)
+	}
+	if err := client.RemoveGroupUsers(ctx, group.ID, []string{"u1"}); err != nil {
+		t.Fatalf("remove users: %v", err)
+	}
+	got, err := client.GetGroup(ctx, group.ID)
+	if err != nil || len(got.Users) != 1 || got.Users[0] != "u2" {
+		t.Fatalf("get = %+v, %v", got, err)
+	}
+
+	if err := client.DeleteGroup(ctx, group.ID); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if exists, _ := client.DoesGroupExist(ctx, group.ID); exists {
+		t.Error("group still exists after delete")
+	}
+	if got, err := client.GetGroup(ctx, group.ID); err != nil || got != nil {
+		t.Errorf("get after delete = %+v, %v", got, err)
+	}
+}
+
+func TestServer_GroupAccessLifecycle(t *testing.T) {
+	ctx := context.Background()
+	client := New(t).Client()
+
+	created, err := client.CreateGroupAccess(ctx, api_client.GroupAccess{GroupID: "g1", RoleID: "r1", CloudAccounts: []string{"ca1"}})
+	if err != nil || created.ID == "" {
+		t.Fatalf("create: %+v, %v", created, err)
+	}
+	created.AllCloudAccounts = true
+	created.CloudAccounts = nil
+	updated, err := client.UpdateGroupAccess(ctx, *created)
+	if err != nil || !updated.AllCloudAccounts || len(updated.CloudAccounts) != 0 {
+		t.Fatalf("update = %+v, %v", updated, err)
+	}
+	list, err := client.ListGroupAccessForGroup(ctx, "g1")
+	if err != nil || len(list) != 1 || list[0].ID != created.ID || list[0].RoleID != "r1" {
+		t.Fatalf("list = %+v, %v", list, err)
+	}
+	if err := client.DeleteGroupAccess(ctx, created.ID); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if list, _ := client.ListGroupAccessForGroup(ctx, "g1"); len(list) != 0 {
+		t.Errorf("assignment still listed after delete: %+v", list)
+	}
+}
+
+func TestServer_CustomSonarAlertLifecycle(t *testing.T) {
+	ctx := context.Background()
+	client := New(t).Client()
+
+	alert, err := client.CreateCustomSonarAlert(ctx, api_client.CustomAlert{
+		Name:            "public buckets",
+		Rule:            "Bucket with PublicAccess = true",
+		RemediationText: &api_client.CustomSonarAlertRemediationText{Enable: true, Text: "close it"},
+	})
+	if err != nil || alert.ID == "" || alert.RuleType == "" {
+		t.Fatalf("create: %+v, %v", alert, err)
+	}
+	got, err := client.GetCustomSonarAlert(ctx, alert.ID)
+	if err != nil || got.Name != "public buckets" || got.RemediationText.Text != "close it" {
+		t.Fatalf("get = %+v, %v", got, err)
+	}
+
+	got.Name = "renamed"
+	got.RemediationText = nil
+	if _, err := client.UpdateCustomSonarAlert(ctx, alert.ID, *got); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	got, err = client.GetCustomSonarAlert(ctx, alert.ID)
+	if err != nil || got.Name != "renamed" || got.RemediationText.Text != "" {
+		t.Fatalf("get after update = %+v, %v", got, err)
+	}
+
+	if err := client.DeleteCustomSonarAlert(ctx, alert.ID); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if exists, err := client.DoesCustomSonarAlertExist(ctx, alert.ID); err != nil || exists {
+		t.Errorf("exists after delete = %v, %v", exists, err)
+	}
+}
+
+func TestServer_ShiftLeftProjectDefaultPolicies(t *testing.T) {
+	ctx := context.Background()
+	client := New(t).Client()
+
+	project, err := client.CreateShiftLeftProject(ctx, api_client.ShiftLeftProject{Name: "p", Key: "p", DefaultPolicies: true})
+	if err != nil || project.ID == "" {
+		t.Fatalf("create: %+v, %v", project, err)
+	}
+	got, err := client.GetShiftLeftProject(ctx, project.ID)
+	if err != nil || !got.DefaultPolicies {
+		t.Fatalf("get = %+v, %v", got, err)
+	}
+	if err := client.DeleteShiftLeftProject(ctx, project.ID); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if exists, _ := client.DoesShiftLeftProjectExist(ctx, project.ID); exists {
+		t.Error("project still exists after delete")
+	}
+}
@@ -0,0 +1,83 @@
+package fakeorca
+
+import (
+	"net/http"
+	"strings"
+)
+
+// alertCategories is the category catalog custom alerts are validated against.
+var alertCategories = []string{
+	"Authentication",
+	"Best practices",
+	"Data at risk",
+	"Data protection",
+	"IAM misconfigurations",
+	"Lateral movement",
+	"Logging and monitoring",
+	"Malicious activity",
+	"Malware",
+	"Neglected assets",
+	"Network misconfigurations",
+	"Source code vulnerabilities",
+	"Suspicious activity",
+	"System integrity",
+	"Vulnerabilities",
+	"Workload misconfigurations",
+}
+
+// /api/sonar/rules holds custom alerts keyed by rule_id. Each rule gets a generated rule_type,
+// which is also the key of its custom remediation text under /api/alerts/custom_remediation_text.
+func (s *Server) mountSonar(mux *http.ServeMux) {
+	rules := &collection{name: "sonar rule", idKey: "rule_id", envelope: true, onCreate: func(item map[string]any) {
+		item["rule_type"] = "orca_custom_" + strings.ReplaceAll(item["rule_id"].(string), "-", "")[:12]
+		item["custom"] = true
+		item["organization"] = OrganizationID
+	}}
+	s.mountCollection(mux, "/api/sonar/rules", rules)
+
+	mux.HandleFunc("GET /api/alerts/catalog/category", func(w http.ResponseWriter, _ *http.Request) {
+		writeData(w, http.StatusOK, alertCategories)
+	})
+
+	const remediationPath = "/api/alerts/custom_remediation_text"
+	mux.HandleFunc("GET "+remediationPath, func(w http.ResponseWriter, r *http.Request) {
+		text := s.remediations[r.URL.Query().Get("alert_type")]
+		if text == nil {
+			writeError(w, http.StatusNotFound, "remediation text not found")
+			return
+		}
+		writeJSON(w, http.StatusOK, text)
+	})
+	// PUT only updates; the client falls back to POST on a 404.
+	mux.HandleFunc("PUT "+remediationPath, func(w http.ResponseWriter, r *http.Request) {
+		body, ok := readObject(w, r)
+		if !ok {
+			return
+		}
+		alertType, _ := body["alert_type"].(string)
+		if s.remediations[alertType] == nil {
+			writeError(w, http.StatusNotFound, "remediation text not found")
+			return
+		}
+		s.remediations[alertType] = body
+		writeJSON(w, http.StatusOK, body)
+	})
+	mux.HandleFunc("POST "+remediationPath, func(w http.ResponseWriter, r *http.Request) {
+		body, ok := readObject(w, r)
+		if !ok {
+			return
+		}
+		alertType, _ := body["alert_type"].(string)
+		s.remediations[alertType] = body
+		writeJSON(w, http.StatusCreated, body)
+	})
+	mux.HandleFunc("DELETE "+remediationPath, func(w http.ResponseWriter, r *http.Request) {
+		body, ok := readObject(w, r)
+		if !ok {
+			return
+		}
+		alertType, _ := body["alert_type"].(string)
+		delete(s.remediations, alertType)
+		writeJSON(w, http.StatusOK, map[string]any{"status": "success"})
+	})
+}
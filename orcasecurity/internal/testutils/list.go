@@ -0,0 +1,79 @@
+package testutils
+
+import (
+	"context"
+	"testing"
+
+	"terraform-provider-orcasecurity/orcasecurity/api_client"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// ListQuery is one `terraform query` list block as RunList drives it.
+type ListQuery struct {
+	// Filters sets list block config attributes by name; unset attributes are null.
+	Filters         map[string]tftypes.Value
+	IncludeResource bool
+	Limit           int64
+}
+
+// RunList drives a list resource the way the framework does — configure, build the request from
+// the list config schema and the managed resource's schema and identity schema — and collects
+// every streamed result.
+func RunList(t *testing.T, lr list.ListResource, newResource func() resource.Resource, client *api_client.APIClient, q ListQuery) []list.ListResult {
+	t.Helper()
+	ctx := context.Background()
+
+	if c, ok := lr.(list.ListResourceWithConfigure); ok {
+		c.Configure(ctx, resource.ConfigureRequest{ProviderData: client}, &resource.ConfigureResponse{})
+	}
+
+	configSchema := list.ListResourceSchemaResponse{}
+	lr.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &configSchema)
+	configType := configSchema.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := map[string]tftypes.Value{}
+	for name, typ := range configType.AttributeTypes {
+		values[name] = tftypes.NewValue(typ, nil)
+		if v, ok := q.Filters[name]; ok {
+			values[name] = v
+		}
+	}
+
+	r := newResource()
+	resourceSchema := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &resourceSchema)
+	identitySchema := resource.IdentitySchemaResponse{}
+	r.(resource.ResourceWithIdentity).IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identitySchema)
+
+	stream := list.ListResultsStream{}
+	lr.List(ctx, list.ListRequest{
+		Config:                 tfsdk.Config{Schema: configSchema.Schema, Raw: tftypes.NewValue(configType, values)},
+		IncludeResource:        q.IncludeResource,
+		Limit:                  q.Limit,
+		ResourceSchema:         resourceSchema.Schema,
+		ResourceIdentitySchema: identitySchema.IdentitySchema,
+	}, &stream)
+
+	var results []list.ListResult
+	if stream.Results == nil {
+		return results
+	}
+	for result := range stream.Results {
+		results = append(results, result)
+	}
+	return results
+}
+
+// IdentityString returns one string attribute of a list result's identity.
+func IdentityString(t *testing.T, result list.ListResult, name string) string {
+	t.Helper()
+	var out string
+	if diags := result.Identity.GetAttribute(context.Background(), path.Root(name), &out); diags.HasError() {
+		t.Fatalf("identity %s: %v", name, diags)
+	}
+	return out
+}
@@ -32,7 +32,7 @@ type MappingSuite[C any] struct {
 	// BuildPayload / Extract are the variant's package-level mapping funcs — the same symbols
 	// its production Spec is wired with.
 	BuildPayload func(context.Context, cc.State, *diag.Diagnostics) api_client.ConfigEnvelope[C]
-	Extract      func(*api_client.ConfigEnvelope[C], cc.State, *diag.Diagnostics) cc.APIObject
+	Extract      func(context.Context, *api_client.ConfigEnvelope[C], cc.State, *diag.Diagnostics) cc.APIObject
 
 	// TemplateName is written into the built payload's common fields and asserted back.
 	TemplateName string
@@ -70,7 +70,7 @@ func RunMappingSuite[C any](t *testing.T, s MappingSuite[C]) {
 			o := &api_client.ConfigEnvelope[C]{ID: "uuid", TemplateName: "t"}
 			st := chk.State()
 			var diags diag.Diagnostics
-			s.Extract(o, st, &diags)
+			s.Extract(context.Background(), o, st, &diags)
 			requireNoDiags(t, diags)
 			chk.Check(t, st)
 		})
@@ -139,7 +139,7 @@ func (s MappingSuite[C]) checkExtract(t *testing.T) {
 		}
 		st := s.EchoState()
 		var diags diag.Diagnostics
-		got := s.Extract(o, st, &diags)
+		got := s.Extract(context.Background(), o, st, &diags)
 		requireNoDiags(t, diags)
 		if got.ID != o.ID || got.TemplateName != o.TemplateName {
 			t.Errorf("id/template mismatch: %+v", got)
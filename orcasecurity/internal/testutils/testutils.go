@@ -57,6 +57,9 @@ type VariantResourceSpec struct {
 	TypeName string
 	// Secrets are string attributes that must be Required and Sensitive.
 	Secrets []string
+	// WriteOnlySecrets are Optional Sensitive string attributes with a "<name>_wo" write-only
+	// twin and a "<name>_wo_version" trigger (config_integration_common.Spec.WriteOnlySecrets).
+	WriteOnlySecrets []string
 	// PlainRequired are string attributes that must be Required and non-sensitive.
 	PlainRequired []string
 	// Forbidden attributes must be absent (e.g. business_units on no-BU variants).
@@ -88,6 +91,7 @@ func CheckVariantResource(t *testing.T, spec VariantResourceSpec) {
 	checkAttrsAbsent(t, attrs, spec.Forbidden)
 	checkRequiredStrings(t, attrs, spec.Secrets, true)
 	checkRequiredStrings(t, attrs, spec.PlainRequired, false)
+	checkWriteOnlySecrets(t, attrs, spec.WriteOnlySecrets)
 	checkStateTagCoverage(t, attrs, spec.State)
 }
 
@@ -166,6 +170,23 @@ func checkRequiredStrings(t *testing.T, attrs map[string]schema.Attribute, names
 	}
 }
 
+// checkWriteOnlySecrets asserts each named secret is Optional and Sensitive and has the
+// "_wo" / "_wo_version" pair that buildSchema derives for it.
+func checkWriteOnlySecrets(t *testing.T, attrs map[string]schema.Attribute, names []string) {
+	t.Helper()
+	for _, name := range names {
+		if sa, ok := attrs[name].(schema.StringAttribute); !ok || !sa.Optional || !sa.Sensitive || sa.WriteOnly {
+			t.Errorf("%s must be an Optional Sensitive string attribute, got %#v", name, attrs[name])
+		}
+		if wo, ok := attrs[name+"_wo"].(schema.StringAttribute); !ok || !wo.Optional || !wo.Sensitive || !wo.WriteOnly {
+			t.Errorf("%s_wo must be an Optional Sensitive write-only string attribute, got %#v", name, attrs[name+"_wo"])
+		}
+		if v, ok := attrs[name+"_wo_version"].(schema.Int64Attribute); !ok || !v.Optional {
+			t.Errorf("%s_wo_version must be an Optional int64 attribute, got %#v", name, attrs[name+"_wo_version"])
+		}
+	}
+}
+
 func checkStateTagCoverage(t *testing.T, attrs map[string]schema.Attribute, state interface{}) {
 	t.Helper()
 	tags := TfsdkTags(state)
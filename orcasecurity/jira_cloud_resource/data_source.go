@@ -72,7 +72,7 @@ func (ds *jiraCloudDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		return
 	}
 
-	current, err := ds.apiClient.GetJiraCloudResourceByName(state.Name.ValueString())
+	current, err := ds.apiClient.GetJiraCloudResourceByName(ctx, state.Name.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error looking up Jira Cloud resource",
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -131,6 +132,7 @@ func encodeMappings(s *state, cfg *api_client.JiraCloudTemplateConfig, diags *di
 func NewJiraCloudTemplateResource() resource.Resource {
 	return cc.New(cc.Spec[api_client.JiraCloudTemplate]{
 		TypeNameSuffix:        "_integration_jira_cloud_template",
+		ServiceName:           api_client.JiraCloudServiceName,
 		UIName:                "Jira Cloud template",
 		Description:           "Manage a Jira Cloud template in Orca. Creates an external service config of `service_name = \"jira\"` linked to an existing Jira Cloud OAuth resource. Holds the project, issue-type, field-mapping, and status-mapping settings used when Orca opens Jira issues.",
 		SupportsBusinessUnits: true,
@@ -154,7 +156,7 @@ func NewJiraCloudTemplateResource() resource.Resource {
 				BusinessUnits: common.BusinessUnitsToAPI(ctx, s.BusinessUnits, diags),
 			}
 		},
-		Extract: func(o *api_client.JiraCloudTemplate, st cc.State, diags *diag.Diagnostics) cc.APIObject {
+		Extract: func(_ context.Context, o *api_client.JiraCloudTemplate, st cc.State, diags *diag.Diagnostics) cc.APIObject {
 			s := st.(*state)
 			if o.Config.ResourceID != "" {
 				s.ResourceID = types.StringValue(o.Config.ResourceID)
@@ -184,5 +186,11 @@ func NewJiraCloudTemplateResource() resource.Resource {
 		Get:    (*api_client.APIClient).GetJiraCloudTemplate,
 		Update: (*api_client.APIClient).UpdateJiraCloudTemplate,
 		Delete: (*api_client.APIClient).DeleteJiraCloudTemplate,
+		List:   (*api_client.APIClient).ListJiraCloudTemplates,
 	})
 }
+
+// NewJiraCloudTemplateListResource lists the integrations managed by NewJiraCloudTemplateResource.
+func NewJiraCloudTemplateListResource() list.ListResource {
+	return cc.NewList(NewJiraCloudTemplateResource)
+}
@@ -58,7 +58,7 @@ func (ds *jiraTemplateDataSource) Read(ctx context.Context, req datasource.ReadR
 	var state jiraTemplateStateModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 
-	item, err := ds.apiClient.GetJiraTemplateByName(state.Name.ValueString())
+	item, err := ds.apiClient.GetJiraTemplateByName(ctx, state.Name.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to read Jira templates", err.Error())
 		return
@@ -0,0 +1,180 @@
+// Package list_common implements the list resources behind `terraform query`. A list resource
+// enumerates the remote objects of one managed resource type so Terraform can generate import
+// blocks (and, with include_resource, configuration) for them.
+//
+// Each resource package supplies a Spec: the managed resource constructor, the filter
+// attributes of its `list` block and a Fetch func returning the identities that match. Full
+// resource data is produced by running the managed resource's own ImportState and Read on each
+// identity — the same path `terraform import` takes — so listed state never drifts from what
+// the resource itself would write.
+package list_common
+
+import (
+	"context"
+	"strings"
+
+	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	"terraform-provider-orcasecurity/orcasecurity/identity_common"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Item is one remote object found by Fetch.
+type Item struct {
+	// Identity holds the values of every attribute of the managed resource's identity schema.
+	Identity map[string]string
+	// DisplayName is shown next to the result in the CLI, typically the object name.
+	DisplayName string
+}
+
+// Spec describes the list resource of one managed resource type.
+type Spec struct {
+	// NewResource constructs the managed resource. Its type name names the list resource and
+	// its ImportState and Read fill each result when the query sets include_resource.
+	NewResource func() resource.Resource
+
+	Description string
+
+	// Attributes are the filters accepted in the `list` block's `config`. All must be optional.
+	Attributes map[string]schema.Attribute
+
+	// Fetch returns the objects matching the filters in config.
+	Fetch func(ctx context.Context, client *api_client.APIClient, config tfsdk.Config) ([]Item, diag.Diagnostics)
+}
+
+type listResource struct {
+	client *api_client.APIClient
+	spec   Spec
+}
+
+// New returns the list resource described by spec.
+func New(spec Spec) list.ListResource {
+	return &listResource{spec: spec}
+}
+
+func (l *listResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	l.spec.NewResource().Metadata(ctx, req, resp)
+}
+
+func (l *listResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	l.client = req.ProviderData.(*api_client.APIClient)
+}
+
+func (l *listResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: l.spec.Description,
+		Attributes:  l.spec.Attributes,
+	}
+}
+
+func (l *listResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	if l.client == nil {
+		var diags diag.Diagnostics
+		diags.AddError("Error listing resources", "API client not configured.")
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	items, diags := l.spec.Fetch(ctx, l.client, req.Config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	stream.Results = func(push func(list.ListResult) bool) {
+		if len(diags) > 0 && !push(list.ListResult{Diagnostics: diags}) {
+			return
+		}
+		for i, item := range items {
+			if req.Limit > 0 && int64(i) >= req.Limit {
+				return
+			}
+			result := req.NewListResult(ctx)
+			result.DisplayName = item.DisplayName
+			result.Diagnostics.Append(identity_common.Set(ctx, result.Identity, item.Identity)...)
+			if req.IncludeResource && !result.Diagnostics.HasError() {
+				l.readResource(ctx, &result)
+			}
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+// readResource fills result.Resource by importing result.Identity through the managed resource.
+func (l *listResource) readResource(ctx context.Context, result *list.ListResult) {
+	r := l.spec.NewResource()
+	if c, ok := r.(resource.ResourceWithConfigure); ok {
+		configureResp := resource.ConfigureResponse{}
+		c.Configure(ctx, resource.ConfigureRequest{ProviderData: l.client}, &configureResp)
+		result.Diagnostics.Append(configureResp.Diagnostics...)
+	}
+	importer, ok := r.(resource.ResourceWithImportState)
+	if !ok || result.Diagnostics.HasError() {
+		return
+	}
+
+	importResp := resource.ImportStateResponse{
+		State:    tfsdk.State{Schema: result.Resource.Schema, Raw: result.Resource.Raw},
+		Identity: result.Identity,
+	}
+	importer.ImportState(ctx, resource.ImportStateRequest{Identity: result.Identity}, &importResp)
+	result.Diagnostics.Append(importResp.Diagnostics...)
+	if result.Diagnostics.HasError() {
+		return
+	}
+
+	readResp := resource.ReadResponse{State: importResp.State, Identity: importResp.Identity}
+	r.Read(ctx, resource.ReadRequest{State: importResp.State, Identity: importResp.Identity}, &readResp)
+	result.Diagnostics.Append(readResp.Diagnostics...)
+	if result.Diagnostics.HasError() {
+		return
+	}
+	if readResp.State.Raw.IsNull() {
+		result.Diagnostics.AddWarning("Listed object no longer exists",
+			"The object was deleted after it was listed, so only its identity is returned.")
+		return
+	}
+	*result.Resource = tfsdk.Resource{Schema: readResp.State.Schema, Raw: readResp.State.Raw}
+}
+
+// NameContainsAttribute is the usual free-text filter on an object's name.
+func NameContainsAttribute(subject string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:    true,
+		Description: "Only list " + subject + " whose name contains this value (case-insensitive).",
+	}
+}
+
+// Contains reports whether value contains filter, ignoring case. A null or empty filter
+// matches everything.
+func Contains(value string, filter types.String) bool {
+	if filter.IsNull() || filter.IsUnknown() || filter.ValueString() == "" {
+		return true
+	}
+	return strings.Contains(strings.ToLower(value), strings.ToLower(filter.ValueString()))
+}
+
+// Is reports whether value equals filter, ignoring case. A null or empty filter matches
+// everything.
+func Is(value string, filter types.String) bool {
+	if filter.IsNull() || filter.IsUnknown() || filter.ValueString() == "" {
+		return true
+	}
+	return strings.EqualFold(value, filter.ValueString())
+}
+
+// BoolIs reports whether value equals filter. A null filter matches everything.
+func BoolIs(value bool, filter types.Bool) bool {
+	if filter.IsNull() || filter.IsUnknown() {
+		return true
+	}
+	return value == filter.ValueBool()
+}
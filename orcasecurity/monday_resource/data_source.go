@@ -72,7 +72,7 @@ func (ds *mondayDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
-	current, err := ds.apiClient.GetMondayResourceByName(state.Name.ValueString())
+	current, err := ds.apiClient.GetMondayResourceByName(ctx, state.Name.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error looking up Monday resource",
@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	"terraform-provider-orcasecurity/orcasecurity/apierror_common"
+	"terraform-provider-orcasecurity/orcasecurity/identity_common"
+	"terraform-provider-orcasecurity/orcasecurity/timeouts_common"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -19,6 +21,7 @@ var (
 	_ resource.Resource                = &mondayResource{}
 	_ resource.ResourceWithConfigure   = &mondayResource{}
 	_ resource.ResourceWithImportState = &mondayResource{}
+	_ resource.ResourceWithIdentity    = &mondayResource{}
 )
 
 type mondayResource struct {
@@ -30,6 +33,8 @@ type mondayResourceModel struct {
 	Name        types.String `tfsdk:"name"`
 	APIToken    types.String `tfsdk:"api_token"`
 	AccountSlug types.String `tfsdk:"account_slug"`
+
+	Timeouts timeouts_common.Value `tfsdk:"timeouts"`
 }
 
 func NewMondayResource() resource.Resource {
@@ -47,7 +52,7 @@ func (r *mondayResource) Configure(_ context.Context, req resource.ConfigureRequ
 	r.apiClient = req.ProviderData.(*api_client.APIClient)
 }
 
-func (r *mondayResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *mondayResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manage a Monday.com credentials resource in Orca. Creates an external service resource of `service_name = \"monday\"` from a Monday API token. Use the returned `id` as `resource_id` on `orcasecurity_integration_monday_template`.",
 		Attributes: map[string]schema.Attribute{
@@ -81,6 +86,9 @@ func (r *mondayResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts_common.Block(ctx),
+		},
 	}
 }
 
@@ -116,27 +124,32 @@ func (r *mondayResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	created, err := r.apiClient.CreateMondayResource(r.buildPayload(plan))
+	ctx, cancel := timeouts_common.Create(ctx, plan.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
+	created, err := r.apiClient.CreateMondayResource(ctx, r.buildPayload(plan))
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating Monday resource",
-			fmt.Sprintf("Could not create Monday resource: %s", err.Error()),
-		)
+		apierror_common.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, "Error creating Monday resource", "Could not create Monday resource", err)
 		return
 	}
 
 	applyResponse(&plan, created)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(identity_common.SetID(ctx, resp.Identity, plan.ID.ValueString())...)
 }
 
 func (r *mondayResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(identity_common.SetIDFromState(ctx, resp.Identity, req.State)...)
 	var state mondayResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	current, err := r.apiClient.GetMondayResource(state.ID.ValueString())
+	ctx, cancel := timeouts_common.Read(ctx, state.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
+	current, err := r.apiClient.GetMondayResource(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading Monday resource",
@@ -159,24 +172,25 @@ func (r *mondayResource) Read(ctx context.Context, req resource.ReadRequest, res
 }
 
 func (r *mondayResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(identity_common.SetIDFromState(ctx, resp.Identity, req.State)...)
 	var plan mondayResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := timeouts_common.Update(ctx, plan.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
 	var state mondayResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	updated, err := r.apiClient.UpdateMondayResource(state.ID.ValueString(), r.buildPayload(plan))
+	updated, err := r.apiClient.UpdateMondayResource(ctx, state.ID.ValueString(), r.buildPayload(plan))
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating Monday resource",
-			fmt.Sprintf("Could not update Monday resource %s: %s", state.ID.ValueString(), err.Error()),
-		)
+		apierror_common.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, "Error updating Monday resource", fmt.Sprintf("Could not update Monday resource %s", state.ID.ValueString()), err)
 		return
 	}
 
@@ -191,7 +205,10 @@ func (r *mondayResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	if err := r.apiClient.DeleteMondayResource(state.ID.ValueString()); err != nil {
+	ctx, cancel := timeouts_common.Delete(ctx, state.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
+	if err := r.apiClient.DeleteMondayResource(ctx, state.ID.ValueString()); err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting Monday resource",
 			fmt.Sprintf("Could not delete Monday resource %s: %s", state.ID.ValueString(), err.Error()),
@@ -201,5 +218,9 @@ func (r *mondayResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *mondayResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	identity_common.ImportID(ctx, req, resp)
+}
+
+func (r *mondayResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity_common.IDSchema()
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -109,6 +110,7 @@ func encodeMappings(s *state, cfg *api_client.MondayTemplateConfig, diags *diag.
 func NewMondayTemplateResource() resource.Resource {
 	return cc.New(cc.Spec[api_client.MondayTemplate]{
 		TypeNameSuffix:        "_integration_monday_template",
+		ServiceName:           api_client.MondayServiceName,
 		UIName:                "Monday template",
 		Description:           "Manage a Monday.com template in Orca. Creates an external service config of `service_name = \"monday\"` linked to an existing Monday resource. Holds the board, group, field-mapping, and status-mapping settings used when Orca opens Monday items.",
 		SupportsBusinessUnits: true,
@@ -131,7 +133,7 @@ func NewMondayTemplateResource() resource.Resource {
 				BusinessUnits: common.BusinessUnitsToAPI(ctx, s.BusinessUnits, diags),
 			}
 		},
-		Extract: func(o *api_client.MondayTemplate, st cc.State, diags *diag.Diagnostics) cc.APIObject {
+		Extract: func(_ context.Context, o *api_client.MondayTemplate, st cc.State, diags *diag.Diagnostics) cc.APIObject {
 			s := st.(*state)
 			if o.Resource != "" {
 				s.ResourceID = types.StringValue(o.Resource)
@@ -158,5 +160,11 @@ func NewMondayTemplateResource() resource.Resource {
 		Get:    (*api_client.APIClient).GetMondayTemplate,
 		Update: (*api_client.APIClient).UpdateMondayTemplate,
 		Delete: (*api_client.APIClient).DeleteMondayTemplate,
+		List:   (*api_client.APIClient).ListMondayTemplates,
 	})
 }
+
+// NewMondayTemplateListResource lists the integrations managed by NewMondayTemplateResource.
+func NewMondayTemplateListResource() list.ListResource {
+	return cc.NewList(NewMondayTemplateResource)
+}
@@ -0,0 +1,70 @@
+package ms_teams_template
+
+import (
+	"context"
+	"encoding/json"
+	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	cc "terraform-provider-orcasecurity/orcasecurity/config_integration_common"
+	common "terraform-provider-orcasecurity/orcasecurity/integrations_common"
+	"terraform-provider-orcasecurity/orcasecurity/internal/testutils"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The shared suite covers the envelope plumbing; the webhook URL is write-only and the mapping
+// round-trips through the orca shorthand.
+func TestMapping(t *testing.T) {
+	testutils.RunMappingSuite(t, testutils.MappingSuite[api_client.MsTeamsTemplateConfig]{
+		BuildPayload:          buildPayload,
+		Extract:               extract,
+		TemplateName:          "tf-acc-test-teams",
+		SupportsBusinessUnits: true,
+		FilledState: func() cc.State {
+			return &state{
+				WebhookURL:  types.StringValue("https://example.webhook.office.com/webhookb2/secret"),
+				MappingJSON: common.NewOrcaMappingValue(`{"title":["alert_title"],"description":[{"custom":"Orca alert"}]}`),
+			}
+		},
+		CheckConfig: func(t *testing.T, c api_client.MsTeamsTemplateConfig) {
+			testutils.AssertEq(t, "webhook_url", c.WebhookURL, "https://example.webhook.office.com/webhookb2/secret")
+			testutils.AssertEq(t, "mapping", string(c.Mapping), `{"description":[{"custom":"Orca alert"}],"title":[{"orca":"alert_title"}]}`)
+		},
+		EchoConfig: api_client.MsTeamsTemplateConfig{Mapping: json.RawMessage(`{"title":[{"orca":"asset_name"}]}`)},
+		EchoState:  func() cc.State { return &state{} },
+		CheckEchoed: func(t *testing.T, st cc.State) {
+			testutils.AssertEq(t, "echoed mapping", st.(*state).MappingJSON.ValueString(), `{"title":["asset_name"]}`)
+		},
+		ZeroConfigChecks: []testutils.StateCheck{
+			{
+				Name:  "sensitive webhook url untouched",
+				State: func() cc.State { return &state{WebhookURL: types.StringValue("https://planned")} },
+				Check: func(t *testing.T, st cc.State) {
+					testutils.AssertEq(t, "planned webhook_url", st.(*state).WebhookURL.ValueString(), "https://planned")
+				},
+			},
+		},
+	})
+}
+
+// The shorthand form in HCL must stay semantically equal to the expanded form Orca echoes back,
+// so a refresh after create shows no diff.
+func TestExtract_ShorthandMappingIsStable(t *testing.T) {
+	planned := common.NewOrcaMappingValue(`{"title":["alert_title","asset_name"]}`)
+	s := &state{MappingJSON: planned}
+	o := &api_client.MsTeamsTemplate{Config: api_client.MsTeamsTemplateConfig{
+		Mapping: json.RawMessage(`{"title":[{"orca":"alert_title"},{"orca":"asset_name"}]}`),
+	}}
+	var diags diag.Diagnostics
+	extract(context.Background(), o, s, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+	eq, d := s.MappingJSON.StringSemanticEquals(context.Background(), planned)
+	if d.HasError() || !eq {
+		t.Errorf("mapping drifted: %s (%v)", s.MappingJSON.ValueString(), d)
+	}
+}
+
+var _ cc.State = &state{}
@@ -0,0 +1,95 @@
+package ms_teams_template
+
+import (
+	"context"
+	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	cc "terraform-provider-orcasecurity/orcasecurity/config_integration_common"
+	common "terraform-provider-orcasecurity/orcasecurity/integrations_common"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// state is the Microsoft Teams template Terraform model. The webhook URL is a secret: Orca
+// never returns it, so Extract leaves the planned value in place.
+type state struct {
+	cc.CommonFieldsWithBU
+	WebhookURL          types.String       `tfsdk:"webhook_url"`
+	WebhookURLWO        types.String       `tfsdk:"webhook_url_wo"`
+	WebhookURLWOVersion types.Int64        `tfsdk:"webhook_url_wo_version"`
+	MappingJSON         common.OrcaMapping `tfsdk:"mapping_json"`
+}
+
+func variantAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"webhook_url": schema.StringAttribute{
+			Required:    true,
+			Sensitive:   true,
+			Description: "Incoming webhook URL of the Teams channel Orca posts to. Stored in Orca's secret store; never returned by the API.",
+			Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+		"mapping_json": schema.StringAttribute{
+			Required:    true,
+			CustomType:  common.OrcaMappingType{},
+			Description: "JSON-encoded `mapping` object. Each key is a message section (for example, `title` or `description`); values are lists of `{ \"orca\": \"<alert_field>\" }` entries, which may be written as bare alert field names, or `{ \"custom\": \"<literal>\" }` objects.",
+			Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+	}
+}
+
+// buildPayload converts the planned state into the Microsoft Teams API payload.
+func buildPayload(ctx context.Context, st cc.State, diags *diag.Diagnostics) api_client.MsTeamsTemplate {
+	s := st.(*state)
+	mapping, d := common.DecodeOrcaMappingField(s.MappingJSON, "mapping_json")
+	diags.Append(d...)
+	return api_client.MsTeamsTemplate{
+		TemplateName:  s.TemplateName.ValueString(),
+		IsEnabled:     s.IsEnabled.ValueBool(),
+		IsDefault:     s.IsDefault.ValueBool(),
+		BusinessUnits: common.BusinessUnitsToAPI(ctx, s.BusinessUnits, diags),
+		Config: api_client.MsTeamsTemplateConfig{
+			WebhookURL: s.WebhookURL.ValueString(),
+			Mapping:    mapping,
+		},
+	}
+}
+
+// extract maps the API envelope back onto state. The mapping is stored verbatim (its type's
+// semantic equality absorbs the orca shorthand); the webhook URL is never returned.
+func extract(_ context.Context, o *api_client.MsTeamsTemplate, st cc.State, diags *diag.Diagnostics) cc.APIObject {
+	s := st.(*state)
+	mapping, d := common.EncodeOrcaMappingField(o.Config.Mapping, s.MappingJSON)
+	diags.Append(d...)
+	s.MappingJSON = mapping
+	return cc.APIObject{ID: o.ID, TemplateName: o.TemplateName, IsEnabled: o.IsEnabled, IsDefault: o.IsDefault, BusinessUnits: o.BusinessUnits}
+}
+
+func NewMsTeamsTemplateResource() resource.Resource {
+	return cc.New(cc.Spec[api_client.MsTeamsTemplate]{
+		TypeNameSuffix:        "_integration_ms_teams_template",
+		ServiceName:           api_client.MsTeamsServiceName,
+		UIName:                "Microsoft Teams template",
+		Description:           "Manage a Microsoft Teams template in Orca. Creates an external service config of `service_name = \"ms_teams\"` that posts alert messages to a Teams channel through an incoming webhook. The webhook URL is stored in Orca's secret store and is never returned by the API.",
+		SupportsBusinessUnits: true,
+		VariantAttributes:     variantAttributes(),
+		WriteOnlySecrets:      []string{"webhook_url"},
+		NewState:              func() cc.State { return &state{} },
+		BuildPayload:          buildPayload,
+		Extract:               extract,
+		Create:                (*api_client.APIClient).CreateMsTeamsTemplate,
+		Get:                   (*api_client.APIClient).GetMsTeamsTemplate,
+		Update:                (*api_client.APIClient).UpdateMsTeamsTemplate,
+		Delete:                (*api_client.APIClient).DeleteMsTeamsTemplate,
+		List:                  (*api_client.APIClient).ListMsTeamsTemplates,
+	})
+}
+
+// NewMsTeamsTemplateListResource lists the integrations managed by NewMsTeamsTemplateResource.
+func NewMsTeamsTemplateListResource() list.ListResource {
+	return cc.NewList(NewMsTeamsTemplateResource)
+}
@@ -0,0 +1,17 @@
+package ms_teams_template
+
+import (
+	"terraform-provider-orcasecurity/orcasecurity/internal/testutils"
+	"testing"
+)
+
+// The webhook URL is a secret with a write-only variant; mapping_json is required but not sensitive.
+func TestMsTeamsTemplateResource_SchemaContract(t *testing.T) {
+	testutils.CheckVariantResource(t, testutils.VariantResourceSpec{
+		NewResource:      NewMsTeamsTemplateResource,
+		TypeName:         "orcasecurity_integration_ms_teams_template",
+		WriteOnlySecrets: []string{"webhook_url"},
+		PlainRequired:    []string{"mapping_json", "template_name"},
+		State:            &state{},
+	})
+}
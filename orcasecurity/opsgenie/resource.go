@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -16,7 +17,9 @@ import (
 
 type state struct {
 	cc.CommonFieldsWithBU
-	OpsgenieKey types.String `tfsdk:"opsgenie_key"`
+	OpsgenieKey          types.String `tfsdk:"opsgenie_key"`
+	OpsgenieKeyWO        types.String `tfsdk:"opsgenie_key_wo"`
+	OpsgenieKeyWOVersion types.Int64  `tfsdk:"opsgenie_key_wo_version"`
 }
 
 // buildPayload converts the planned state into the Opsgenie API payload.
@@ -32,13 +35,14 @@ func buildPayload(ctx context.Context, st cc.State, diags *diag.Diagnostics) api
 }
 
 // extract maps the API envelope back onto state; the key is never returned so state is untouched.
-func extract(o *api_client.OpsgenieExternalServiceConfig, _ cc.State, _ *diag.Diagnostics) cc.APIObject {
+func extract(_ context.Context, o *api_client.OpsgenieExternalServiceConfig, _ cc.State, _ *diag.Diagnostics) cc.APIObject {
 	return cc.APIObject{ID: o.ID, TemplateName: o.TemplateName, IsEnabled: o.IsEnabled, IsDefault: o.IsDefault, BusinessUnits: o.BusinessUnits}
 }
 
 func NewOpsgenieResource() resource.Resource {
 	return cc.New(cc.Spec[api_client.OpsgenieExternalServiceConfig]{
 		TypeNameSuffix:        "_integration_opsgenie",
+		ServiceName:           api_client.OpsgenieServiceName,
 		UIName:                "Opsgenie integration",
 		Description:           "Manage an Opsgenie integration in Orca. Creates an external service config of `service_name = \"opsgenie\"` and stores the Opsgenie API key in Orca's secret store.",
 		SupportsBusinessUnits: true,
@@ -50,12 +54,19 @@ func NewOpsgenieResource() resource.Resource {
 				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
 			},
 		},
-		NewState:     func() cc.State { return &state{} },
-		BuildPayload: buildPayload,
-		Extract:      extract,
-		Create:       (*api_client.APIClient).CreateOpsgenieConfig,
-		Get:          (*api_client.APIClient).GetOpsgenieConfig,
-		Update:       (*api_client.APIClient).UpdateOpsgenieConfig,
-		Delete:       (*api_client.APIClient).DeleteOpsgenieConfig,
+		WriteOnlySecrets: []string{"opsgenie_key"},
+		NewState:         func() cc.State { return &state{} },
+		BuildPayload:     buildPayload,
+		Extract:          extract,
+		Create:           (*api_client.APIClient).CreateOpsgenieConfig,
+		Get:              (*api_client.APIClient).GetOpsgenieConfig,
+		Update:           (*api_client.APIClient).UpdateOpsgenieConfig,
+		Delete:           (*api_client.APIClient).DeleteOpsgenieConfig,
+		List:             (*api_client.APIClient).ListOpsgenieConfigs,
 	})
 }
+
+// NewOpsgenieListResource lists the integrations managed by NewOpsgenieResource.
+func NewOpsgenieListResource() list.ListResource {
+	return cc.NewList(NewOpsgenieResource)
+}
@@ -8,10 +8,16 @@ import (
 // Create/update/import via the shared simple-key runner. The backend accepts Opsgenie keys of any
 // shape (verified against the lab), so a fake key works without a real Opsgenie tenant.
 func TestAccOpsgenieResource(t *testing.T) {
-	acctest.RunSimpleKeyIntegrationTest(t, acctest.SimpleKeyIntegrationSpec{
-		ResourceType: "orcasecurity_integration_opsgenie",
-		TemplateName: "tf-acc-test-opsgenie",
-		KeyAttr:      "opsgenie_key",
-		KeyValue:     "fake-opsgenie-key-abc123",
-	})
+	acctest.RunSimpleKeyIntegrationTest(t, opsgenieSpec)
+}
+
+func TestOpsgenieResource_Offline(t *testing.T) {
+	acctest.RunSimpleKeyIntegrationTestOffline(t, opsgenieSpec)
+}
+
+var opsgenieSpec = acctest.SimpleKeyIntegrationSpec{
+	ResourceType: "orcasecurity_integration_opsgenie",
+	TemplateName: "tf-acc-test-opsgenie",
+	KeyAttr:      "opsgenie_key",
+	KeyValue:     "fake-opsgenie-key-abc123",
 }
@@ -15,8 +15,9 @@ var (
 )
 
 type organizationStateModel struct {
-	ID   types.String `tfsdk:"id"`
-	Name types.String `tfsdk:"name"`
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Permissions types.List   `tfsdk:"permissions"`
 }
 
 type organizationDataSource struct {
@@ -50,15 +51,25 @@ func (ds *organizationDataSource) Schema(_ context.Context, _ datasource.SchemaR
 				Computed:    true,
 				Description: "Organization name",
 			},
+			"permissions": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Actions the provider's API token may perform in the organization, as reported by Orca. Empty when Orca reports none.",
+			},
 		},
 	}
 }
 
 func (ds *organizationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state organizationStateModel
-	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
 
-	item, err := ds.apiClient.GetCurrentOrganization()
+	item := ds.apiClient.Organization
+	var err error
+	if item == nil {
+		item, err = ds.apiClient.GetCurrentOrganization(ctx)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to read organization.", err.Error())
 		return
@@ -66,8 +77,17 @@ func (ds *organizationDataSource) Read(ctx context.Context, req datasource.ReadR
 
 	state.ID = types.StringValue(item.ID)
 	state.Name = types.StringValue(item.Name)
+	permissions := item.Permissions
+	if permissions == nil {
+		permissions = []string{}
+	}
+	state.Permissions, diags = types.ListValueFrom(ctx, types.StringType, permissions)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -0,0 +1,86 @@
+package organizations
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	"terraform-provider-orcasecurity/orcasecurity/internal/testutils"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// read runs Read with the given client and returns the resulting state.
+func read(t *testing.T, client *api_client.APIClient) organizationStateModel {
+	t.Helper()
+	ctx := context.Background()
+	ds := &organizationDataSource{apiClient: client}
+	schemaResp := &datasource.SchemaResponse{}
+	ds.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	typ := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	attrs := map[string]tftypes.Value{}
+	for name, attrType := range typ.AttributeTypes {
+		attrs[name] = tftypes.NewValue(attrType, nil)
+	}
+
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	ds.Read(ctx, datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(typ, attrs)}}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	var state organizationStateModel
+	if diags := resp.State.Get(ctx, &state); diags.HasError() {
+		t.Fatalf("failed to read state: %v", diags)
+	}
+	return state
+}
+
+func permissions(t *testing.T, state organizationStateModel) []string {
+	t.Helper()
+	var out []string
+	if diags := state.Permissions.ElementsAs(context.Background(), &out, false); diags.HasError() {
+		t.Fatal(diags)
+	}
+	return out
+}
+
+func TestRead_UsesValidatedOrganization(t *testing.T) {
+	client := testutils.NewStubAPIClient(func(req *http.Request) *http.Response {
+		t.Errorf("unexpected request %s", req.URL)
+		return nil
+	})
+	client.Organization = &api_client.Organization{ID: "org-1", Name: "Acme", Permissions: []string{"rbac.read"}}
+
+	state := read(t, client)
+	if state.ID.ValueString() != "org-1" || state.Name.ValueString() != "Acme" {
+		t.Errorf("state = %+v", state)
+	}
+	if got := permissions(t, state); len(got) != 1 || got[0] != "rbac.read" {
+		t.Errorf("permissions = %v", got)
+	}
+}
+
+func TestRead_FetchesOrganizationWhenNotValidated(t *testing.T) {
+	client := testutils.NewStubAPIClient(func(req *http.Request) *http.Response {
+		if req.URL.Path != "/api/user/action" {
+			t.Errorf("unexpected request %s", req.URL)
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(`{"data":{"organization_id":"org-2","organization_name":"Beta"}}`)),
+		}
+	})
+
+	state := read(t, client)
+	if state.ID.ValueString() != "org-2" {
+		t.Errorf("id = %s", state.ID)
+	}
+	if state.Permissions.IsNull() || len(permissions(t, state)) != 0 {
+		t.Errorf("permissions = %s, want an empty list", state.Permissions)
+	}
+}
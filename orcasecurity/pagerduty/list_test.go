@@ -0,0 +1,118 @@
+package pagerduty
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"terraform-provider-orcasecurity/orcasecurity/internal/testutils"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func jsonResponse(status int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: status,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}
+}
+
+const listBody = `{"status":"success","data":[
+	{"id":"c1","service_name":"pagerduty","template_name":"tf-acc-test-prod","config":{},"is_enabled":true},
+	{"id":"c2","service_name":"pagerduty","template_name":"tf-acc-test-staging","config":{},"is_enabled":false},
+	{"id":"c3","service_name":"pagerduty","template_name":"oncall","config":{},"is_enabled":true}
+]}`
+
+// listStub answers the service-wide list and the per-template GET Read issues.
+func listStub(t *testing.T) testutils.RoundTripFunc {
+	return func(req *http.Request) *http.Response {
+		q := req.URL.Query()
+		if q.Get("service_name") != "pagerduty" {
+			t.Errorf("unexpected request %s", req.URL)
+			return jsonResponse(http.StatusNotFound, `{}`)
+		}
+		if name := q.Get("template_name"); name != "" {
+			return jsonResponse(http.StatusOK, `{"status":"success","data":[{"id":"c1","service_name":"pagerduty","template_name":"`+name+`","config":{},"is_enabled":true}]}`)
+		}
+		return jsonResponse(http.StatusOK, listBody)
+	}
+}
+
+func TestList_Filters(t *testing.T) {
+	cases := []struct {
+		name    string
+		filters map[string]tftypes.Value
+		want    []string
+	}{
+		{"no filters", nil, []string{"tf-acc-test-prod", "tf-acc-test-staging", "oncall"}},
+		{"name", map[string]tftypes.Value{"template_name_contains": tftypes.NewValue(tftypes.String, "TF-ACC")}, []string{"tf-acc-test-prod", "tf-acc-test-staging"}},
+		{"enabled", map[string]tftypes.Value{"is_enabled": tftypes.NewValue(tftypes.Bool, true)}, []string{"tf-acc-test-prod", "oncall"}},
+		{"both", map[string]tftypes.Value{
+			"template_name_contains": tftypes.NewValue(tftypes.String, "tf-acc"),
+			"is_enabled":             tftypes.NewValue(tftypes.Bool, false),
+		}, []string{"tf-acc-test-staging"}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			results := testutils.RunList(t, NewPagerDutyListResource(), NewPagerDutyResource,
+				testutils.NewStubAPIClient(listStub(t)), testutils.ListQuery{Filters: tc.filters})
+			if len(results) != len(tc.want) {
+				t.Fatalf("got %d results, want %d", len(results), len(tc.want))
+			}
+			for i, r := range results {
+				if r.Diagnostics.HasError() {
+					t.Fatalf("result %d: %v", i, r.Diagnostics)
+				}
+				if got := testutils.IdentityString(t, r, "template_name"); got != tc.want[i] {
+					t.Errorf("result %d template_name = %q, want %q", i, got, tc.want[i])
+				}
+				if got := testutils.IdentityString(t, r, "service_name"); got != "pagerduty" {
+					t.Errorf("result %d service_name = %q", i, got)
+				}
+				if r.DisplayName != tc.want[i] {
+					t.Errorf("result %d display name = %q", i, r.DisplayName)
+				}
+			}
+		})
+	}
+}
+
+func TestList_Limit(t *testing.T) {
+	results := testutils.RunList(t, NewPagerDutyListResource(), NewPagerDutyResource,
+		testutils.NewStubAPIClient(listStub(t)), testutils.ListQuery{Limit: 2})
+	if len(results) != 2 {
+		t.Fatalf("got %d results, want 2", len(results))
+	}
+}
+
+func TestList_IncludeResource(t *testing.T) {
+	results := testutils.RunList(t, NewPagerDutyListResource(), NewPagerDutyResource,
+		testutils.NewStubAPIClient(listStub(t)), testutils.ListQuery{IncludeResource: true, Limit: 1})
+	if len(results) != 1 {
+		t.Fatalf("got %d results, want 1", len(results))
+	}
+	r := results[0]
+	if r.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", r.Diagnostics)
+	}
+	var id, templateName types.String
+	r.Resource.GetAttribute(context.Background(), path.Root("id"), &id)
+	r.Resource.GetAttribute(context.Background(), path.Root("template_name"), &templateName)
+	if id.ValueString() != "c1" || templateName.ValueString() != "tf-acc-test-prod" {
+		t.Errorf("resource not read back: id=%s template_name=%s", id, templateName)
+	}
+}
+
+func TestList_APIError(t *testing.T) {
+	client := testutils.NewStubAPIClient(func(*http.Request) *http.Response {
+		return jsonResponse(http.StatusInternalServerError, `{"error":"boom"}`)
+	})
+	results := testutils.RunList(t, NewPagerDutyListResource(), NewPagerDutyResource, client, testutils.ListQuery{})
+	if len(results) != 1 || !results[0].Diagnostics.HasError() {
+		t.Fatalf("want a single error result, got %+v", results)
+	}
+}
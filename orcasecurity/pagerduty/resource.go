@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -15,7 +16,9 @@ import (
 
 type state struct {
 	cc.CommonFields
-	IntegrationKey types.String `tfsdk:"integration_key"`
+	IntegrationKey          types.String `tfsdk:"integration_key"`
+	IntegrationKeyWO        types.String `tfsdk:"integration_key_wo"`
+	IntegrationKeyWOVersion types.Int64  `tfsdk:"integration_key_wo_version"`
 }
 
 // buildPayload converts the planned state into the PagerDuty API payload.
@@ -30,13 +33,14 @@ func buildPayload(_ context.Context, st cc.State, _ *diag.Diagnostics) api_clien
 }
 
 // extract maps the API envelope back onto state; the key is never returned so state is untouched.
-func extract(o *api_client.PagerDutyExternalServiceConfig, _ cc.State, _ *diag.Diagnostics) cc.APIObject {
+func extract(_ context.Context, o *api_client.PagerDutyExternalServiceConfig, _ cc.State, _ *diag.Diagnostics) cc.APIObject {
 	return cc.APIObject{ID: o.ID, TemplateName: o.TemplateName, IsEnabled: o.IsEnabled, IsDefault: o.IsDefault}
 }
 
 func NewPagerDutyResource() resource.Resource {
 	return cc.New(cc.Spec[api_client.PagerDutyExternalServiceConfig]{
 		TypeNameSuffix: "_integration_pagerduty",
+		ServiceName:    api_client.PagerDutyServiceName,
 		UIName:         "PagerDuty integration",
 		Description:    "Manage a PagerDuty integration in Orca. Creates an external service config of `service_name = \"pagerduty\"` and stores the integration key in Orca's secret store.",
 		VariantAttributes: map[string]schema.Attribute{
@@ -47,12 +51,19 @@ func NewPagerDutyResource() resource.Resource {
 				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
 			},
 		},
-		NewState:     func() cc.State { return &state{} },
-		BuildPayload: buildPayload,
-		Extract:      extract,
-		Create:       (*api_client.APIClient).CreatePagerDutyConfig,
-		Get:          (*api_client.APIClient).GetPagerDutyConfig,
-		Update:       (*api_client.APIClient).UpdatePagerDutyConfig,
-		Delete:       (*api_client.APIClient).DeletePagerDutyConfig,
+		WriteOnlySecrets: []string{"integration_key"},
+		NewState:         func() cc.State { return &state{} },
+		BuildPayload:     buildPayload,
+		Extract:          extract,
+		Create:           (*api_client.APIClient).CreatePagerDutyConfig,
+		Get:              (*api_client.APIClient).GetPagerDutyConfig,
+		Update:           (*api_client.APIClient).UpdatePagerDutyConfig,
+		Delete:           (*api_client.APIClient).DeletePagerDutyConfig,
+		List:             (*api_client.APIClient).ListPagerDutyConfigs,
 	})
 }
+
+// NewPagerDutyListResource lists the integrations managed by NewPagerDutyResource.
+func NewPagerDutyListResource() list.ListResource {
+	return cc.NewList(NewPagerDutyResource)
+}
@@ -9,10 +9,16 @@ import (
 // format (exactly 32 characters — verified against the lab) but not its ownership, so a
 // well-formed fake key works without a real PagerDuty account.
 func TestAccPagerDutyResource(t *testing.T) {
-	acctest.RunSimpleKeyIntegrationTest(t, acctest.SimpleKeyIntegrationSpec{
-		ResourceType: "orcasecurity_integration_pagerduty",
-		TemplateName: "tf-acc-test-pagerduty",
-		KeyAttr:      "integration_key",
-		KeyValue:     "fakeintegrationkey1234567890abcd",
-	})
+	acctest.RunSimpleKeyIntegrationTest(t, pagerdutySpec)
+}
+
+func TestPagerDutyResource_Offline(t *testing.T) {
+	acctest.RunSimpleKeyIntegrationTestOffline(t, pagerdutySpec)
+}
+
+var pagerdutySpec = acctest.SimpleKeyIntegrationSpec{
+	ResourceType: "orcasecurity_integration_pagerduty",
+	TemplateName: "tf-acc-test-pagerduty",
+	KeyAttr:      "integration_key",
+	KeyValue:     "fakeintegrationkey1234567890abcd",
 }
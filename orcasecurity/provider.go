@@ -3,8 +3,7 @@ package orcasecurity
 import (
 	"context"
 	"fmt"
-	"os"
-	"strings"
+	"net/http"
 	"terraform-provider-orcasecurity/orcasecurity/add_users"
 	"terraform-provider-orcasecurity/orcasecurity/admission_controller"
 	"terraform-provider-orcasecurity/orcasecurity/akamai"
@@ -12,10 +11,16 @@ import (
 	"terraform-provider-orcasecurity/orcasecurity/automation_v2"
 	"terraform-provider-orcasecurity/orcasecurity/automation_v2_priorities"
 	"terraform-provider-orcasecurity/orcasecurity/automation_v2_priority_order"
+	"terraform-provider-orcasecurity/orcasecurity/aws_security_lake"
+	"terraform-provider-orcasecurity/orcasecurity/aws_sns"
+	"terraform-provider-orcasecurity/orcasecurity/aws_sqs"
+	"terraform-provider-orcasecurity/orcasecurity/aws_trust_policy"
 	"terraform-provider-orcasecurity/orcasecurity/azure_devops_template"
 	"terraform-provider-orcasecurity/orcasecurity/azure_sentinel"
 	"terraform-provider-orcasecurity/orcasecurity/business_unit"
+	"terraform-provider-orcasecurity/orcasecurity/chronicle"
 	"terraform-provider-orcasecurity/orcasecurity/cloudflare"
+	"terraform-provider-orcasecurity/orcasecurity/cribl"
 	"terraform-provider-orcasecurity/orcasecurity/custom_compliance_framework"
 	"terraform-provider-orcasecurity/orcasecurity/custom_dashboard"
 	"terraform-provider-orcasecurity/orcasecurity/custom_discovery_alert"
@@ -24,8 +29,10 @@ import (
 	"terraform-provider-orcasecurity/orcasecurity/custom_tag_rule"
 	"terraform-provider-orcasecurity/orcasecurity/custom_widget"
 	"terraform-provider-orcasecurity/orcasecurity/data_detection_rule"
+	"terraform-provider-orcasecurity/orcasecurity/datadog"
 	"terraform-provider-orcasecurity/orcasecurity/discovery_view"
 	"terraform-provider-orcasecurity/orcasecurity/dspm_policy"
+	"terraform-provider-orcasecurity/orcasecurity/gcp_pub_sub"
 	"terraform-provider-orcasecurity/orcasecurity/group"
 	"terraform-provider-orcasecurity/orcasecurity/group_access"
 	"terraform-provider-orcasecurity/orcasecurity/jira_cloud_resource"
@@ -33,6 +40,7 @@ import (
 	"terraform-provider-orcasecurity/orcasecurity/jira_template"
 	"terraform-provider-orcasecurity/orcasecurity/monday_resource"
 	"terraform-provider-orcasecurity/orcasecurity/monday_template"
+	"terraform-provider-orcasecurity/orcasecurity/ms_teams_template"
 	"terraform-provider-orcasecurity/orcasecurity/opsgenie"
 	"terraform-provider-orcasecurity/orcasecurity/organizations"
 	"terraform-provider-orcasecurity/orcasecurity/pagerduty"
@@ -49,18 +57,26 @@ import (
 	"terraform-provider-orcasecurity/orcasecurity/shift_left_project"
 	"terraform-provider-orcasecurity/orcasecurity/slack"
 	"terraform-provider-orcasecurity/orcasecurity/snyk"
+	"terraform-provider-orcasecurity/orcasecurity/sonar_query"
 	"terraform-provider-orcasecurity/orcasecurity/splunk"
+	"terraform-provider-orcasecurity/orcasecurity/sumo_logic"
 	"terraform-provider-orcasecurity/orcasecurity/system_sonar_alert"
 	"terraform-provider-orcasecurity/orcasecurity/terraform_cloud"
 	"terraform-provider-orcasecurity/orcasecurity/trusted_cloud_account"
 	"terraform-provider-orcasecurity/orcasecurity/trusted_dynamic_ip_range"
 	"terraform-provider-orcasecurity/orcasecurity/user"
 	"terraform-provider-orcasecurity/orcasecurity/user_access"
+	"terraform-provider-orcasecurity/orcasecurity/user_invite"
 	"terraform-provider-orcasecurity/orcasecurity/user_preferences"
 	"terraform-provider-orcasecurity/orcasecurity/webhook"
 	"terraform-provider-orcasecurity/orcasecurity/zscaler"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -71,27 +87,71 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces
 var (
-	_ provider.Provider = &orcasecurityProvider{}
+	_ provider.Provider                       = &orcasecurityProvider{}
+	_ provider.ProviderWithEphemeralResources = &orcasecurityProvider{}
+	_ provider.ProviderWithFunctions          = &orcasecurityProvider{}
+	_ provider.ProviderWithListResources      = &orcasecurityProvider{}
 )
 
 const apiEndpointEnvName = "ORCASECURITY_API_ENDPOINT"
 const apiTokenEnvName = "ORCASECURITY_API_TOKEN"
+const skipSonarQueryValidationEnvName = "ORCASECURITY_SKIP_SONAR_QUERY_VALIDATION"
+const readOnlyEnvName = "ORCASECURITY_READ_ONLY"
 
 // New is a helper function to simplify provider server and testing implementation.
-func New(version string) func() provider.Provider {
+func New(version string, opts ...Option) func() provider.Provider {
 	return func() provider.Provider {
-		return &orcasecurityProvider{version: version}
+		p := &orcasecurityProvider{version: version}
+		for _, opt := range opts {
+			opt(p)
+		}
+		return p
 	}
 }
 
+// Option customizes a provider built by New. The released binary passes none.
+type Option func(*orcasecurityProvider)
+
+// WithTransport wraps the transport of every API client the provider configures. Tests use it
+// to install a recording or replaying transport (internal/cassette) on their own provider
+// instance.
+func WithTransport(wrap func(http.RoundTripper) http.RoundTripper) Option {
+	return func(p *orcasecurityProvider) { p.wrapTransport = wrap }
+}
+
 // orcasecurityProvider is the provider implementation.
 type orcasecurityProvider struct {
-	version string
+	version       string
+	wrapTransport func(http.RoundTripper) http.RoundTripper
 }
 
 type orcasecurityProviderModel struct {
-	APIEndpoint types.String `tfsdk:"api_endpoint"`
-	APIToken    types.String `tfsdk:"api_token"`
+	APIEndpoint    types.String `tfsdk:"api_endpoint"`
+	Region         types.String `tfsdk:"region"`
+	APIToken       types.String `tfsdk:"api_token"`
+	DefaultTimeout types.String `tfsdk:"default_timeout"`
+
+	APITokenFile     types.String `tfsdk:"api_token_file"`
+	CredentialHelper types.List   `tfsdk:"credential_helper"`
+	OAuth            types.Object `tfsdk:"oauth"`
+
+	SkipCredentialsValidation types.Bool `tfsdk:"skip_credentials_validation"`
+	SkipSonarQueryValidation  types.Bool `tfsdk:"skip_sonar_query_validation"`
+	ReadOnly                  types.Bool `tfsdk:"read_only"`
+
+	ProxyURL         types.String `tfsdk:"proxy_url"`
+	CACertFile       types.String `tfsdk:"ca_cert_file"`
+	CACertPEM        types.String `tfsdk:"ca_cert_pem"`
+	ClientCertFile   types.String `tfsdk:"client_cert_file"`
+	ClientKeyFile    types.String `tfsdk:"client_key_file"`
+	RequestTimeout   types.String `tfsdk:"request_timeout"`
+	MaxRetryAttempts types.Int64  `tfsdk:"max_retry_attempts"`
+	MaxRetryBackoff  types.String `tfsdk:"max_retry_backoff"`
+
+	MaxRequestsPerSecond  types.Float64 `tfsdk:"max_requests_per_second"`
+	MaxConcurrentRequests types.Int64   `tfsdk:"max_concurrent_requests"`
+
+	CacheResponses types.Bool `tfsdk:"cache_responses"`
 }
 
 // Metadata returns the provider type name.
@@ -102,27 +162,51 @@ func (p *orcasecurityProvider) Metadata(_ context.Context, _ provider.MetadataRe
 
 // Schema defines the provider-level schema for configuration data.
 func (p *orcasecurityProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"api_token": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("API token. Alternatively, set `%s` environment variable.  ", apiTokenEnvName) +
+				"Please make sure that API token has enough permissions to access Orca Security resources.",
+			Optional:  true,
+			Sensitive: true,
+		},
+		"default_timeout": schema.StringAttribute{
+			Optional: true,
+			MarkdownDescription: "Default deadline for each create, read, update and delete operation, as a Go duration string (e.g. `\"5m\"`). " +
+				"Applies when a resource's `timeouts` block leaves that operation unset. The deadline bounds every HTTP attempt and retry backoff. " +
+				"No operation deadline by default.",
+		},
+		"read_only": schema.BoolAttribute{
+			Optional: true,
+			MarkdownDescription: "Refuse every API request that could change anything in Orca, so `terraform plan`, data sources and list resources can run with a read-scoped token and are guaranteed not to write. " +
+				"Only GET and HEAD requests are sent, plus POST requests that are queries (Sonar query validation, GraphQL reads); a create, update or delete fails with a read-only error before reaching the API. " +
+				fmt.Sprintf("Defaults to `false`. Alternatively set `%s` to `true`.", readOnlyEnvName),
+		},
+		"skip_sonar_query_validation": schema.BoolAttribute{
+			Optional: true,
+			MarkdownDescription: "Skip checking new and changed Sonar queries (`orcasecurity_custom_sonar_alert` `rule`, `orcasecurity_automation_v2` `filter.sonar_query`) with the Orca query parser during plan. " +
+				fmt.Sprintf("Useful for offline plans; invalid queries are then only rejected at apply. Defaults to `false`. Alternatively set `%s` to `true`.", skipSonarQueryValidationEnvName),
+		},
+	}
+	for name, attr := range endpointSchemaAttributes() {
+		attributes[name] = attr
+	}
+	for name, attr := range authSchemaAttributes() {
+		attributes[name] = attr
+	}
+	for name, attr := range transportSchemaAttributes() {
+		attributes[name] = attr
+	}
+
 	resp.Schema = schema.Schema{
 		MarkdownDescription: fmt.Sprintf(`
 This provider is used to interact with the resources supported by Orca Security.
 The provider needs to be configured with the proper credentials before it can be used.
 Use the navigation to the left to get information about the available resources.  
 
-It is required to configure at least two configuration options: api_endpoint and api_token. 
-Both can be configured using environment variables "%s" and "%s" respectively.`, apiEndpointEnvName, apiTokenEnvName),
-		Attributes: map[string]schema.Attribute{
-			"api_endpoint": schema.StringAttribute{
-				Optional: true,
-				MarkdownDescription: fmt.Sprintf("API endpoint. Alternatively set `%s` environment variable.  ", apiEndpointEnvName) +
-					"No default value provided. The provider will not start if none endpoint provided.",
-			},
-			"api_token": schema.StringAttribute{
-				MarkdownDescription: fmt.Sprintf("API token. Alternatively, set `%s` environment variable.  ", apiTokenEnvName) +
-					"Please make sure that API token has enough permissions to access Orca Security resources.",
-				Optional:  true,
-				Sensitive: true,
-			},
-		},
+It is required to configure at least two configuration options: api_endpoint (or region) and api_token. 
+Both can be configured using environment variables "%s" and "%s" respectively.
+Instead of a static api_token, credentials can come from api_token_file, credential_helper or an oauth block.`, apiEndpointEnvName, apiTokenEnvName),
+		Attributes: attributes,
 	}
 }
 
@@ -146,6 +230,17 @@ func (p *orcasecurityProvider) Configure(ctx context.Context, req provider.Confi
 		)
 	}
 
+	if config.Region.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("region"),
+			"Unknown Orca Security region",
+			fmt.Sprintf("The provider cannot create Orca Security API client as there is an unknown configuration value for the Orca Security region. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the %s environment variable.",
+				regionEnvName,
+			),
+		)
+	}
+
 	if config.APIToken.IsUnknown() {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("api_token"),
@@ -162,56 +257,60 @@ func (p *orcasecurityProvider) Configure(ctx context.Context, req provider.Confi
 		return
 	}
 
-	api_endpoint := os.Getenv(apiEndpointEnvName)
-	api_token := os.Getenv(apiTokenEnvName)
+	var endpointDiags diag.Diagnostics
+	api_endpoint := resolveEndpoint(ctx, config, &endpointDiags)
+	resp.Diagnostics.Append(endpointDiags...)
+	auth := resolveAuth(ctx, config, &resp.Diagnostics)
+	api_token := auth.apiToken
 
-	if !config.APIEndpoint.IsNull() {
-		api_endpoint = config.APIEndpoint.ValueString()
-	}
-	if !config.APIToken.IsNull() {
-		api_token = config.APIToken.ValueString()
-	}
-
-	// Trim trailing slashes from the API endpoint
-	trimmedEndpoint := strings.TrimRight(api_endpoint, "/")
-	if trimmedEndpoint != api_endpoint {
-		tflog.Warn(ctx, "Trailing slash detected in 'api_endpoint'. It has been automatically removed.", map[string]interface{}{
-			"original": api_endpoint,
-			"trimmed":  trimmedEndpoint,
-		})
-	}
-
-	api_endpoint = trimmedEndpoint
-
-	if api_endpoint == "" {
+	if api_endpoint == "" && !endpointDiags.HasError() {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("api_endpoint"),
 			"Missing Orca Security API endpoint",
 			fmt.Sprintf("The provider cannot create Orca Security API client as there is a missing or empty value for the Orca Security API endpoint. "+
-				"Set the api_endpoint value in the configuration or use %s environment variable. "+
+				"Set the api_endpoint or region value in the configuration or use the %s or %s environment variable. "+
 				"If either is already set, ensure the value is not empty.",
-				apiEndpointEnvName,
+				apiEndpointEnvName, regionEnvName,
 			),
 		)
 	}
-	if api_token == "" {
+	if auth.empty() && !resp.Diagnostics.HasError() {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("api_token"),
 			"Missing Orca Security API token",
 			fmt.Sprintf(
 				"The provider cannot create Orca Security API client as there is a missing or empty value for the Orca Security API token. "+
-					"Set the api_token value in the configuration or use %s environment variable. "+
+					"Set the api_token value in the configuration or use %s environment variable, "+
+					"or configure api_token_file, credential_helper or oauth. "+
 					"If either is already set, ensure the value is not empty.",
 				apiTokenEnvName,
 			),
 		)
 	}
 
+	var defaultTimeout time.Duration
+	if !config.DefaultTimeout.IsNull() && !config.DefaultTimeout.IsUnknown() {
+		d, err := time.ParseDuration(config.DefaultTimeout.ValueString())
+		if err != nil || d < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("default_timeout"),
+				"Invalid default timeout",
+				fmt.Sprintf("The default_timeout value %q must be a non-negative duration such as \"30s\" or \"10m\".", config.DefaultTimeout.ValueString()),
+			)
+		}
+		defaultTimeout = d
+	}
+
+	transport := transportConfig(config, &resp.Diagnostics)
+	skipCredentialsValidation := boolSetting(config.SkipCredentialsValidation, skipCredentialsValidationEnvName, "skip_credentials_validation", &resp.Diagnostics)
+	skipSonarQueryValidation := boolSetting(config.SkipSonarQueryValidation, skipSonarQueryValidationEnvName, "skip_sonar_query_validation", &resp.Diagnostics)
+	readOnly := boolSetting(config.ReadOnly, readOnlyEnvName, "read_only", &resp.Diagnostics)
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	client, err := api_client.NewAPIClient(&api_endpoint, &api_token)
+	client, err := api_client.NewAPIClientWithTransport(&api_endpoint, &api_token, transport)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to create Orca Security API client",
@@ -219,18 +318,39 @@ func (p *orcasecurityProvider) Configure(ctx context.Context, req provider.Confi
 				"If the error is not clear, please contact the provider developers. \n\n"+
 				"Orca Security API client error: "+err.Error(),
 		)
+		return
+	}
+	client.DefaultTimeout = defaultTimeout
+	client.SkipSonarQueryValidation = skipSonarQueryValidation
+	client.ReadOnly = readOnly
+	if p.wrapTransport != nil {
+		client.HTTPClient.Transport = p.wrapTransport(client.HTTPClient.Transport)
+	}
+	auth.apply(client)
+
+	if !skipCredentialsValidation {
+		validateCredentials(ctx, client, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
 	resp.ResourceData = client
 	resp.DataSourceData = client
+	resp.EphemeralResourceData = client
+	resp.ListResourceData = client
 
 	tflog.Info(ctx, fmt.Sprintf("Using %s as Orca Security API base URL", api_endpoint))
+	if readOnly {
+		tflog.Info(ctx, "Read-only mode: requests that could change Orca will be refused")
+	}
 }
 
 // DataSources defines the data sources implemented in the provider.
 func (p *orcasecurityProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		admission_controller.NewAdmissionControllerTemplateDataSource,
+		aws_trust_policy.NewAwsTrustPolicyDataSource,
 		azure_devops_template.NewAzureDevopsTemplateDataSource,
 		jira_template.NewJiraTemplateDataSource,
 		jira_cloud_resource.NewJiraCloudDataSource,
@@ -267,10 +387,18 @@ func (p *orcasecurityProvider) Resources(_ context.Context) []func() resource.Re
 		user_access.NewUserAccessResource,
 		business_unit.NewBusinessUnitResource,
 		akamai.NewAkamaiResource,
+		aws_security_lake.NewAwsSecurityLakeResource,
+		aws_sns.NewAwsSnsResource,
+		aws_sqs.NewAwsSqsResource,
 		azure_sentinel.NewAzureSentinelResource,
+		chronicle.NewChronicleResource,
 		cloudflare.NewCloudflareResource,
+		cribl.NewCriblResource,
+		datadog.NewDatadogResource,
+		gcp_pub_sub.NewGcpPubSubResource,
 		jira_cloud_template.NewJiraCloudTemplateResource,
 		monday_template.NewMondayTemplateResource,
+		ms_teams_template.NewMsTeamsTemplateResource,
 		opsgenie.NewOpsgenieResource,
 		pagerduty.NewPagerDutyResource,
 		s3_bucket.NewS3BucketResource,
@@ -278,8 +406,14 @@ func (p *orcasecurityProvider) Resources(_ context.Context) []func() resource.Re
 		monday_resource.NewMondayResource,
 		snyk.NewSnykResource,
 		splunk.NewSplunkResource,
+		sumo_logic.NewSumoLogicResource,
 		terraform_cloud.NewTerraformCloudResource,
 		webhook.NewWebhookResource,
+		webhook.NewTinesResource,
+		webhook.NewTorqResource,
+		webhook.NewOpusResource,
+		webhook.NewPantherResource,
+		webhook.NewCoralogixResource,
 		zscaler.NewZscalerResource,
 		custom_widget.NewCustomWidgetResource,
 		custom_dashboard.NewCustomDashboardResource,
@@ -300,3 +434,60 @@ func (p *orcasecurityProvider) Resources(_ context.Context) []func() resource.Re
 		automation_v2_priority_order.NewAutomationPriorityOrderResource,
 	}
 }
+
+// EphemeralResources defines the ephemeral resources implemented in the provider.
+func (p *orcasecurityProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		user_invite.NewUserInviteEphemeralResource,
+	}
+}
+
+// Functions defines the provider functions implemented in the provider.
+func (p *orcasecurityProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		sonar_query.NewSonarQueryFunction,
+		sonar_query.NewSonarAndFunction,
+		sonar_query.NewSonarOrFunction,
+		sonar_query.NewSonarNormalizeFunction,
+	}
+}
+
+// ListResources defines the list resources implemented in the provider, used by `terraform query`.
+func (p *orcasecurityProvider) ListResources(_ context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		automation_v2.NewAutomationV2ListResource,
+		custom_sonar_alert.NewCustomSonarAlertListResource,
+		business_unit.NewBusinessUnitListResource,
+		group.NewGroupListResource,
+		akamai.NewAkamaiListResource,
+		aws_security_lake.NewAwsSecurityLakeListResource,
+		aws_sns.NewAwsSnsListResource,
+		aws_sqs.NewAwsSqsListResource,
+		azure_sentinel.NewAzureSentinelListResource,
+		chronicle.NewChronicleListResource,
+		cloudflare.NewCloudflareListResource,
+		cribl.NewCriblListResource,
+		datadog.NewDatadogListResource,
+		gcp_pub_sub.NewGcpPubSubListResource,
+		jira_cloud_template.NewJiraCloudTemplateListResource,
+		monday_template.NewMondayTemplateListResource,
+		ms_teams_template.NewMsTeamsTemplateListResource,
+		opsgenie.NewOpsgenieListResource,
+		pagerduty.NewPagerDutyListResource,
+		s3_bucket.NewS3BucketListResource,
+		servicenow_itsm_template.NewServiceNowITSMTemplateListResource,
+		servicenow_sir_template.NewServiceNowSIRTemplateListResource,
+		slack.NewSlackListResource,
+		snyk.NewSnykListResource,
+		splunk.NewSplunkListResource,
+		sumo_logic.NewSumoLogicListResource,
+		terraform_cloud.NewTerraformCloudListResource,
+		webhook.NewWebhookListResource,
+		webhook.NewTinesListResource,
+		webhook.NewTorqListResource,
+		webhook.NewOpusListResource,
+		webhook.NewPantherListResource,
+		webhook.NewCoralogixListResource,
+		zscaler.NewZscalerListResource,
+	}
+}
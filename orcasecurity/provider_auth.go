@@ -0,0 +1,265 @@
+package orcasecurity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"terraform-provider-orcasecurity/orcasecurity/api_client"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	apiTokenFileEnvName     = "ORCASECURITY_API_TOKEN_FILE"
+	credentialHelperEnvName = "ORCASECURITY_CREDENTIAL_HELPER"
+
+	oauthTokenURLEnvName     = "ORCASECURITY_OAUTH_TOKEN_URL"
+	oauthClientIDEnvName     = "ORCASECURITY_OAUTH_CLIENT_ID"
+	oauthClientSecretEnvName = "ORCASECURITY_OAUTH_CLIENT_SECRET"
+	oauthScopeEnvName        = "ORCASECURITY_OAUTH_SCOPE"
+	oidcTokenEnvName         = "ORCASECURITY_OIDC_TOKEN"
+	oidcTokenFileEnvName     = "ORCASECURITY_OIDC_TOKEN_FILE"
+
+	skipCredentialsValidationEnvName = "ORCASECURITY_SKIP_CREDENTIALS_VALIDATION"
+)
+
+type providerOAuthModel struct {
+	TokenURL      types.String `tfsdk:"token_url"`
+	ClientID      types.String `tfsdk:"client_id"`
+	ClientSecret  types.String `tfsdk:"client_secret"`
+	OIDCToken     types.String `tfsdk:"oidc_token"`
+	OIDCTokenFile types.String `tfsdk:"oidc_token_file"`
+	Scope         types.String `tfsdk:"scope"`
+}
+
+// authSchemaAttributes are the alternatives to a static api_token, at most
+// one of which may be configured in the provider block, and the switch for
+// the Configure-time credentials check.
+func authSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"skip_credentials_validation": schema.BoolAttribute{
+			Optional: true,
+			MarkdownDescription: "Skip the identity request the provider makes during configuration to verify the endpoint and credentials. " +
+				fmt.Sprintf("Useful for offline plans; a bad endpoint or token then surfaces as per-resource errors instead. Defaults to `false`. Alternatively set `%s` to `true`.", skipCredentialsValidationEnvName),
+		},
+		"api_token_file": schema.StringAttribute{
+			Optional: true,
+			MarkdownDescription: fmt.Sprintf("Path to a file holding the API token, re-read when the token expires so an external agent can rotate it. Alternatively set `%s`. ", apiTokenFileEnvName) +
+				"JWTs are sent as `Bearer` tokens and refreshed from the file before their `exp` claim; other values are sent as Orca API tokens.",
+		},
+		"credential_helper": schema.ListAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+			MarkdownDescription: fmt.Sprintf("Command and arguments of a credential helper, e.g. `[\"vault-orca-token\", \"--role\", \"ci\"]`. Alternatively set `%s` (split on whitespace). ", credentialHelperEnvName) +
+				"The helper prints either the bare token or a JSON object `{\"token\": ..., \"token_type\": ..., \"expires_at\": ...}` (`expires_in` in seconds may replace `expires_at`). " +
+				"It is run again shortly before the token expires, or every 5 minutes when no expiry is known.",
+		},
+		"oauth": schema.SingleNestedAttribute{
+			Optional: true,
+			MarkdownDescription: "Exchange an OAuth client ID and secret, or an OIDC workload-identity token from CI, for short-lived bearer tokens via the client-credentials grant. " +
+				"Tokens are refreshed automatically before they expire. " +
+				fmt.Sprintf("Without this block, setting `%s` enables it from the environment.", oauthClientIDEnvName),
+			Attributes: map[string]schema.Attribute{
+				"token_url": schema.StringAttribute{
+					Optional:            true,
+					MarkdownDescription: fmt.Sprintf("Token endpoint URL. Alternatively set `%s`.", oauthTokenURLEnvName),
+				},
+				"client_id": schema.StringAttribute{
+					Optional:            true,
+					MarkdownDescription: fmt.Sprintf("OAuth client ID. Alternatively set `%s`.", oauthClientIDEnvName),
+				},
+				"client_secret": schema.StringAttribute{
+					Optional:            true,
+					Sensitive:           true,
+					MarkdownDescription: fmt.Sprintf("OAuth client secret. Alternatively set `%s`. Conflicts with `oidc_token` and `oidc_token_file`.", oauthClientSecretEnvName),
+				},
+				"oidc_token": schema.StringAttribute{
+					Optional:            true,
+					Sensitive:           true,
+					MarkdownDescription: fmt.Sprintf("OIDC ID token sent as a JWT client assertion instead of a client secret. Alternatively set `%s`.", oidcTokenEnvName),
+				},
+				"oidc_token_file": schema.StringAttribute{
+					Optional:            true,
+					MarkdownDescription: fmt.Sprintf("Path to a file holding the OIDC ID token, re-read on every token exchange. Alternatively set `%s`.", oidcTokenFileEnvName),
+				},
+				"scope": schema.StringAttribute{
+					Optional:            true,
+					MarkdownDescription: fmt.Sprintf("Space-separated scopes to request. Alternatively set `%s`.", oauthScopeEnvName),
+				},
+			},
+		},
+	}
+}
+
+// authMethod is the resolved authentication configuration. Exactly one field
+// is set when resolution succeeds.
+type authMethod struct {
+	apiToken         string
+	tokenFile        string
+	credentialHelper []string
+	oauth            *api_client.OAuthConfig
+}
+
+// resolveAuth picks the authentication method. Methods set in the provider
+// block win over the environment; within each, only one may be used.
+func resolveAuth(ctx context.Context, config orcasecurityProviderModel, diags *diag.Diagnostics) authMethod {
+	for name, v := range map[string]attr.Value{
+		"api_token_file":    config.APITokenFile,
+		"credential_helper": config.CredentialHelper,
+		"oauth":             config.OAuth,
+	} {
+		if v.IsUnknown() {
+			diags.AddAttributeError(path.Root(name), "Unknown Orca Security authentication setting",
+				fmt.Sprintf("The provider cannot authenticate because %s is not known until apply. Set it statically or target apply its source first.", name))
+		}
+	}
+	if diags.HasError() {
+		return authMethod{}
+	}
+
+	var configured []string
+	if !config.APIToken.IsNull() {
+		configured = append(configured, "api_token")
+	}
+	if !config.APITokenFile.IsNull() {
+		configured = append(configured, "api_token_file")
+	}
+	if !config.CredentialHelper.IsNull() {
+		configured = append(configured, "credential_helper")
+	}
+	if !config.OAuth.IsNull() {
+		configured = append(configured, "oauth")
+	}
+	if len(configured) > 1 {
+		diags.AddAttributeError(
+			path.Root(configured[1]),
+			"Conflicting Orca Security authentication settings",
+			fmt.Sprintf("Only one of api_token, api_token_file, credential_helper and oauth may be set; found %s.", strings.Join(configured, ", ")),
+		)
+		return authMethod{}
+	}
+
+	switch {
+	case !config.APIToken.IsNull():
+		return authMethod{apiToken: config.APIToken.ValueString()}
+	case !config.APITokenFile.IsNull():
+		return authMethod{tokenFile: config.APITokenFile.ValueString()}
+	case !config.CredentialHelper.IsNull():
+		var argv []string
+		diags.Append(config.CredentialHelper.ElementsAs(ctx, &argv, false)...)
+		if len(argv) == 0 || argv[0] == "" {
+			diags.AddAttributeError(path.Root("credential_helper"), "Empty credential helper",
+				"credential_helper must contain at least the command to run.")
+		}
+		return authMethod{credentialHelper: argv}
+	case !config.OAuth.IsNull():
+		var oauth providerOAuthModel
+		diags.Append(config.OAuth.As(ctx, &oauth, basetypes.ObjectAsOptions{})...)
+		return authMethod{oauth: oauthConfig(oauth, diags)}
+	}
+
+	switch {
+	case os.Getenv(apiTokenEnvName) != "":
+		return authMethod{apiToken: os.Getenv(apiTokenEnvName)}
+	case os.Getenv(apiTokenFileEnvName) != "":
+		return authMethod{tokenFile: os.Getenv(apiTokenFileEnvName)}
+	case os.Getenv(credentialHelperEnvName) != "":
+		return authMethod{credentialHelper: strings.Fields(os.Getenv(credentialHelperEnvName))}
+	case os.Getenv(oauthClientIDEnvName) != "":
+		return authMethod{oauth: oauthConfig(providerOAuthModel{}, diags)}
+	}
+	return authMethod{}
+}
+
+func oauthConfig(m providerOAuthModel, diags *diag.Diagnostics) *api_client.OAuthConfig {
+	cfg := &api_client.OAuthConfig{
+		TokenURL:            stringSetting(m.TokenURL, oauthTokenURLEnvName),
+		ClientID:            stringSetting(m.ClientID, oauthClientIDEnvName),
+		ClientSecret:        stringSetting(m.ClientSecret, oauthClientSecretEnvName),
+		ClientAssertion:     stringSetting(m.OIDCToken, oidcTokenEnvName),
+		ClientAssertionFile: stringSetting(m.OIDCTokenFile, oidcTokenFileEnvName),
+		Scope:               stringSetting(m.Scope, oauthScopeEnvName),
+	}
+	if cfg.TokenURL == "" {
+		diags.AddAttributeError(path.Root("oauth").AtName("token_url"), "Missing OAuth token URL",
+			fmt.Sprintf("Set oauth.token_url or %s.", oauthTokenURLEnvName))
+	}
+	if cfg.ClientID == "" {
+		diags.AddAttributeError(path.Root("oauth").AtName("client_id"), "Missing OAuth client ID",
+			fmt.Sprintf("Set oauth.client_id or %s.", oauthClientIDEnvName))
+	}
+	secrets := 0
+	for _, v := range []string{cfg.ClientSecret, cfg.ClientAssertion, cfg.ClientAssertionFile} {
+		if v != "" {
+			secrets++
+		}
+	}
+	if secrets != 1 {
+		diags.AddAttributeError(path.Root("oauth"), "Invalid OAuth client authentication",
+			"Exactly one of oauth.client_secret, oauth.oidc_token and oauth.oidc_token_file (or their environment variables) must be set.")
+	}
+	return cfg
+}
+
+// empty reports whether no authentication method was found at all.
+func (m authMethod) empty() bool {
+	return m.apiToken == "" && m.tokenFile == "" && len(m.credentialHelper) == 0 && m.oauth == nil
+}
+
+// apply installs the method on client. A static token stays in APIToken so
+// the default Execute path is unchanged.
+func (m authMethod) apply(client *api_client.APIClient) {
+	switch {
+	case m.tokenFile != "":
+		client.Credentials = api_client.NewTokenFileCredentials(m.tokenFile)
+	case len(m.credentialHelper) > 0:
+		client.Credentials = api_client.NewCredentialHelper(m.credentialHelper)
+	case m.oauth != nil:
+		client.Credentials = api_client.NewOAuthCredentials(client.HTTPClient, *m.oauth)
+	}
+}
+
+// validateCredentials resolves the caller's organization once so a wrong
+// endpoint or rejected token is reported as a single diagnostic here rather
+// than as an error from every resource.
+func validateCredentials(ctx context.Context, client *api_client.APIClient, diags *diag.Diagnostics) {
+	org, err := client.GetCurrentOrganization(ctx)
+	if err == nil {
+		client.Organization = org
+		tflog.Info(ctx, "Validated Orca Security credentials", map[string]interface{}{"organization_id": org.ID})
+		return
+	}
+
+	const skipHint = " Set skip_credentials_validation to plan without contacting the API."
+	var apiErr *api_client.APIError
+	switch {
+	case errors.As(err, &apiErr) && (apiErr.StatusCode == http.StatusUnauthorized || apiErr.StatusCode == http.StatusForbidden):
+		diags.AddError(
+			"Invalid Orca Security credentials",
+			fmt.Sprintf("The Orca Security API at %s rejected the configured credentials: %s. "+
+				"Check that the token is valid, has not expired, and belongs to this endpoint's region.", client.APIEndpoint, err)+skipHint,
+		)
+	case errors.As(err, &apiErr):
+		diags.AddAttributeError(
+			path.Root("api_endpoint"),
+			"Unexpected response from the Orca Security API endpoint",
+			fmt.Sprintf("Verifying credentials against %s failed: %s. "+
+				"Check that api_endpoint is the API URL for your region (e.g. https://api.orcasecurity.io), not the web console URL.", client.APIEndpoint, err)+skipHint,
+		)
+	default:
+		diags.AddAttributeError(
+			path.Root("api_endpoint"),
+			"Unable to reach the Orca Security API",
+			fmt.Sprintf("Verifying credentials against %s failed: %s.", client.APIEndpoint, err)+skipHint,
+		)
+	}
+}
@@ -0,0 +1,160 @@
+package orcasecurity
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var oauthAttrTypes = map[string]attr.Type{
+	"token_url":       types.StringType,
+	"client_id":       types.StringType,
+	"client_secret":   types.StringType,
+	"oidc_token":      types.StringType,
+	"oidc_token_file": types.StringType,
+	"scope":           types.StringType,
+}
+
+func oauthObject(t *testing.T, values map[string]string) types.Object {
+	t.Helper()
+	attrs := map[string]attr.Value{}
+	for name := range oauthAttrTypes {
+		attrs[name] = types.StringNull()
+		if v, ok := values[name]; ok {
+			attrs[name] = types.StringValue(v)
+		}
+	}
+	obj, diags := types.ObjectValue(oauthAttrTypes, attrs)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	return obj
+}
+
+func TestResolveAuth_BlockWinsOverEnvironment(t *testing.T) {
+	t.Setenv(apiTokenEnvName, "env-token")
+	var diags diag.Diagnostics
+	auth := resolveAuth(context.Background(), orcasecurityProviderModel{APITokenFile: types.StringValue("/run/orca/token")}, &diags)
+	if diags.HasError() || auth.tokenFile != "/run/orca/token" || auth.apiToken != "" {
+		t.Fatalf("auth = %+v, diags = %v", auth, diags)
+	}
+}
+
+func TestResolveAuth_EnvironmentFallbacks(t *testing.T) {
+	t.Setenv(apiTokenEnvName, "")
+	t.Setenv(credentialHelperEnvName, "orca-creds get --profile ci")
+	var diags diag.Diagnostics
+	auth := resolveAuth(context.Background(), orcasecurityProviderModel{}, &diags)
+	if diags.HasError() || len(auth.credentialHelper) != 4 || auth.credentialHelper[0] != "orca-creds" {
+		t.Fatalf("auth = %+v, diags = %v", auth, diags)
+	}
+}
+
+func TestResolveAuth_ConflictingMethods(t *testing.T) {
+	var diags diag.Diagnostics
+	resolveAuth(context.Background(), orcasecurityProviderModel{
+		APIToken: types.StringValue("tok"),
+		OAuth:    oauthObject(t, map[string]string{"token_url": "https://idp/token", "client_id": "c", "client_secret": "s"}),
+	}, &diags)
+	if diags.ErrorsCount() != 1 {
+		t.Fatalf("errors = %d, want 1 conflict: %v", diags.ErrorsCount(), diags)
+	}
+}
+
+func TestResolveAuth_OAuthValidation(t *testing.T) {
+	var diags diag.Diagnostics
+	auth := resolveAuth(context.Background(), orcasecurityProviderModel{
+		OAuth: oauthObject(t, map[string]string{"token_url": "https://idp/token", "client_id": "c", "oidc_token_file": "/var/run/oidc"}),
+	}, &diags)
+	if diags.HasError() || auth.oauth == nil || auth.oauth.ClientAssertionFile != "/var/run/oidc" {
+		t.Fatalf("auth = %+v, diags = %v", auth, diags)
+	}
+
+	diags = nil
+	resolveAuth(context.Background(), orcasecurityProviderModel{
+		OAuth: oauthObject(t, map[string]string{"client_secret": "s", "oidc_token": "jwt"}),
+	}, &diags)
+	if got := diags.ErrorsCount(); got != 3 {
+		t.Fatalf("errors = %d, want 3 (token_url, client_id, two secrets): %v", got, diags)
+	}
+}
+
+func validationClient(t *testing.T, status int, body string) *api_client.APIClient {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/user/action" {
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+	return &api_client.APIClient{APIEndpoint: srv.URL, APIToken: "tok", HTTPClient: srv.Client(), MaxRetryAttempts: 1}
+}
+
+func TestValidateCredentials_StoresOrganization(t *testing.T) {
+	client := validationClient(t, http.StatusOK, `{"data": {"organization_id": "org-1", "organization_name": "Acme", "permissions": ["rbac.read", "rbac.write"]}}`)
+	var diags diag.Diagnostics
+	validateCredentials(context.Background(), client, &diags)
+	if diags.HasError() || client.Organization == nil || client.Organization.ID != "org-1" {
+		t.Fatalf("organization = %+v, diags = %v", client.Organization, diags)
+	}
+	if has, known := client.HasPermission("rbac.write"); !has || !known {
+		t.Errorf("HasPermission(rbac.write) = %v, %v; want the reported permission", has, known)
+	}
+	if has, known := client.HasPermission("automation.write"); has || !known {
+		t.Errorf("HasPermission(automation.write) = %v, %v; want known and missing", has, known)
+	}
+}
+
+func TestHasPermission_UnknownWithoutValidation(t *testing.T) {
+	client := validationClient(t, http.StatusOK, `{"data": {"organization_id": "org-1"}}`)
+	if _, known := client.HasPermission("rbac.write"); known {
+		t.Error("permissions known before Configure validated the token")
+	}
+	var diags diag.Diagnostics
+	validateCredentials(context.Background(), client, &diags)
+	if _, known := client.HasPermission("rbac.write"); known {
+		t.Error("permissions known although the API reported none")
+	}
+}
+
+func TestValidateCredentials_Diagnostics(t *testing.T) {
+	cases := map[string]struct {
+		status      int
+		wantSummary string
+		wantPath    bool
+	}{
+		"rejected token": {http.StatusUnauthorized, "Invalid Orca Security credentials", false},
+		"wrong endpoint": {http.StatusNotFound, "Unexpected response from the Orca Security API endpoint", true},
+	}
+	for name, tc := range cases {
+		var diags diag.Diagnostics
+		validateCredentials(context.Background(), validationClient(t, tc.status, `{"message": "nope"}`), &diags)
+		if diags.ErrorsCount() != 1 || diags[0].Summary() != tc.wantSummary {
+			t.Errorf("%s: diags = %v", name, diags)
+			continue
+		}
+		if _, ok := diags[0].(diag.DiagnosticWithPath); ok != tc.wantPath {
+			t.Errorf("%s: attribute diagnostic = %v, want %v", name, ok, tc.wantPath)
+		}
+	}
+}
+
+func TestValidateCredentials_UnreachableEndpoint(t *testing.T) {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	srv.Close()
+	client := &api_client.APIClient{APIEndpoint: srv.URL, APIToken: "tok", HTTPClient: &http.Client{}, MaxRetryAttempts: 1}
+
+	var diags diag.Diagnostics
+	validateCredentials(context.Background(), client, &diags)
+	if diags.ErrorsCount() != 1 || diags[0].Summary() != "Unable to reach the Orca Security API" {
+		t.Fatalf("diags = %v", diags)
+	}
+}
@@ -0,0 +1,169 @@
+package orcasecurity
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const regionEnvName = "ORCASECURITY_REGION"
+
+// regionEndpoints maps the `region` shorthand to the regional API base URL.
+var regionEndpoints = map[string]string{
+	"us": "https://api.orcasecurity.io",
+	"eu": "https://api.eu.orcasecurity.io",
+	"au": "https://api.au.orcasecurity.io",
+	"il": "https://api.il.orcasecurity.io",
+	"in": "https://api.in.orcasecurity.io",
+}
+
+func regionNames() []string {
+	names := make([]string, 0, len(regionEndpoints))
+	for name := range regionEndpoints {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// endpointSchemaAttributes are the two mutually exclusive ways to choose the
+// API base URL.
+func endpointSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"api_endpoint": schema.StringAttribute{
+			Optional: true,
+			MarkdownDescription: fmt.Sprintf("API endpoint. Alternatively set `%s` environment variable. ", apiEndpointEnvName) +
+				"Must be an `https://` URL (plain `http://` is accepted only for localhost). Conflicts with `region`. " +
+				"The provider will not start if neither an endpoint nor a region is provided.",
+			Validators: []validator.String{
+				endpointURLValidator{},
+				stringvalidator.ConflictsWith(path.MatchRoot("region")),
+			},
+		},
+		"region": schema.StringAttribute{
+			Optional: true,
+			MarkdownDescription: fmt.Sprintf("Orca Security region, one of `%s`, resolved to that region's API endpoint (e.g. `eu` is `%s`). Alternatively set `%s`. Conflicts with `api_endpoint`.",
+				strings.Join(regionNames(), "`, `"), regionEndpoints["eu"], regionEnvName),
+			Validators: []validator.String{
+				stringvalidator.OneOf(regionNames()...),
+			},
+		},
+	}
+}
+
+// resolveEndpoint returns the API base URL from api_endpoint or region, with
+// the provider block taking precedence over the environment. It returns ""
+// after adding a diagnostic when the settings conflict or are invalid.
+func resolveEndpoint(ctx context.Context, config orcasecurityProviderModel, diags *diag.Diagnostics) string {
+	endpoint, region := os.Getenv(apiEndpointEnvName), os.Getenv(regionEnvName)
+	switch {
+	case !config.APIEndpoint.IsNull() && !config.Region.IsNull():
+		addEndpointRegionConflict(diags, "api_endpoint and region are both set in the provider configuration.")
+		return ""
+	case !config.APIEndpoint.IsNull():
+		endpoint, region = config.APIEndpoint.ValueString(), ""
+	case !config.Region.IsNull():
+		endpoint, region = "", config.Region.ValueString()
+	case endpoint != "" && region != "":
+		addEndpointRegionConflict(diags, fmt.Sprintf("%s and %s are both set in the environment.", apiEndpointEnvName, regionEnvName))
+		return ""
+	}
+
+	if region != "" {
+		resolved, ok := regionEndpoints[region]
+		if !ok {
+			diags.AddAttributeError(
+				path.Root("region"),
+				"Unknown Orca Security region",
+				fmt.Sprintf("Region %q is not one of %s.", region, strings.Join(regionNames(), ", ")),
+			)
+			return ""
+		}
+		return resolved
+	}
+
+	// Trim trailing slashes from the API endpoint
+	trimmed := strings.TrimRight(endpoint, "/")
+	if trimmed != endpoint {
+		tflog.Warn(ctx, "Trailing slash detected in 'api_endpoint'. It has been automatically removed.", map[string]interface{}{
+			"original": endpoint,
+			"trimmed":  trimmed,
+		})
+	}
+	if trimmed == "" {
+		return ""
+	}
+	if err := checkEndpointURL(trimmed); err != nil {
+		diags.AddAttributeError(path.Root("api_endpoint"), "Invalid Orca Security API endpoint", err.Error())
+		return ""
+	}
+	return trimmed
+}
+
+func addEndpointRegionConflict(diags *diag.Diagnostics, detail string) {
+	diags.AddAttributeError(
+		path.Root("region"),
+		"Conflicting Orca Security endpoint settings",
+		detail+" Set either api_endpoint for a custom URL or region for a standard regional endpoint, not both.",
+	)
+}
+
+// checkEndpointURL rejects endpoints that are not absolute HTTPS base URLs.
+// Plain HTTP is allowed for loopback hosts so tests can target a local server.
+func checkEndpointURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" {
+		return fmt.Errorf("api_endpoint %q must be an absolute URL such as %s", raw, regionEndpoints["us"])
+	}
+	if u.RawQuery != "" || u.Fragment != "" || u.User != nil {
+		return fmt.Errorf("api_endpoint %q must be a base URL without credentials, query string or fragment", raw)
+	}
+	switch u.Scheme {
+	case "https":
+		return nil
+	case "http":
+		if isLoopbackHost(u.Hostname()) {
+			return nil
+		}
+	}
+	return fmt.Errorf("api_endpoint %q must use https://", raw)
+}
+
+func isLoopbackHost(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// endpointURLValidator applies checkEndpointURL at plan time.
+type endpointURLValidator struct{}
+
+func (v endpointURLValidator) Description(_ context.Context) string {
+	return "value must be an absolute https:// URL"
+}
+
+func (v endpointURLValidator) MarkdownDescription(_ context.Context) string {
+	return "value must be an absolute `https://` URL (e.g. `https://api.orcasecurity.io`)"
+}
+
+func (v endpointURLValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() || req.ConfigValue.ValueString() == "" {
+		return
+	}
+	if err := checkEndpointURL(strings.TrimRight(req.ConfigValue.ValueString(), "/")); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Orca Security API endpoint", err.Error())
+	}
+}
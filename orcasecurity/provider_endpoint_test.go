@@ -0,0 +1,85 @@
+package orcasecurity
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestResolveEndpoint_Region(t *testing.T) {
+	t.Setenv(apiEndpointEnvName, "https://env.example.com")
+	var diags diag.Diagnostics
+	got := resolveEndpoint(context.Background(), orcasecurityProviderModel{Region: types.StringValue("eu")}, &diags)
+	if diags.HasError() || got != "https://api.eu.orcasecurity.io" {
+		t.Fatalf("endpoint = %q, diags = %v", got, diags)
+	}
+}
+
+func TestResolveEndpoint_TrimsAndValidates(t *testing.T) {
+	t.Setenv(apiEndpointEnvName, "")
+	t.Setenv(regionEnvName, "")
+	cases := map[string]struct {
+		endpoint string
+		want     string
+		wantErr  bool
+	}{
+		"trailing slash": {"https://api.orcasecurity.io/", "https://api.orcasecurity.io", false},
+		"localhost http": {"http://127.0.0.1:8080", "http://127.0.0.1:8080", false},
+		"plain http":     {"http://api.orcasecurity.io", "", true},
+		"no scheme":      {"api.orcasecurity.io", "", true},
+		"query string":   {"https://api.orcasecurity.io?x=1", "", true},
+	}
+	for name, tc := range cases {
+		var diags diag.Diagnostics
+		got := resolveEndpoint(context.Background(), orcasecurityProviderModel{APIEndpoint: types.StringValue(tc.endpoint)}, &diags)
+		if got != tc.want || diags.HasError() != tc.wantErr {
+			t.Errorf("%s: endpoint = %q, diags = %v", name, got, diags)
+		}
+	}
+}
+
+func TestResolveEndpoint_Conflicts(t *testing.T) {
+	var diags diag.Diagnostics
+	resolveEndpoint(context.Background(), orcasecurityProviderModel{
+		APIEndpoint: types.StringValue("https://api.orcasecurity.io"),
+		Region:      types.StringValue("us"),
+	}, &diags)
+	if diags.ErrorsCount() != 1 || diags[0].Summary() != "Conflicting Orca Security endpoint settings" {
+		t.Fatalf("block conflict: diags = %v", diags)
+	}
+
+	t.Setenv(apiEndpointEnvName, "https://api.orcasecurity.io")
+	t.Setenv(regionEnvName, "au")
+	diags = nil
+	resolveEndpoint(context.Background(), orcasecurityProviderModel{}, &diags)
+	if diags.ErrorsCount() != 1 {
+		t.Fatalf("environment conflict: diags = %v", diags)
+	}
+
+	diags = nil
+	got := resolveEndpoint(context.Background(), orcasecurityProviderModel{Region: types.StringValue("il")}, &diags)
+	if diags.HasError() || got != regionEndpoints["il"] {
+		t.Fatalf("the provider block should override a conflicting environment: %q, %v", got, diags)
+	}
+}
+
+func TestEndpointURLValidator(t *testing.T) {
+	for value, wantErr := range map[string]bool{
+		"https://api.orcasecurity.io": false,
+		"ftp://api.orcasecurity.io":   true,
+		"":                            false,
+	} {
+		resp := &validator.StringResponse{}
+		endpointURLValidator{}.ValidateString(context.Background(), validator.StringRequest{
+			Path:        path.Root("api_endpoint"),
+			ConfigValue: types.StringValue(value),
+		}, resp)
+		if resp.Diagnostics.HasError() != wantErr {
+			t.Errorf("%q: diags = %v", value, resp.Diagnostics)
+		}
+	}
+}
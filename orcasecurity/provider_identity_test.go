@@ -0,0 +1,44 @@
+package orcasecurity
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// Every resource must declare an identity so `import { identity = {...} }` works provider-wide,
+// and each identity attribute must name a state attribute so it can be derived from state.
+func TestResources_DeclareIdentity(t *testing.T) {
+	ctx := context.Background()
+	p := &orcasecurityProvider{}
+	for _, newResource := range p.Resources(ctx) {
+		r := newResource()
+		meta := resource.MetadataResponse{}
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "orcasecurity"}, &meta)
+
+		withIdentity, ok := r.(resource.ResourceWithIdentity)
+		if !ok {
+			t.Errorf("%s: no identity schema", meta.TypeName)
+			continue
+		}
+		identity := resource.IdentitySchemaResponse{}
+		withIdentity.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identity)
+		if identity.Diagnostics.HasError() || len(identity.IdentitySchema.Attributes) == 0 {
+			t.Errorf("%s: empty identity schema: %v", meta.TypeName, identity.Diagnostics)
+			continue
+		}
+
+		schema := resource.SchemaResponse{}
+		r.Schema(ctx, resource.SchemaRequest{}, &schema)
+		for name := range identity.IdentitySchema.Attributes {
+			if name == "service_name" {
+				// Fixed per integration type rather than stored in state.
+				continue
+			}
+			if _, ok := schema.Schema.Attributes[name]; !ok {
+				t.Errorf("%s: identity attribute %q is not a state attribute", meta.TypeName, name)
+			}
+		}
+	}
+}
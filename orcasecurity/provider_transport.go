@@ -0,0 +1,213 @@
+package orcasecurity
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	proxyURLEnvName         = "ORCASECURITY_PROXY_URL"
+	caCertFileEnvName       = "ORCASECURITY_CA_CERT_FILE"
+	caCertPEMEnvName        = "ORCASECURITY_CA_CERT_PEM"
+	clientCertFileEnvName   = "ORCASECURITY_CLIENT_CERT_FILE"
+	clientKeyFileEnvName    = "ORCASECURITY_CLIENT_KEY_FILE"
+	requestTimeoutEnvName   = "ORCASECURITY_REQUEST_TIMEOUT"
+	maxRetryAttemptsEnvName = "ORCASECURITY_MAX_RETRY_ATTEMPTS"
+	maxRetryBackoffEnvName  = "ORCASECURITY_MAX_RETRY_BACKOFF"
+
+	maxRequestsPerSecondEnvName  = "ORCASECURITY_MAX_REQUESTS_PER_SECOND"
+	maxConcurrentRequestsEnvName = "ORCASECURITY_MAX_CONCURRENT_REQUESTS"
+
+	cacheResponsesEnvName = "ORCASECURITY_CACHE_RESPONSES"
+)
+
+// transportSchemaAttributes are the provider attributes that shape the HTTP
+// client: proxy, TLS trust, mTLS identity, per-attempt timeout, retry budget,
+// the client-wide request rate and the collection response cache.
+func transportSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"proxy_url": schema.StringAttribute{
+			Optional: true,
+			MarkdownDescription: fmt.Sprintf("Proxy URL for all API requests, e.g. `http://proxy.internal:3128`. Alternatively set `%s`. ", proxyURLEnvName) +
+				"When unset, the standard `HTTPS_PROXY`/`NO_PROXY` environment variables apply.",
+		},
+		"ca_cert_file": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: fmt.Sprintf("Path to a PEM CA bundle trusted in addition to the system roots, e.g. a TLS-inspecting proxy's CA. Alternatively set `%s`.", caCertFileEnvName),
+		},
+		"ca_cert_pem": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: fmt.Sprintf("Inline PEM CA certificates trusted in addition to the system roots. Alternatively set `%s`.", caCertPEMEnvName),
+		},
+		"client_cert_file": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: fmt.Sprintf("Path to a PEM client certificate for mutual TLS. Requires `client_key_file`. Alternatively set `%s`.", clientCertFileEnvName),
+		},
+		"client_key_file": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: fmt.Sprintf("Path to the PEM private key for `client_cert_file`. Alternatively set `%s`.", clientKeyFileEnvName),
+		},
+		"request_timeout": schema.StringAttribute{
+			Optional: true,
+			MarkdownDescription: fmt.Sprintf("Timeout for a single HTTP attempt, as a Go duration string. Defaults to `10s`. Alternatively set `%s`. ", requestTimeoutEnvName) +
+				"Each attempt is also cut short by the operation deadline from a `timeouts` block or `default_timeout`, whichever ends first.",
+		},
+		"max_retry_attempts": schema.Int64Attribute{
+			Optional:            true,
+			MarkdownDescription: fmt.Sprintf("Attempts per request, first try included, for transient failures and 408/429/502/503/504 responses. Defaults to `5`. Alternatively set `%s`.", maxRetryAttemptsEnvName),
+		},
+		"max_retry_backoff": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: fmt.Sprintf("Upper bound on a single exponential backoff sleep between attempts, as a Go duration string. Defaults to `16s`. Alternatively set `%s`.", maxRetryBackoffEnvName),
+		},
+		"max_requests_per_second": schema.Float64Attribute{
+			Optional: true,
+			MarkdownDescription: fmt.Sprintf("Client-side cap on API requests per second, shared by every resource and data source. Unlimited by default. Alternatively set `%s`. ", maxRequestsPerSecondEnvName) +
+				"A `429` or `503` with `Retry-After` additionally pauses all requests for the requested time.",
+		},
+		"max_concurrent_requests": schema.Int64Attribute{
+			Optional:            true,
+			MarkdownDescription: fmt.Sprintf("Maximum API requests in flight at once, across all resources and data sources. Unlimited by default. Alternatively set `%s`.", maxConcurrentRequestsEnvName),
+		},
+		"cache_responses": schema.BoolAttribute{
+			Optional:            true,
+			MarkdownDescription: fmt.Sprintf("Cache collection lookups (RBAC access assignments, users, roles, automations, AppSec catalog controls) for the duration of one Terraform run, so large configurations list each collection once instead of once per resource. Any write to a collection evicts its cached pages. Defaults to `false`. Alternatively set `%s` to `true`.", cacheResponsesEnvName),
+		},
+	}
+}
+
+// transportConfig resolves the transport settings from the provider block,
+// falling back to the environment. Invalid values are reported against the
+// attribute they came from.
+func transportConfig(config orcasecurityProviderModel, diags *diag.Diagnostics) api_client.TransportConfig {
+	cfg := api_client.TransportConfig{
+		ProxyURL:       stringSetting(config.ProxyURL, proxyURLEnvName),
+		CACertFile:     stringSetting(config.CACertFile, caCertFileEnvName),
+		CACertPEM:      stringSetting(config.CACertPEM, caCertPEMEnvName),
+		ClientCertFile: stringSetting(config.ClientCertFile, clientCertFileEnvName),
+		ClientKeyFile:  stringSetting(config.ClientKeyFile, clientKeyFileEnvName),
+
+		RequestTimeout:   durationSetting(config.RequestTimeout, requestTimeoutEnvName, "request_timeout", diags),
+		MaxRetryAttempts: positiveIntSetting(config.MaxRetryAttempts, maxRetryAttemptsEnvName, "max_retry_attempts", diags),
+		MaxRetryBackoff:  durationSetting(config.MaxRetryBackoff, maxRetryBackoffEnvName, "max_retry_backoff", diags),
+
+		MaxRequestsPerSecond:  maxRequestsPerSecondSetting(config.MaxRequestsPerSecond, diags),
+		MaxConcurrentRequests: positiveIntSetting(config.MaxConcurrentRequests, maxConcurrentRequestsEnvName, "max_concurrent_requests", diags),
+
+		CacheResponses: boolSetting(config.CacheResponses, cacheResponsesEnvName, "cache_responses", diags),
+	}
+
+	if (cfg.ClientCertFile == "") != (cfg.ClientKeyFile == "") {
+		diags.AddAttributeError(
+			path.Root("client_cert_file"),
+			"Incomplete mutual TLS configuration",
+			"client_cert_file and client_key_file must be set together.",
+		)
+	}
+	return cfg
+}
+
+func stringSetting(v types.String, envName string) string {
+	if !v.IsNull() {
+		return v.ValueString()
+	}
+	return os.Getenv(envName)
+}
+
+func boolSetting(v types.Bool, envName, attr string, diags *diag.Diagnostics) bool {
+	if !v.IsNull() {
+		return v.ValueBool()
+	}
+	raw := os.Getenv(envName)
+	if raw == "" {
+		return false
+	}
+	b, err := strconv.ParseBool(raw)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root(attr),
+			"Invalid "+strings.ReplaceAll(attr, "_", " "),
+			fmt.Sprintf("%s value %q must be \"true\" or \"false\".", envName, raw),
+		)
+		return false
+	}
+	return b
+}
+
+// positiveIntSetting resolves an optional positive integer from the provider
+// block or envName. Zero means unset.
+func positiveIntSetting(v types.Int64, envName, attr string, diags *diag.Diagnostics) int {
+	var n int64
+	switch raw := os.Getenv(envName); {
+	case !v.IsNull():
+		n = v.ValueInt64()
+	case raw != "":
+		parsed, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			parsed = -1
+		}
+		n = parsed
+	default:
+		return 0
+	}
+	if n < 1 {
+		diags.AddAttributeError(
+			path.Root(attr),
+			"Invalid "+strings.ReplaceAll(attr, "_", " "),
+			fmt.Sprintf("%s (or %s) must be a positive integer.", attr, envName),
+		)
+		return 0
+	}
+	return int(n)
+}
+
+func maxRequestsPerSecondSetting(v types.Float64, diags *diag.Diagnostics) float64 {
+	var n float64
+	switch raw := os.Getenv(maxRequestsPerSecondEnvName); {
+	case !v.IsNull():
+		n = v.ValueFloat64()
+	case raw != "":
+		parsed, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			parsed = -1
+		}
+		n = parsed
+	default:
+		return 0
+	}
+	if n <= 0 {
+		diags.AddAttributeError(
+			path.Root("max_requests_per_second"),
+			"Invalid max requests per second",
+			fmt.Sprintf("max_requests_per_second (or %s) must be a positive number.", maxRequestsPerSecondEnvName),
+		)
+		return 0
+	}
+	return n
+}
+
+func durationSetting(v types.String, envName, attr string, diags *diag.Diagnostics) time.Duration {
+	raw := stringSetting(v, envName)
+	if raw == "" {
+		return 0
+	}
+	d, err := time.ParseDuration(raw)
+	if err != nil || d <= 0 {
+		diags.AddAttributeError(
+			path.Root(attr),
+			"Invalid duration",
+			fmt.Sprintf("%s (or %s) value %q must be a positive duration such as \"30s\" or \"2m\".", attr, envName, raw),
+		)
+		return 0
+	}
+	return d
+}
@@ -0,0 +1,80 @@
+package orcasecurity
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestTransportConfig_AttributeOverridesEnv(t *testing.T) {
+	t.Setenv(proxyURLEnvName, "http://env-proxy:3128")
+	t.Setenv(requestTimeoutEnvName, "45s")
+	t.Setenv(maxRetryAttemptsEnvName, "7")
+
+	config := orcasecurityProviderModel{
+		ProxyURL:         types.StringValue("http://block-proxy:3128"),
+		MaxRetryAttempts: types.Int64Null(),
+		MaxRetryBackoff:  types.StringValue("2s"),
+	}
+	var diags diag.Diagnostics
+	cfg := transportConfig(config, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+	if cfg.ProxyURL != "http://block-proxy:3128" {
+		t.Errorf("ProxyURL = %q, want the provider block value", cfg.ProxyURL)
+	}
+	if cfg.RequestTimeout != 45*time.Second {
+		t.Errorf("RequestTimeout = %s, want 45s from the environment", cfg.RequestTimeout)
+	}
+	if cfg.MaxRetryAttempts != 7 {
+		t.Errorf("MaxRetryAttempts = %d, want 7 from the environment", cfg.MaxRetryAttempts)
+	}
+	if cfg.MaxRetryBackoff != 2*time.Second {
+		t.Errorf("MaxRetryBackoff = %s, want 2s", cfg.MaxRetryBackoff)
+	}
+}
+
+func TestTransportConfig_InvalidValues(t *testing.T) {
+	config := orcasecurityProviderModel{
+		RequestTimeout:   types.StringValue("soon"),
+		MaxRetryAttempts: types.Int64Value(0),
+		ClientCertFile:   types.StringValue("/tmp/cert.pem"),
+	}
+	var diags diag.Diagnostics
+	transportConfig(config, &diags)
+	if got := diags.ErrorsCount(); got != 3 {
+		t.Fatalf("errors = %d, want 3 (request_timeout, max_retry_attempts, mTLS pair): %v", got, diags)
+	}
+}
+
+func TestTransportConfig_CacheResponses(t *testing.T) {
+	t.Setenv(cacheResponsesEnvName, "true")
+	var diags diag.Diagnostics
+	if cfg := transportConfig(orcasecurityProviderModel{}, &diags); !cfg.CacheResponses {
+		t.Error("CacheResponses = false, want true from the environment")
+	}
+	if cfg := transportConfig(orcasecurityProviderModel{CacheResponses: types.BoolValue(false)}, &diags); cfg.CacheResponses {
+		t.Error("CacheResponses = true, want the provider block value to win")
+	}
+
+	t.Setenv(cacheResponsesEnvName, "sometimes")
+	transportConfig(orcasecurityProviderModel{}, &diags)
+	if got := diags.ErrorsCount(); got != 1 {
+		t.Fatalf("errors = %d, want 1 for an unparsable %s: %v", got, cacheResponsesEnvName, diags)
+	}
+}
+
+// A transport wrapper belongs to the provider instances built with it, never to the package.
+func TestWithTransport_ScopedToProvider(t *testing.T) {
+	wrap := func(rt http.RoundTripper) http.RoundTripper { return rt }
+	if p := New("test", WithTransport(wrap))().(*orcasecurityProvider); p.wrapTransport == nil {
+		t.Error("WithTransport did not set the wrapper")
+	}
+	if p := New("test")().(*orcasecurityProvider); p.wrapTransport != nil {
+		t.Error("a provider built without WithTransport has a wrapper")
+	}
+}
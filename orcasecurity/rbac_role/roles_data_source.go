@@ -89,7 +89,7 @@ type rbacRolesDataSourceModel struct {
 }
 
 func (ds *rbacRolesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	roles, err := ds.apiClient.ListRBACRoles()
+	roles, err := ds.apiClient.ListRBACRoles(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to read RBAC roles", err.Error())
 		return
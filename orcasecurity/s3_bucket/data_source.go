@@ -94,7 +94,7 @@ func (ds *s3BucketPolicyDataSource) Read(ctx context.Context, req datasource.Rea
 		return
 	}
 
-	settings, err := ds.apiClient.GetOrcaSettings()
+	settings, err := ds.apiClient.GetOrcaSettings(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error fetching Orca settings",
@@ -12,6 +12,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -44,6 +45,7 @@ type state struct {
 func NewS3BucketResource() resource.Resource {
 	return cc.New(cc.Spec[api_client.S3BucketExternalServiceConfig]{
 		TypeNameSuffix: "_integration_s3_bucket",
+		ServiceName:    api_client.S3BucketServiceName,
 		UIName:         "S3 bucket integration",
 		Description:    "Manage an S3 Bucket integration in Orca. Orca uploads alert exports into the customer-owned S3 bucket. After creating the resource, attach the rendered `bucket_policy_json` to the bucket so Orca's uploader role can `PutObject` under `folder/*`.",
 		VariantAttributes: map[string]schema.Attribute{
@@ -95,7 +97,7 @@ func NewS3BucketResource() resource.Resource {
 				},
 			}
 		},
-		Extract: func(o *api_client.S3BucketExternalServiceConfig, st cc.State, _ *diag.Diagnostics) cc.APIObject {
+		Extract: func(_ context.Context, o *api_client.S3BucketExternalServiceConfig, st cc.State, _ *diag.Diagnostics) cc.APIObject {
 			s := st.(*state)
 			if o.Config.ArnOrURL != "" {
 				s.ArnOrURL = types.StringValue(o.Config.ArnOrURL)
@@ -113,19 +115,25 @@ func NewS3BucketResource() resource.Resource {
 		Get:          (*api_client.APIClient).GetS3BucketConfig,
 		Update:       (*api_client.APIClient).UpdateS3BucketConfig,
 		Delete:       (*api_client.APIClient).DeleteS3BucketConfig,
+		List:         (*api_client.APIClient).ListS3BucketConfigs,
 	})
 }
 
+// NewS3BucketListResource lists the integrations managed by NewS3BucketResource.
+func NewS3BucketListResource() list.ListResource {
+	return cc.NewList(NewS3BucketResource)
+}
+
 // populateComputed derives bucket_name / uploader_role_arn / bucket_policy_json /
 // bucket_policy_instructions from arn_or_url + the Orca settings document.
-func populateComputed(client *api_client.APIClient, st cc.State, diags *diag.Diagnostics) {
+func populateComputed(ctx context.Context, client *api_client.APIClient, st cc.State, diags *diag.Diagnostics) {
 	s := st.(*state)
 	bucket, err := parseBucketName(s.ArnOrURL.ValueString())
 	if err != nil {
 		diags.AddError(errRenderingPolicy, err.Error())
 		return
 	}
-	settings, err := client.GetOrcaSettings()
+	settings, err := client.GetOrcaSettings(ctx)
 	if err != nil {
 		diags.AddError(errRenderingPolicy, fmt.Sprintf("could not fetch Orca settings to build bucket policy: %s", err.Error()))
 		return
@@ -6,11 +6,13 @@ import (
 	"fmt"
 	"regexp"
 	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	"terraform-provider-orcasecurity/orcasecurity/apierror_common"
+	"terraform-provider-orcasecurity/orcasecurity/identity_common"
+	"terraform-provider-orcasecurity/orcasecurity/timeouts_common"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -26,6 +28,7 @@ var (
 	_ resource.Resource                = &scheduledReportResource{}
 	_ resource.ResourceWithConfigure   = &scheduledReportResource{}
 	_ resource.ResourceWithImportState = &scheduledReportResource{}
+	_ resource.ResourceWithIdentity    = &scheduledReportResource{}
 )
 
 var exportTimeRegex = regexp.MustCompile(`^([01]\d|2[0-3]):[0-5]\d:[0-5]\d$`)
@@ -116,6 +119,8 @@ type scheduledReportResourceModel struct {
 
 	ShareToSnowflake  types.Bool   `tfsdk:"share_to_snowflake"`
 	SnowflakeTemplate types.String `tfsdk:"snowflake_template"`
+
+	Timeouts timeouts_common.Value `tfsdk:"timeouts"`
 }
 
 func NewScheduledReportResource() resource.Resource {
@@ -134,7 +139,11 @@ func (r *scheduledReportResource) Configure(_ context.Context, req resource.Conf
 }
 
 func (r *scheduledReportResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	identity_common.ImportID(ctx, req, resp)
+}
+
+func (r *scheduledReportResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity_common.IDSchema()
 }
 
 func (r *scheduledReportResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -318,6 +327,9 @@ func (r *scheduledReportResource) Schema(ctx context.Context, req resource.Schem
 				Optional:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts_common.Block(ctx),
+		},
 	}
 }
 
@@ -472,6 +484,9 @@ func (r *scheduledReportResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	ctx, cancel := timeouts_common.Create(ctx, plan.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
 	payload, jsonErrors := r.buildAPIPayload(ctx, &plan)
 	for _, message := range jsonErrors {
 		resp.Diagnostics.AddError("Error creating scheduled report", message)
@@ -480,16 +495,14 @@ func (r *scheduledReportResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
-	instance, err := r.apiClient.CreateScheduledReport(*payload)
+	instance, err := r.apiClient.CreateScheduledReport(ctx, *payload)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating scheduled report",
-			"Could not create scheduled report, unexpected error: "+err.Error(),
-		)
+		apierror_common.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, "Error creating scheduled report", "Could not create scheduled report", err)
 		return
 	}
 
 	plan.ID = types.StringValue(instance.ID)
+	resp.Diagnostics.Append(identity_common.SetID(ctx, resp.Identity, plan.ID.ValueString())...)
 	plan.Status = types.StringValue(reportStatusToString(instance.Status))
 
 	diags = resp.State.Set(ctx, &plan)
@@ -497,6 +510,7 @@ func (r *scheduledReportResource) Create(ctx context.Context, req resource.Creat
 }
 
 func (r *scheduledReportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(identity_common.SetIDFromState(ctx, resp.Identity, req.State)...)
 	var state scheduledReportResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -504,7 +518,10 @@ func (r *scheduledReportResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
-	instance, err := r.apiClient.GetScheduledReport(state.ID.ValueString())
+	ctx, cancel := timeouts_common.Read(ctx, state.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
+	instance, err := r.apiClient.GetScheduledReport(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading scheduled report",
@@ -592,6 +609,7 @@ func (r *scheduledReportResource) Read(ctx context.Context, req resource.ReadReq
 }
 
 func (r *scheduledReportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(identity_common.SetIDFromState(ctx, resp.Identity, req.State)...)
 	var plan scheduledReportResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -599,6 +617,9 @@ func (r *scheduledReportResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	ctx, cancel := timeouts_common.Update(ctx, plan.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
 	payload, jsonErrors := r.buildAPIPayload(ctx, &plan)
 	for _, message := range jsonErrors {
 		resp.Diagnostics.AddError("Error updating scheduled report", message)
@@ -607,12 +628,9 @@ func (r *scheduledReportResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
-	instance, err := r.apiClient.UpdateScheduledReport(plan.ID.ValueString(), *payload)
+	instance, err := r.apiClient.UpdateScheduledReport(ctx, plan.ID.ValueString(), *payload)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating scheduled report",
-			"Could not update scheduled report, unexpected error: "+err.Error(),
-		)
+		apierror_common.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, "Error updating scheduled report", "Could not update scheduled report", err)
 		return
 	}
 
@@ -630,7 +648,10 @@ func (r *scheduledReportResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
-	err := r.apiClient.DeleteScheduledReport(state.ID.ValueString())
+	ctx, cancel := timeouts_common.Delete(ctx, state.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
+	err := r.apiClient.DeleteScheduledReport(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting scheduled report",
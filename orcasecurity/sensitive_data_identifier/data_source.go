@@ -136,7 +136,7 @@ func (ds *sensitiveDataIdentifiersDataSource) Read(ctx context.Context, req data
 		return
 	}
 
-	detectors, err := ds.apiClient.ListDSPMDetectors(api_client.DSPMDetectorListFilters{
+	detectors, err := ds.apiClient.ListDSPMDetectors(ctx, api_client.DSPMDetectorListFilters{
 		Title:       config.Title.ValueString(),
 		Category:    config.Category.ValueString(),
 		SubCategory: config.SubCategory.ValueString(),
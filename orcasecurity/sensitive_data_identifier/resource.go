@@ -4,12 +4,14 @@ import (
 	"context"
 	"fmt"
 	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	"terraform-provider-orcasecurity/orcasecurity/apierror_common"
+	"terraform-provider-orcasecurity/orcasecurity/identity_common"
 	"terraform-provider-orcasecurity/orcasecurity/tfconv"
+	"terraform-provider-orcasecurity/orcasecurity/timeouts_common"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -25,6 +27,7 @@ var (
 	_ resource.Resource                = &sensitiveDataIdentifierResource{}
 	_ resource.ResourceWithConfigure   = &sensitiveDataIdentifierResource{}
 	_ resource.ResourceWithImportState = &sensitiveDataIdentifierResource{}
+	_ resource.ResourceWithIdentity    = &sensitiveDataIdentifierResource{}
 )
 
 type sensitiveDataIdentifierResource struct {
@@ -60,6 +63,8 @@ type stateModel struct {
 	SubCategory    types.String     `tfsdk:"sub_category"`
 	Enabled        types.Bool       `tfsdk:"enabled"`
 	Properties     *propertiesModel `tfsdk:"properties"`
+
+	Timeouts timeouts_common.Value `tfsdk:"timeouts"`
 }
 
 func NewSensitiveDataIdentifierResource() resource.Resource {
@@ -78,10 +83,14 @@ func (r *sensitiveDataIdentifierResource) Configure(_ context.Context, req resou
 }
 
 func (r *sensitiveDataIdentifierResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	identity_common.ImportID(ctx, req, resp)
+}
+
+func (r *sensitiveDataIdentifierResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity_common.IDSchema()
 }
 
-func (r *sensitiveDataIdentifierResource) Schema(_ context.Context, req resource.SchemaRequest, res *resource.SchemaResponse) {
+func (r *sensitiveDataIdentifierResource) Schema(ctx context.Context, req resource.SchemaRequest, res *resource.SchemaResponse) {
 	res.Schema = schema.Schema{
 		Description: "Provides a custom DSPM sensitive data identifier (detector). Sensitive data identifiers describe the data patterns DSPM scanning looks for.",
 		Attributes: map[string]schema.Attribute{
@@ -215,6 +224,9 @@ func (r *sensitiveDataIdentifierResource) Schema(_ context.Context, req resource
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts_common.Block(ctx),
+		},
 	}
 }
 
@@ -275,12 +287,12 @@ func (r *sensitiveDataIdentifierResource) Create(ctx context.Context, req resour
 		return
 	}
 
-	instance, err := r.apiClient.CreateDSPMDetector(generateDetectorPayload(ctx, plan))
+	ctx, cancel := timeouts_common.Create(ctx, plan.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
+	instance, err := r.apiClient.CreateDSPMDetector(ctx, generateDetectorPayload(ctx, plan))
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating Sensitive Data Identifier",
-			"Could not create Sensitive Data Identifier, unexpected error: "+err.Error(),
-		)
+		apierror_common.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, "Error creating Sensitive Data Identifier", "Could not create Sensitive Data Identifier", err)
 		return
 	}
 
@@ -294,6 +306,7 @@ func (r *sensitiveDataIdentifierResource) Create(ctx context.Context, req resour
 }
 
 func (r *sensitiveDataIdentifierResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(identity_common.SetIDFromState(ctx, resp.Identity, req.State)...)
 	var state stateModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -301,7 +314,10 @@ func (r *sensitiveDataIdentifierResource) Read(ctx context.Context, req resource
 		return
 	}
 
-	instance, err := r.apiClient.GetDSPMDetector(state.ID.ValueString())
+	ctx, cancel := timeouts_common.Read(ctx, state.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
+	instance, err := r.apiClient.GetDSPMDetector(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading Sensitive Data Identifier",
@@ -342,6 +358,7 @@ func (r *sensitiveDataIdentifierResource) Read(ctx context.Context, req resource
 	}
 
 	state.ID = types.StringValue(instance.ID)
+	resp.Diagnostics.Append(identity_common.SetID(ctx, resp.Identity, state.ID.ValueString())...)
 	state.OrganizationID = types.StringValue(instance.OrganizationID)
 	state.Title = types.StringValue(instance.Title)
 	state.Details = types.StringValue(instance.Details)
@@ -367,6 +384,7 @@ func (r *sensitiveDataIdentifierResource) Read(ctx context.Context, req resource
 }
 
 func (r *sensitiveDataIdentifierResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(identity_common.SetIDFromState(ctx, resp.Identity, req.State)...)
 	var plan stateModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -374,6 +392,9 @@ func (r *sensitiveDataIdentifierResource) Update(ctx context.Context, req resour
 		return
 	}
 
+	ctx, cancel := timeouts_common.Update(ctx, plan.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
 	if plan.ID.ValueString() == "" {
 		resp.Diagnostics.AddError(
 			"ID is null",
@@ -382,12 +403,9 @@ func (r *sensitiveDataIdentifierResource) Update(ctx context.Context, req resour
 		return
 	}
 
-	instance, err := r.apiClient.UpdateDSPMDetector(plan.ID.ValueString(), generateDetectorPayload(ctx, plan))
+	instance, err := r.apiClient.UpdateDSPMDetector(ctx, plan.ID.ValueString(), generateDetectorPayload(ctx, plan))
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating Sensitive Data Identifier",
-			"Could not update Sensitive Data Identifier, unexpected error: "+err.Error(),
-		)
+		apierror_common.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, "Error updating Sensitive Data Identifier", "Could not update Sensitive Data Identifier", err)
 		return
 	}
 
@@ -408,7 +426,10 @@ func (r *sensitiveDataIdentifierResource) Delete(ctx context.Context, req resour
 		return
 	}
 
-	err := r.apiClient.DeleteDSPMDetector(state.ID.ValueString())
+	ctx, cancel := timeouts_common.Delete(ctx, state.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
+	err := r.apiClient.DeleteDSPMDetector(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting Sensitive Data Identifier",
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	"terraform-provider-orcasecurity/orcasecurity/timeouts_common"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -95,6 +96,7 @@ func minModel(id string) serviceNowITSMResourceModel {
 		URL:      types.StringValue("u"),
 		Username: types.StringValue("user"),
 		Password: types.StringValue("p"),
+		Timeouts: timeouts_common.Null(context.Background()),
 	}
 }
 
@@ -106,6 +108,7 @@ func prodPlanModel() serviceNowITSMResourceModel {
 		URL:      types.StringValue("https://acme.service-now.com"),
 		Username: types.StringValue("svc-orca"),
 		Password: types.StringValue("s3cret"),
+		Timeouts: timeouts_common.Null(context.Background()),
 	}
 }
 
@@ -119,6 +122,7 @@ func TestRead_PopulatesFromAPIAndPreservesPassword(t *testing.T) {
 		URL:      types.StringValue("stale"),
 		Username: types.StringValue("stale"),
 		Password: types.StringValue("kept-secret"),
+		Timeouts: timeouts_common.Null(context.Background()),
 	})}
 	resp := &resource.ReadResponse{State: tfsdk.State{Schema: sch}}
 	r.Read(context.Background(), req, resp)
@@ -77,7 +77,7 @@ func (ds *itsmDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	current, err := ds.apiClient.GetServiceNowITSMResourceByName(state.Name.ValueString())
+	current, err := ds.apiClient.GetServiceNowITSMResourceByName(ctx, state.Name.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error looking up ServiceNow ITSM resource",
@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	"terraform-provider-orcasecurity/orcasecurity/apierror_common"
+	"terraform-provider-orcasecurity/orcasecurity/identity_common"
+	"terraform-provider-orcasecurity/orcasecurity/timeouts_common"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -19,6 +21,7 @@ var (
 	_ resource.Resource                = &serviceNowITSMResource{}
 	_ resource.ResourceWithConfigure   = &serviceNowITSMResource{}
 	_ resource.ResourceWithImportState = &serviceNowITSMResource{}
+	_ resource.ResourceWithIdentity    = &serviceNowITSMResource{}
 )
 
 type serviceNowITSMResource struct {
@@ -31,6 +34,8 @@ type serviceNowITSMResourceModel struct {
 	URL      types.String `tfsdk:"servicenow_url"`
 	Username types.String `tfsdk:"username"`
 	Password types.String `tfsdk:"password"`
+
+	Timeouts timeouts_common.Value `tfsdk:"timeouts"`
 }
 
 func NewServiceNowResource() resource.Resource {
@@ -48,7 +53,7 @@ func (r *serviceNowITSMResource) Configure(_ context.Context, req resource.Confi
 	r.apiClient = req.ProviderData.(*api_client.APIClient)
 }
 
-func (r *serviceNowITSMResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *serviceNowITSMResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manage a ServiceNow ITSM integration in Orca. Creates an external service resource of type `sn_incidents` using Basic authentication.",
 		Attributes: map[string]schema.Attribute{
@@ -89,6 +94,9 @@ func (r *serviceNowITSMResource) Schema(_ context.Context, _ resource.SchemaRequ
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts_common.Block(ctx),
+		},
 	}
 }
 
@@ -116,16 +124,17 @@ func (r *serviceNowITSMResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
-	created, err := r.apiClient.CreateServiceNowITSMResource(r.buildPayload(plan))
+	ctx, cancel := timeouts_common.Create(ctx, plan.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
+	created, err := r.apiClient.CreateServiceNowITSMResource(ctx, r.buildPayload(plan))
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating ServiceNow ITSM integration",
-			fmt.Sprintf("Could not create ServiceNow ITSM integration: %s", err.Error()),
-		)
+		apierror_common.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, "Error creating ServiceNow ITSM integration", "Could not create ServiceNow ITSM integration", err)
 		return
 	}
 
 	plan.ID = types.StringValue(created.ID)
+	resp.Diagnostics.Append(identity_common.SetID(ctx, resp.Identity, plan.ID.ValueString())...)
 	// Keep the user-supplied URL/name in state in case the API normalises them. Refresh from
 	// the API response when it returns a value to avoid spurious diffs.
 	if created.HostURL != "" {
@@ -142,13 +151,17 @@ func (r *serviceNowITSMResource) Create(ctx context.Context, req resource.Create
 }
 
 func (r *serviceNowITSMResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(identity_common.SetIDFromState(ctx, resp.Identity, req.State)...)
 	var state serviceNowITSMResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	current, err := r.apiClient.GetServiceNowITSMResource(state.ID.ValueString())
+	ctx, cancel := timeouts_common.Read(ctx, state.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
+	current, err := r.apiClient.GetServiceNowITSMResource(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading ServiceNow ITSM integration",
@@ -174,24 +187,25 @@ func (r *serviceNowITSMResource) Read(ctx context.Context, req resource.ReadRequ
 }
 
 func (r *serviceNowITSMResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(identity_common.SetIDFromState(ctx, resp.Identity, req.State)...)
 	var plan serviceNowITSMResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := timeouts_common.Update(ctx, plan.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
 	var state serviceNowITSMResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	updated, err := r.apiClient.UpdateServiceNowITSMResource(state.ID.ValueString(), r.buildPayload(plan))
+	updated, err := r.apiClient.UpdateServiceNowITSMResource(ctx, state.ID.ValueString(), r.buildPayload(plan))
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating ServiceNow ITSM integration",
-			fmt.Sprintf("Could not update ServiceNow ITSM integration %s: %s", state.ID.ValueString(), err.Error()),
-		)
+		apierror_common.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, "Error updating ServiceNow ITSM integration", fmt.Sprintf("Could not update ServiceNow ITSM integration %s", state.ID.ValueString()), err)
 		return
 	}
 
@@ -216,7 +230,10 @@ func (r *serviceNowITSMResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
-	if err := r.apiClient.DeleteServiceNowITSMResource(state.ID.ValueString()); err != nil {
+	ctx, cancel := timeouts_common.Delete(ctx, state.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
+	if err := r.apiClient.DeleteServiceNowITSMResource(ctx, state.ID.ValueString()); err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting ServiceNow ITSM integration",
 			fmt.Sprintf("Could not delete ServiceNow ITSM integration %s: %s", state.ID.ValueString(), err.Error()),
@@ -226,5 +243,9 @@ func (r *serviceNowITSMResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *serviceNowITSMResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	identity_common.ImportID(ctx, req, resp)
+}
+
+func (r *serviceNowITSMResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity_common.IDSchema()
 }
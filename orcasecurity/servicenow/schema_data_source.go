@@ -101,7 +101,7 @@ func (ds *schemaDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	}
 
 	snType := state.Type.ValueString()
-	fields, err := ds.apiClient.GetServiceNowSchema(state.ResourceID.ValueString(), snType)
+	fields, err := ds.apiClient.GetServiceNowSchema(ctx, state.ResourceID.ValueString(), snType)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading ServiceNow schema",
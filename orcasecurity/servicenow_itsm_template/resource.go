@@ -2,8 +2,10 @@ package servicenow_itsm_template
 
 import (
 	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	cc "terraform-provider-orcasecurity/orcasecurity/config_integration_common"
 	"terraform-provider-orcasecurity/orcasecurity/servicenow_template_common"
 
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
 
@@ -19,5 +21,11 @@ func NewServiceNowITSMTemplateResource() resource.Resource {
 		Get:            (*api_client.APIClient).GetServiceNowITSMTemplate,
 		Update:         (*api_client.APIClient).UpdateServiceNowITSMTemplate,
 		Delete:         (*api_client.APIClient).DeleteServiceNowITSMTemplate,
+		List:           (*api_client.APIClient).ListServiceNowITSMTemplates,
 	})
 }
+
+// NewServiceNowITSMTemplateListResource lists the templates managed by NewServiceNowITSMTemplateResource.
+func NewServiceNowITSMTemplateListResource() list.ListResource {
+	return cc.NewList(NewServiceNowITSMTemplateResource)
+}
@@ -2,8 +2,10 @@ package servicenow_sir_template
 
 import (
 	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	cc "terraform-provider-orcasecurity/orcasecurity/config_integration_common"
 	"terraform-provider-orcasecurity/orcasecurity/servicenow_template_common"
 
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
 
@@ -19,5 +21,11 @@ func NewServiceNowSIRTemplateResource() resource.Resource {
 		Get:            (*api_client.APIClient).GetServiceNowSIRTemplate,
 		Update:         (*api_client.APIClient).UpdateServiceNowSIRTemplate,
 		Delete:         (*api_client.APIClient).DeleteServiceNowSIRTemplate,
+		List:           (*api_client.APIClient).ListServiceNowSIRTemplates,
 	})
 }
+
+// NewServiceNowSIRTemplateListResource lists the templates managed by NewServiceNowSIRTemplateResource.
+func NewServiceNowSIRTemplateListResource() list.ListResource {
+	return cc.NewList(NewServiceNowSIRTemplateResource)
+}
@@ -45,10 +45,11 @@ type Options struct {
 	UIName         string
 	Description    string
 	ConfigType     string
-	Create         func(*api_client.APIClient, api_client.ServiceNowITSMTemplate) (*api_client.ServiceNowITSMTemplate, error)
-	Get            func(*api_client.APIClient, string) (*api_client.ServiceNowITSMTemplate, error)
-	Update         func(*api_client.APIClient, string, api_client.ServiceNowITSMTemplate) (*api_client.ServiceNowITSMTemplate, error)
-	Delete         func(*api_client.APIClient, string) error
+	Create         func(*api_client.APIClient, context.Context, api_client.ServiceNowITSMTemplate) (*api_client.ServiceNowITSMTemplate, error)
+	Get            func(*api_client.APIClient, context.Context, string) (*api_client.ServiceNowITSMTemplate, error)
+	Update         func(*api_client.APIClient, context.Context, string, api_client.ServiceNowITSMTemplate) (*api_client.ServiceNowITSMTemplate, error)
+	Delete         func(*api_client.APIClient, context.Context, string) error
+	List           func(*api_client.APIClient, context.Context) ([]api_client.ServiceNowITSMTemplate, error)
 }
 
 func variantAttributes() map[string]schema.Attribute {
@@ -157,6 +158,7 @@ func extractStateFromAPI(api *api_client.ServiceNowITSMTemplate, s *state, diags
 func NewResource(opts Options) resource.Resource {
 	return cc.New(cc.Spec[api_client.ServiceNowITSMTemplate]{
 		TypeNameSuffix:        opts.TypeNameSuffix,
+		ServiceName:           api_client.ServiceNowITSMServiceName,
 		UIName:                opts.UIName,
 		Description:           opts.Description,
 		SupportsBusinessUnits: false,
@@ -196,7 +198,7 @@ func NewResource(opts Options) resource.Resource {
 				Config:       cfg,
 			}
 		},
-		Extract: func(api *api_client.ServiceNowITSMTemplate, st cc.State, diags *diag.Diagnostics) cc.APIObject {
+		Extract: func(_ context.Context, api *api_client.ServiceNowITSMTemplate, st cc.State, diags *diag.Diagnostics) cc.APIObject {
 			s := st.(*state)
 			extractStateFromAPI(api, s, diags)
 			return cc.APIObject{
@@ -210,5 +212,6 @@ func NewResource(opts Options) resource.Resource {
 		Get:    opts.Get,
 		Update: opts.Update,
 		Delete: opts.Delete,
+		List:   opts.List,
 	})
 }
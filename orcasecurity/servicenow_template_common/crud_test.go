@@ -7,6 +7,7 @@ import (
 	"net/http/httptest"
 	"terraform-provider-orcasecurity/orcasecurity/api_client"
 	common "terraform-provider-orcasecurity/orcasecurity/integrations_common"
+	"terraform-provider-orcasecurity/orcasecurity/timeouts_common"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
@@ -94,6 +95,7 @@ func newModel() *state {
 	m.OnCloseAlertMappingJSON = jsontypes.NewNormalizedValue(`{"state":"closed"}`)
 	m.AllowReopenAndResolution = types.BoolValue(true)
 	m.AllowMapping = types.BoolValue(true)
+	m.Timeouts = timeouts_common.Null(context.Background())
 	return m
 }
 
@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	"terraform-provider-orcasecurity/orcasecurity/apierror_common"
+	"terraform-provider-orcasecurity/orcasecurity/identity_common"
+	"terraform-provider-orcasecurity/orcasecurity/timeouts_common"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -30,6 +32,7 @@ var (
 	_ resource.Resource                = &shiftLeftCveExceptionListResource{}
 	_ resource.ResourceWithConfigure   = &shiftLeftCveExceptionListResource{}
 	_ resource.ResourceWithImportState = &shiftLeftCveExceptionListResource{}
+	_ resource.ResourceWithIdentity    = &shiftLeftCveExceptionListResource{}
 )
 
 type shiftLeftCveExceptionListResource struct {
@@ -57,6 +60,8 @@ type ShiftLeftCveExceptionList struct {
 	Disabled        types.Bool      `tfsdk:"disabled"`
 	Vulnerabilities []Vulnerability `tfsdk:"vulnerabilities"`
 	Projects        []Project       `tfsdk:"projects"`
+
+	Timeouts timeouts_common.Value `tfsdk:"timeouts"`
 }
 
 func NewShiftLeftCveExceptionListResource() resource.Resource {
@@ -75,7 +80,11 @@ func (r *shiftLeftCveExceptionListResource) Configure(_ context.Context, req res
 }
 
 func (r *shiftLeftCveExceptionListResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	identity_common.ImportID(ctx, req, resp)
+}
+
+func (r *shiftLeftCveExceptionListResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity_common.IDSchema()
 }
 
 func (r *shiftLeftCveExceptionListResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -154,6 +163,9 @@ func (r *shiftLeftCveExceptionListResource) Schema(ctx context.Context, req reso
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts_common.Block(ctx),
+		},
 	}
 }
 
@@ -164,6 +176,9 @@ func (r *shiftLeftCveExceptionListResource) Create(ctx context.Context, req reso
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	ctx, cancel := timeouts_common.Create(ctx, plan.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
 	//var finalDiags diag.Diagnostics
 
 	var projects []api_client.Project
@@ -210,16 +225,14 @@ func (r *shiftLeftCveExceptionListResource) Create(ctx context.Context, req reso
 		Vulnerabilities: vulnerabilities,
 	}
 
-	instance, err := r.apiClient.CreateShiftLeftCveExceptionList(exceptionListToCreate)
+	instance, err := r.apiClient.CreateShiftLeftCveExceptionList(ctx, exceptionListToCreate)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating exception list",
-			"Could not create exception list, unexpected error: "+err.Error(),
-		)
+		apierror_common.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, "Error creating exception list", "Could not create exception list", err)
 		return
 	}
 
 	plan.ID = types.StringValue(instance.ID)
+	resp.Diagnostics.Append(identity_common.SetID(ctx, resp.Identity, plan.ID.ValueString())...)
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -228,6 +241,7 @@ func (r *shiftLeftCveExceptionListResource) Create(ctx context.Context, req reso
 }
 
 func (r *shiftLeftCveExceptionListResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(identity_common.SetIDFromState(ctx, resp.Identity, req.State)...)
 	var state ShiftLeftCveExceptionList
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -235,7 +249,10 @@ func (r *shiftLeftCveExceptionListResource) Read(ctx context.Context, req resour
 		return
 	}
 
-	exists, err := r.apiClient.DoesShiftLeftCveExceptionListExist(state.ID.ValueString())
+	ctx, cancel := timeouts_common.Read(ctx, state.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
+	exists, err := r.apiClient.DoesShiftLeftCveExceptionListExist(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading Shift Left CVE exception list",
@@ -249,7 +266,7 @@ func (r *shiftLeftCveExceptionListResource) Read(ctx context.Context, req resour
 		return
 	}
 
-	instance, err := r.apiClient.GetShiftLeftCveExceptionList(state.ID.ValueString())
+	instance, err := r.apiClient.GetShiftLeftCveExceptionList(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading Shift Left CVE exception list",
@@ -304,12 +321,16 @@ func (r *shiftLeftCveExceptionListResource) Read(ctx context.Context, req resour
 }
 
 func (r *shiftLeftCveExceptionListResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(identity_common.SetIDFromState(ctx, resp.Identity, req.State)...)
 	var plan ShiftLeftCveExceptionList
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	ctx, cancel := timeouts_common.Update(ctx, plan.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
 	//var finalDiags diag.Diagnostics
 
 	if plan.ID == types.StringValue("") {
@@ -354,12 +375,9 @@ func (r *shiftLeftCveExceptionListResource) Update(ctx context.Context, req reso
 		Vulnerabilities: vulnerabilities,
 	}
 
-	_, err := r.apiClient.UpdateShiftLeftCveExceptionList(updateReq.ID, updateReq)
+	_, err := r.apiClient.UpdateShiftLeftCveExceptionList(ctx, updateReq.ID, updateReq)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating Shift Left exception list",
-			"Could not update Shift Left exception list, unexpected error: "+err.Error(),
-		)
+		apierror_common.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, "Error updating Shift Left exception list", "Could not update Shift Left exception list", err)
 		return
 	}
 
@@ -387,7 +405,10 @@ func (r *shiftLeftCveExceptionListResource) Delete(ctx context.Context, req reso
 		return
 	}
 
-	err := r.apiClient.DeleteShiftLeftCveExceptionList(state.ID.ValueString())
+	ctx, cancel := timeouts_common.Delete(ctx, state.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
+	err := r.apiClient.DeleteShiftLeftCveExceptionList(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting exception list",
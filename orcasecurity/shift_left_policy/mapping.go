@@ -715,6 +715,7 @@ func mergeProjectsIdsFromPlan(state, plan *shiftLeftPolicyResourceModel) {
 }
 
 func mergeStateFromPlan(state, plan *shiftLeftPolicyResourceModel) {
+	state.Timeouts = plan.Timeouts
 	mergeProjectsIdsFromPlan(state, plan)
 
 	switch plan.Type.ValueString() {
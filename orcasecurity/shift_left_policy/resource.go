@@ -3,9 +3,12 @@ package shift_left_policy
 import (
 	"context"
 	"fmt"
-	"strings"
 	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	"terraform-provider-orcasecurity/orcasecurity/apierror_common"
+	"terraform-provider-orcasecurity/orcasecurity/identity_common"
+	"terraform-provider-orcasecurity/orcasecurity/timeouts_common"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -16,6 +19,7 @@ var (
 	_ resource.Resource                = &shiftLeftPolicyResource{}
 	_ resource.ResourceWithConfigure   = &shiftLeftPolicyResource{}
 	_ resource.ResourceWithImportState = &shiftLeftPolicyResource{}
+	_ resource.ResourceWithIdentity    = &shiftLeftPolicyResource{}
 )
 
 type shiftLeftPolicyResource struct {
@@ -37,22 +41,31 @@ func (r *shiftLeftPolicyResource) Configure(_ context.Context, req resource.Conf
 	r.apiClient = req.ProviderData.(*api_client.APIClient)
 }
 
+// IdentitySchema addresses a policy by the pair its API routes are keyed by, matching the
+// `<type>/<id>` import ID.
+func (r *shiftLeftPolicyResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity_common.Schema(
+		identity_common.Attribute{Name: "type", Description: "AppSec policy type."},
+		identity_common.Attribute{Name: identity_common.IDAttribute, Description: "AppSec policy ID."},
+	)
+}
+
 func (r *shiftLeftPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Provides an AppSec (Shift Left) policy resource. Use this resource to create and manage AppSec scan policies in Orca Security.",
 		Attributes:  resourceSchemaAttributes(),
-		Blocks:      resourceSchemaBlocks(),
+		Blocks:      resourceSchemaBlocks(ctx),
 	}
 }
 
 func (r *shiftLeftPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	policyType, policyID, err := parseImportID(req.ID)
+	policyType, policyID, err := importedPolicy(ctx, req, &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError("Invalid import ID", err.Error())
 		return
 	}
 
-	instance, err := r.apiClient.GetShiftLeftPolicy(policyType, policyID)
+	instance, err := r.apiClient.GetShiftLeftPolicy(ctx, policyType, policyID)
 	if err != nil {
 		resp.Diagnostics.AddError("Error importing AppSec policy", err.Error())
 		return
@@ -67,6 +80,15 @@ func (r *shiftLeftPolicyResource) ImportState(ctx context.Context, req resource.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("type"), policyType)...)
 }
 
+// importedPolicy returns the policy type and ID from the `<type>/<id>` import ID or, when
+// importing by identity, from the identity attributes.
+func importedPolicy(ctx context.Context, req resource.ImportStateRequest, diags *diag.Diagnostics) (string, string, error) {
+	if req.ID != "" || req.Identity == nil {
+		return parseImportID(req.ID)
+	}
+	return identity_common.Imported(ctx, req, "type", diags), identity_common.ImportedID(ctx, req, diags), nil
+}
+
 func (r *shiftLeftPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan shiftLeftPolicyResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -74,6 +96,9 @@ func (r *shiftLeftPolicyResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	ctx, cancel := timeouts_common.Create(ctx, plan.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
 	apiPolicy, diags := planToAPI(&plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -81,36 +106,41 @@ func (r *shiftLeftPolicyResource) Create(ctx context.Context, req resource.Creat
 	}
 
 	policyType := plan.Type.ValueString()
-	if err := r.apiClient.AddAllCatalogControls(policyType, &apiPolicy, allControlsScopeKeys(&plan)); err != nil {
+	if err := r.apiClient.AddAllCatalogControls(ctx, policyType, &apiPolicy, allControlsScopeKeys(&plan)); err != nil {
 		resp.Diagnostics.AddError("Error expanding catalog controls", err.Error())
 		return
 	}
-	if err := r.apiClient.EnrichShiftLeftPolicyFromCatalog(policyType, &apiPolicy); err != nil {
+	if err := r.apiClient.EnrichShiftLeftPolicyFromCatalog(ctx, policyType, &apiPolicy); err != nil {
 		resp.Diagnostics.AddError("Error enriching AppSec policy from catalog", err.Error())
 		return
 	}
 
-	instance, err := r.apiClient.CreateShiftLeftPolicy(policyType, apiPolicy)
+	instance, err := r.apiClient.CreateShiftLeftPolicy(ctx, policyType, apiPolicy)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating AppSec policy", "Could not create policy: "+err.Error())
+		apierror_common.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, "Error creating AppSec policy", "Could not create policy", err)
 		return
 	}
 
 	state := stateFromPlanAfterWrite(&plan, instance)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(identity_common.SetFromState(ctx, resp.Identity, resp.State, "type", "id")...)
 }
 
 func (r *shiftLeftPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(identity_common.SetFromState(ctx, resp.Identity, req.State, "type", "id")...)
 	var state shiftLeftPolicyResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := timeouts_common.Read(ctx, state.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
 	policyType := state.Type.ValueString()
 	policyID := state.ID.ValueString()
 
-	exists, err := r.apiClient.DoesShiftLeftPolicyExist(policyType, policyID)
+	exists, err := r.apiClient.DoesShiftLeftPolicyExist(ctx, policyType, policyID)
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading AppSec policy", fmt.Sprintf("Could not read policy %s/%s: %s", policyType, policyID, err.Error()))
 		return
@@ -121,7 +151,7 @@ func (r *shiftLeftPolicyResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
-	instance, err := r.apiClient.GetShiftLeftPolicy(policyType, policyID)
+	instance, err := r.apiClient.GetShiftLeftPolicy(ctx, policyType, policyID)
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading AppSec policy", "Could not read policy: "+err.Error())
 		return
@@ -132,12 +162,16 @@ func (r *shiftLeftPolicyResource) Read(ctx context.Context, req resource.ReadReq
 }
 
 func (r *shiftLeftPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(identity_common.SetFromState(ctx, resp.Identity, req.State, "type", "id")...)
 	var plan shiftLeftPolicyResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := timeouts_common.Update(ctx, plan.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
 	if plan.Builtin.ValueBool() {
 		resp.Diagnostics.AddError(
 			"Cannot update built-in policy",
@@ -154,22 +188,22 @@ func (r *shiftLeftPolicyResource) Update(ctx context.Context, req resource.Updat
 
 	policyType := plan.Type.ValueString()
 	policyID := plan.ID.ValueString()
-	if err := r.apiClient.AddAllCatalogControls(policyType, &apiPolicy, allControlsScopeKeys(&plan)); err != nil {
+	if err := r.apiClient.AddAllCatalogControls(ctx, policyType, &apiPolicy, allControlsScopeKeys(&plan)); err != nil {
 		resp.Diagnostics.AddError("Error expanding catalog controls", err.Error())
 		return
 	}
-	if err := r.apiClient.EnrichShiftLeftPolicyFromCatalog(policyType, &apiPolicy); err != nil {
+	if err := r.apiClient.EnrichShiftLeftPolicyFromCatalog(ctx, policyType, &apiPolicy); err != nil {
 		resp.Diagnostics.AddError("Error enriching AppSec policy from catalog", err.Error())
 		return
 	}
 
-	_, err := r.apiClient.UpdateShiftLeftPolicy(policyType, policyID, apiPolicy)
+	_, err := r.apiClient.UpdateShiftLeftPolicy(ctx, policyType, policyID, apiPolicy)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating AppSec policy", "Could not update policy: "+err.Error())
+		apierror_common.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, "Error updating AppSec policy", "Could not update policy", err)
 		return
 	}
 
-	instance, err := r.apiClient.GetShiftLeftPolicy(policyType, policyID)
+	instance, err := r.apiClient.GetShiftLeftPolicy(ctx, policyType, policyID)
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading AppSec policy after update", err.Error())
 		return
@@ -186,6 +220,9 @@ func (r *shiftLeftPolicyResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
+	ctx, cancel := timeouts_common.Delete(ctx, state.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
 	if state.Builtin.ValueBool() {
 		resp.Diagnostics.AddError(
 			"Cannot delete built-in policy",
@@ -194,9 +231,9 @@ func (r *shiftLeftPolicyResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
-	err := r.apiClient.DeleteShiftLeftPolicy(state.Type.ValueString(), state.ID.ValueString())
+	err := r.apiClient.DeleteShiftLeftPolicy(ctx, state.Type.ValueString(), state.ID.ValueString())
 	if err != nil {
-		if strings.Contains(err.Error(), "404") {
+		if api_client.IsNotFound(err) {
 			return
 		}
 		resp.Diagnostics.AddError("Error deleting AppSec policy", "Could not delete policy: "+err.Error())
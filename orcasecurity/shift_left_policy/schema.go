@@ -1,6 +1,8 @@
 package shift_left_policy
 
 import (
+	"context"
+
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -8,6 +10,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-orcasecurity/orcasecurity/timeouts_common"
 )
 
 // allControlsAttr is a section-level toggle that tells the provider to include
@@ -275,7 +279,7 @@ func resourceSchemaAttributes() map[string]schema.Attribute {
 	}
 }
 
-func resourceSchemaBlocks() map[string]schema.Block {
+func resourceSchemaBlocks(ctx context.Context) map[string]schema.Block {
 	return map[string]schema.Block{
 		"iac":                          iacBlock(),
 		"sast":                         sastBlock(),
@@ -286,5 +290,6 @@ func resourceSchemaBlocks() map[string]schema.Block {
 		"scm_posture":                  scmPostureBlock(),
 		"licenses":                     licensesBlock(),
 		"sca":                          licensesBlock(),
+		"timeouts":                     timeouts_common.Block(ctx),
 	}
 }
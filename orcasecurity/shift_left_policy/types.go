@@ -2,6 +2,8 @@ package shift_left_policy
 
 import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-orcasecurity/orcasecurity/timeouts_common"
 )
 
 var policyTypes = []string{
@@ -139,4 +141,5 @@ type shiftLeftPolicyResourceModel struct {
 	ScmPosture                *scmPostureBlockModel     `tfsdk:"scm_posture"`
 	Licenses                  *licensesBlockModel       `tfsdk:"licenses"`
 	Sca                       *licensesBlockModel       `tfsdk:"sca"`
+	Timeouts                  timeouts_common.Value     `tfsdk:"timeouts"`
 }
@@ -105,7 +105,7 @@ func (ds *catalogControlsDataSource) Read(ctx context.Context, req datasource.Re
 		return
 	}
 
-	catalog, err := ds.apiClient.GetShiftLeftPolicyCatalogControls(state.Type.ValueString())
+	catalog, err := ds.apiClient.GetShiftLeftPolicyCatalogControls(ctx, state.Type.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading catalog controls", err.Error())
 		return
@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	"terraform-provider-orcasecurity/orcasecurity/apierror_common"
+	"terraform-provider-orcasecurity/orcasecurity/identity_common"
+	"terraform-provider-orcasecurity/orcasecurity/timeouts_common"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -20,6 +22,7 @@ var (
 	_ resource.Resource                = &shiftLeftProjectResource{}
 	_ resource.ResourceWithConfigure   = &shiftLeftProjectResource{}
 	_ resource.ResourceWithImportState = &shiftLeftProjectResource{}
+	_ resource.ResourceWithIdentity    = &shiftLeftProjectResource{}
 )
 
 type shiftLeftProjectResource struct {
@@ -38,6 +41,8 @@ type shiftLeftProjectResourceModel struct {
 	GitDefaultBaselineBranch         types.String `tfsdk:"git_default_baseline_branch"`
 	PolicyIds                        []string     `tfsdk:"policies_ids"`
 	ExceptionIds                     []string     `tfsdk:"exceptions_ids"`
+
+	Timeouts timeouts_common.Value `tfsdk:"timeouts"`
 }
 
 func NewShiftLeftProjectResource() resource.Resource {
@@ -66,7 +71,11 @@ func (r *shiftLeftProjectResource) Configure(_ context.Context, req resource.Con
 }
 
 func (r *shiftLeftProjectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	identity_common.ImportID(ctx, req, resp)
+}
+
+func (r *shiftLeftProjectResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity_common.IDSchema()
 }
 
 func (r *shiftLeftProjectResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -134,6 +143,9 @@ func (r *shiftLeftProjectResource) Schema(ctx context.Context, req resource.Sche
 				Optional:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts_common.Block(ctx),
+		},
 	}
 }
 
@@ -145,6 +157,9 @@ func (r *shiftLeftProjectResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	ctx, cancel := timeouts_common.Create(ctx, plan.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
 	createReq := api_client.ShiftLeftProject{
 		Name:                             plan.Name.ValueString(),
 		Description:                      plan.Description.ValueString(),
@@ -158,16 +173,14 @@ func (r *shiftLeftProjectResource) Create(ctx context.Context, req resource.Crea
 		ExceptionIds:                     plan.ExceptionIds,
 	}
 
-	instance, err := r.apiClient.CreateShiftLeftProject(createReq)
+	instance, err := r.apiClient.CreateShiftLeftProject(ctx, createReq)
 
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating Shift Left project",
-			"Could not create project, unexpected error: "+err.Error(),
-		)
+		apierror_common.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, "Error creating Shift Left project", "Could not create project", err)
 		return
 	}
 	plan.ID = types.StringValue(instance.ID)
+	resp.Diagnostics.Append(identity_common.SetID(ctx, resp.Identity, plan.ID.ValueString())...)
 	// support_*_via_cli are Computed: the server assigns defaults when unset, so
 	// populate them from the create response to resolve the planned unknowns.
 	plan.SupportCodeComments = optionalString(instance.SupportCodeComments)
@@ -182,6 +195,7 @@ func (r *shiftLeftProjectResource) Create(ctx context.Context, req resource.Crea
 }
 
 func (r *shiftLeftProjectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(identity_common.SetIDFromState(ctx, resp.Identity, req.State)...)
 	var state shiftLeftProjectResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -189,7 +203,10 @@ func (r *shiftLeftProjectResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
-	exists, err := r.apiClient.DoesShiftLeftProjectExist(state.ID.ValueString())
+	ctx, cancel := timeouts_common.Read(ctx, state.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
+	exists, err := r.apiClient.DoesShiftLeftProjectExist(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading Shift Left project",
@@ -203,7 +220,7 @@ func (r *shiftLeftProjectResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
-	instance, err := r.apiClient.GetShiftLeftProject(state.ID.ValueString())
+	instance, err := r.apiClient.GetShiftLeftProject(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading project",
@@ -242,6 +259,7 @@ func attachedPolicyIDs(current *api_client.ShiftLeftProject) []string {
 }
 
 func (r *shiftLeftProjectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(identity_common.SetIDFromState(ctx, resp.Identity, req.State)...)
 	var plan shiftLeftProjectResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -249,6 +267,9 @@ func (r *shiftLeftProjectResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	ctx, cancel := timeouts_common.Update(ctx, plan.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
 	if plan.ID.ValueString() == "" {
 		resp.Diagnostics.AddError(
 			"ID is null",
@@ -263,7 +284,7 @@ func (r *shiftLeftProjectResource) Update(ctx context.Context, req resource.Upda
 	// preserve whatever is currently attached by echoing back its policy ids.
 	policyIds := plan.PolicyIds
 	if len(policyIds) == 0 {
-		current, err := r.apiClient.GetShiftLeftProject(plan.ID.ValueString())
+		current, err := r.apiClient.GetShiftLeftProject(ctx, plan.ID.ValueString())
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error reading project",
@@ -288,12 +309,9 @@ func (r *shiftLeftProjectResource) Update(ctx context.Context, req resource.Upda
 		ExceptionIds:                     plan.ExceptionIds,
 	}
 
-	_, err := r.apiClient.UpdateShiftLeftProject(updateReq.ID, updateReq)
+	_, err := r.apiClient.UpdateShiftLeftProject(ctx, updateReq.ID, updateReq)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating project",
-			"Could not update project, unexpected error: "+err.Error(),
-		)
+		apierror_common.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, "Error updating project", "Could not update project", err)
 		return
 	}
 
@@ -312,7 +330,10 @@ func (r *shiftLeftProjectResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
-	err := r.apiClient.DeleteShiftLeftProject(state.ID.ValueString())
+	ctx, cancel := timeouts_common.Delete(ctx, state.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
+	err := r.apiClient.DeleteShiftLeftProject(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting Shift Left project",
@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -135,6 +136,7 @@ func encodeMapping(ctx context.Context, s *state, cfg *api_client.SlackConfig, d
 func NewSlackResource() resource.Resource {
 	return cc.New(cc.Spec[api_client.SlackTemplate]{
 		TypeNameSuffix:        "_integration_slack_template",
+		ServiceName:           api_client.SlackServiceName,
 		UIName:                "Slack template",
 		Description:           "Manage a Slack integration in Orca. Creates an external service config of `service_name = \"slack\"` that posts Orca alerts to the given Slack workspace/channels, with a field mapping controlling which alert fields render in the message title and description.",
 		SupportsBusinessUnits: true,
@@ -158,12 +160,12 @@ func NewSlackResource() resource.Resource {
 				BusinessUnits: common.BusinessUnitsToAPI(ctx, s.BusinessUnits, diags),
 			}
 		},
-		Extract: func(o *api_client.SlackTemplate, st cc.State, diags *diag.Diagnostics) cc.APIObject {
+		Extract: func(ctx context.Context, o *api_client.SlackTemplate, st cc.State, diags *diag.Diagnostics) cc.APIObject {
 			s := st.(*state)
 			if o.Config.WorkspaceID != "" {
 				s.WorkspaceID = types.StringValue(o.Config.WorkspaceID)
 			}
-			channels, d := common.OptionalListMatchPlan(context.Background(), s.Channels, o.Config.Channels)
+			channels, d := common.OptionalListMatchPlan(ctx, s.Channels, o.Config.Channels)
 			diags.Append(d...)
 			s.Channels = channels
 			// Orca omits show_actions from stored config in some cases (e.g. UI-created
@@ -173,7 +175,7 @@ func NewSlackResource() resource.Resource {
 			} else {
 				s.ShowActions = types.BoolValue(true)
 			}
-			encodeMapping(context.Background(), s, &o.Config, diags)
+			encodeMapping(ctx, s, &o.Config, diags)
 			return cc.APIObject{
 				ID:            o.ID,
 				TemplateName:  o.TemplateName,
@@ -186,5 +188,11 @@ func NewSlackResource() resource.Resource {
 		Get:    (*api_client.APIClient).GetSlackTemplate,
 		Update: (*api_client.APIClient).UpdateSlackTemplate,
 		Delete: (*api_client.APIClient).DeleteSlackTemplate,
+		List:   (*api_client.APIClient).ListSlackTemplates,
 	})
 }
+
+// NewSlackListResource lists the integrations managed by NewSlackResource.
+func NewSlackListResource() list.ListResource {
+	return cc.NewList(NewSlackResource)
+}
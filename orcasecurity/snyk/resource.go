@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -17,8 +18,10 @@ var snykRegions = []string{"US", "EU", "AU", "US2"}
 
 type state struct {
 	cc.CommonFields
-	APIToken types.String `tfsdk:"api_token"`
-	Region   types.String `tfsdk:"region"`
+	APIToken          types.String `tfsdk:"api_token"`
+	APITokenWO        types.String `tfsdk:"api_token_wo"`
+	APITokenWOVersion types.Int64  `tfsdk:"api_token_wo_version"`
+	Region            types.String `tfsdk:"region"`
 }
 
 // buildPayload converts the planned state into the Snyk API payload.
@@ -33,7 +36,7 @@ func buildPayload(_ context.Context, st cc.State, _ *diag.Diagnostics) api_clien
 }
 
 // extract maps the API envelope back onto state; an empty region never clobbers the plan.
-func extract(o *api_client.SnykExternalServiceConfig, st cc.State, _ *diag.Diagnostics) cc.APIObject {
+func extract(_ context.Context, o *api_client.SnykExternalServiceConfig, st cc.State, _ *diag.Diagnostics) cc.APIObject {
 	if o.Config.Region != "" {
 		st.(*state).Region = types.StringValue(o.Config.Region)
 	}
@@ -43,6 +46,7 @@ func extract(o *api_client.SnykExternalServiceConfig, st cc.State, _ *diag.Diagn
 func NewSnykResource() resource.Resource {
 	return cc.New(cc.Spec[api_client.SnykExternalServiceConfig]{
 		TypeNameSuffix: "_integration_snyk",
+		ServiceName:    api_client.SnykServiceName,
 		UIName:         "Snyk integration",
 		Description:    "Manage a Snyk integration in Orca. Creates an external service config of `service_name = \"snyk\"`. The Snyk API token is stored in Orca's secret store and is never returned by the API.",
 		VariantAttributes: map[string]schema.Attribute{
@@ -62,12 +66,19 @@ func NewSnykResource() resource.Resource {
 				Validators: []validator.String{stringvalidator.OneOf(snykRegions...)},
 			},
 		},
-		NewState:     func() cc.State { return &state{} },
-		BuildPayload: buildPayload,
-		Extract:      extract,
-		Create:       (*api_client.APIClient).CreateSnykConfig,
-		Get:          (*api_client.APIClient).GetSnykConfig,
-		Update:       (*api_client.APIClient).UpdateSnykConfig,
-		Delete:       (*api_client.APIClient).DeleteSnykConfig,
+		WriteOnlySecrets: []string{"api_token"},
+		NewState:         func() cc.State { return &state{} },
+		BuildPayload:     buildPayload,
+		Extract:          extract,
+		Create:           (*api_client.APIClient).CreateSnykConfig,
+		Get:              (*api_client.APIClient).GetSnykConfig,
+		Update:           (*api_client.APIClient).UpdateSnykConfig,
+		Delete:           (*api_client.APIClient).DeleteSnykConfig,
+		List:             (*api_client.APIClient).ListSnykConfigs,
 	})
 }
+
+// NewSnykListResource lists the integrations managed by NewSnykResource.
+func NewSnykListResource() list.ListResource {
+	return cc.NewList(NewSnykResource)
+}
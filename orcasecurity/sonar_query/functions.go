@@ -0,0 +1,185 @@
+package sonar_query
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ function.Function = &queryFunction{}
+	_ function.Function = &combineFunction{}
+	_ function.Function = &normalizeFunction{}
+)
+
+const conditionDescription = "A condition as an HCL object (e.g. `{ key = \"OrcaScore\", operator = \"range\", type = \"float\", values = [7, 10] }`) " +
+	"or as a JSON string, such as the result of `sonar_and` or `sonar_or`."
+
+func conditionsParameter() function.DynamicParameter {
+	return function.DynamicParameter{
+		Name:                "conditions",
+		MarkdownDescription: conditionDescription,
+	}
+}
+
+// conditionsFromTuple converts the variadic conditions argument, reporting failures against the
+// argument position (first is the position of the first variadic argument).
+func conditionsFromTuple(ctx context.Context, conditions types.Tuple, first int64) ([]map[string]interface{}, *function.FuncError) {
+	out := make([]map[string]interface{}, 0, len(conditions.Elements()))
+	for i, v := range conditions.Elements() {
+		c, err := conditionFromValue(ctx, v)
+		if err != nil {
+			return nil, function.NewArgumentFuncError(first+int64(i), err.Error())
+		}
+		out = append(out, c)
+	}
+	return out, nil
+}
+
+// setResult stores a canonical JSON value as the function result.
+func setResult(ctx context.Context, resp *function.RunResponse, value interface{}) {
+	result, err := stringValue(value)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+type queryFunction struct{}
+
+// NewSonarQueryFunction returns provider::orcasecurity::sonar_query.
+func NewSonarQueryFunction() function.Function {
+	return &queryFunction{}
+}
+
+func (f *queryFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "sonar_query"
+}
+
+func (f *queryFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Build a Sonar query",
+		MarkdownDescription: "Returns the canonical JSON of an `object_set` Sonar query over `models`. Any conditions are combined " +
+			"with `and` into the query's `with` clause. The result is byte-for-byte what `jsonencode` produces for the same query.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "models",
+				ElementType:         types.StringType,
+				MarkdownDescription: "Sonar models to query, e.g. `[\"Alert\"]`.",
+			},
+		},
+		VariadicParameter: conditionsParameter(),
+		Return:            function.StringReturn{},
+	}
+}
+
+func (f *queryFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var models []string
+	var conditions types.Tuple
+	resp.Error = req.Arguments.Get(ctx, &models, &conditions)
+	if resp.Error != nil {
+		return
+	}
+	parsed, funcErr := conditionsFromTuple(ctx, conditions, 1)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+	query, err := Query(models, parsed)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	setResult(ctx, resp, query)
+}
+
+// combineFunction implements sonar_and and sonar_or.
+type combineFunction struct {
+	operator string
+}
+
+// NewSonarAndFunction returns provider::orcasecurity::sonar_and.
+func NewSonarAndFunction() function.Function {
+	return &combineFunction{operator: "and"}
+}
+
+// NewSonarOrFunction returns provider::orcasecurity::sonar_or.
+func NewSonarOrFunction() function.Function {
+	return &combineFunction{operator: "or"}
+}
+
+func (f *combineFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "sonar_" + f.operator
+}
+
+func (f *combineFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Combine Sonar conditions with `" + f.operator + "`",
+		MarkdownDescription: "Returns the canonical JSON of a Sonar `operation` that joins the conditions with `" + f.operator + "`. " +
+			"Nested `" + f.operator + "` operations are flattened and a single condition is returned unchanged, so equivalent " +
+			"compositions produce identical JSON.",
+		VariadicParameter: conditionsParameter(),
+		Return:            function.StringReturn{},
+	}
+}
+
+func (f *combineFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var conditions types.Tuple
+	resp.Error = req.Arguments.Get(ctx, &conditions)
+	if resp.Error != nil {
+		return
+	}
+	parsed, funcErr := conditionsFromTuple(ctx, conditions, 0)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+	combined, err := Combine(f.operator, parsed)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+	setResult(ctx, resp, combined)
+}
+
+type normalizeFunction struct{}
+
+// NewSonarNormalizeFunction returns provider::orcasecurity::sonar_normalize.
+func NewSonarNormalizeFunction() function.Function {
+	return &normalizeFunction{}
+}
+
+func (f *normalizeFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "sonar_normalize"
+}
+
+func (f *normalizeFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Normalize Sonar query JSON",
+		MarkdownDescription: "Re-encodes a JSON string (for example a query copied from the Orca UI or read from a file) as compact " +
+			"JSON with sorted keys, matching `jsonencode`. Numbers keep their original precision.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "json",
+				MarkdownDescription: "JSON-encoded Sonar query or condition.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *normalizeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var raw string
+	resp.Error = req.Arguments.Get(ctx, &raw)
+	if resp.Error != nil {
+		return
+	}
+	normalized, err := Normalize(raw)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, normalized))
+}
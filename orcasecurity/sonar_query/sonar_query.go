@@ -0,0 +1,197 @@
+// Package sonar_query implements the provider functions that build and normalize Sonar query
+// JSON (automation_v2 filter.sonar_query, scheduled_report sonar_query, discovery_view
+// filter_data.query, widget request_params.query, ...).
+//
+// Every function returns compact JSON with object keys sorted, which is the form HCL
+// jsonencode produces, so switching an existing jsonencode(...) query to these functions (or
+// back) does not change the stored string.
+package sonar_query
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+const (
+	operationType = "operation"
+	objectSetType = "object_set"
+)
+
+// Normalize re-encodes a JSON document in canonical form. Numbers keep their original text so
+// large IDs and decimals are not rounded through float64.
+func Normalize(raw string) (string, error) {
+	value, err := decode(raw)
+	if err != nil {
+		return "", err
+	}
+	return encode(value)
+}
+
+// Combine joins conditions with operator ("and" / "or"). Operands that are themselves operations
+// with the same operator are flattened into the result, and a single condition is returned as is,
+// so equivalent compositions always produce the same JSON.
+func Combine(operator string, conditions []map[string]interface{}) (map[string]interface{}, error) {
+	if len(conditions) == 0 {
+		return nil, fmt.Errorf("sonar_%s requires at least one condition", operator)
+	}
+	values := make([]interface{}, 0, len(conditions))
+	for _, c := range conditions {
+		if c["type"] == operationType && c["operator"] == operator {
+			if nested, ok := c["values"].([]interface{}); ok {
+				values = append(values, nested...)
+				continue
+			}
+		}
+		values = append(values, c)
+	}
+	if len(values) == 1 {
+		if single, ok := values[0].(map[string]interface{}); ok {
+			return single, nil
+		}
+	}
+	return map[string]interface{}{"type": operationType, "operator": operator, "values": values}, nil
+}
+
+// Query builds an object_set query over models, and-ing any conditions into its "with" clause.
+func Query(models []string, conditions []map[string]interface{}) (map[string]interface{}, error) {
+	if len(models) == 0 {
+		return nil, errors.New("sonar_query requires at least one model")
+	}
+	query := map[string]interface{}{"models": models, "type": objectSetType}
+	if len(conditions) > 0 {
+		with, err := Combine("and", conditions)
+		if err != nil {
+			return nil, err
+		}
+		query["with"] = with
+	}
+	return query, nil
+}
+
+// conditionFromValue accepts a condition either as an HCL object or as a JSON string (such as
+// the result of another sonar_* function) and returns it as a JSON object.
+func conditionFromValue(ctx context.Context, v attr.Value) (map[string]interface{}, error) {
+	if d, ok := v.(basetypes.DynamicValue); ok {
+		v = d.UnderlyingValue()
+	}
+	var value interface{}
+	if s, ok := v.(basetypes.StringValue); ok {
+		decoded, err := decode(s.ValueString())
+		if err != nil {
+			return nil, err
+		}
+		value = decoded
+	} else {
+		converted, err := jsonFromValue(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		value = converted
+	}
+	condition, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("condition must be an object or a JSON-encoded object")
+	}
+	return condition, nil
+}
+
+// jsonFromValue converts a Terraform value into the value encoding/json would produce for the
+// equivalent jsonencode input. Null object attributes are dropped: they typically come from
+// optional attributes of typed variables and carry no meaning in a Sonar query.
+func jsonFromValue(ctx context.Context, v attr.Value) (interface{}, error) {
+	if v == nil || v.IsNull() {
+		return nil, nil
+	}
+	if v.IsUnknown() {
+		return nil, errors.New("value is not yet known")
+	}
+	switch value := v.(type) {
+	case basetypes.DynamicValue:
+		return jsonFromValue(ctx, value.UnderlyingValue())
+	case basetypes.StringValue:
+		return value.ValueString(), nil
+	case basetypes.BoolValue:
+		return value.ValueBool(), nil
+	case basetypes.NumberValue:
+		return json.Number(value.ValueBigFloat().Text('f', -1)), nil
+	case basetypes.Int64Value:
+		return value.ValueInt64(), nil
+	case basetypes.Float64Value:
+		return value.ValueFloat64(), nil
+	case basetypes.ListValue:
+		return jsonFromElements(ctx, value.Elements())
+	case basetypes.SetValue:
+		return jsonFromElements(ctx, value.Elements())
+	case basetypes.TupleValue:
+		return jsonFromElements(ctx, value.Elements())
+	case basetypes.ObjectValue:
+		return jsonFromAttributes(ctx, value.Attributes())
+	case basetypes.MapValue:
+		return jsonFromAttributes(ctx, value.Elements())
+	}
+	return nil, fmt.Errorf("unsupported value type %s", v.Type(ctx))
+}
+
+func jsonFromElements(ctx context.Context, elements []attr.Value) (interface{}, error) {
+	out := make([]interface{}, 0, len(elements))
+	for _, e := range elements {
+		converted, err := jsonFromValue(ctx, e)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, converted)
+	}
+	return out, nil
+}
+
+func jsonFromAttributes(ctx context.Context, attributes map[string]attr.Value) (interface{}, error) {
+	out := make(map[string]interface{}, len(attributes))
+	for k, a := range attributes {
+		if a == nil || a.IsNull() {
+			continue
+		}
+		converted, err := jsonFromValue(ctx, a)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", k, err)
+		}
+		out[k] = converted
+	}
+	return out, nil
+}
+
+func decode(raw string) (interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader([]byte(raw)))
+	dec.UseNumber()
+	var value interface{}
+	if err := dec.Decode(&value); err != nil {
+		return nil, fmt.Errorf("invalid JSON: %w", err)
+	}
+	if dec.More() {
+		return nil, errors.New("invalid JSON: unexpected data after the top-level value")
+	}
+	return value, nil
+}
+
+func encode(value interface{}) (string, error) {
+	out, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+// stringValue wraps a canonical JSON result for the function response.
+func stringValue(value interface{}) (types.String, error) {
+	out, err := encode(value)
+	if err != nil {
+		return types.StringNull(), err
+	}
+	return types.StringValue(out), nil
+}
@@ -0,0 +1,178 @@
+package sonar_query
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// run invokes f with args and returns the string result or the function error text.
+func run(t *testing.T, f function.Function, args ...attr.Value) (string, string) {
+	t.Helper()
+	resp := &function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+	f.Run(context.Background(), function.RunRequest{Arguments: function.NewArgumentsData(args)}, resp)
+	if resp.Error != nil {
+		return "", resp.Error.Error()
+	}
+	return resp.Result.Value().(types.String).ValueString(), ""
+}
+
+func dynamic(v attr.Value) types.Dynamic { return types.DynamicValue(v) }
+
+func tuple(t *testing.T, values ...attr.Value) types.Tuple {
+	t.Helper()
+	elemTypes := make([]attr.Type, len(values))
+	for i := range values {
+		elemTypes[i] = types.DynamicType
+	}
+	out, diags := types.TupleValue(elemTypes, values)
+	if diags.HasError() {
+		t.Fatalf("tuple: %v", diags)
+	}
+	return out
+}
+
+func object(t *testing.T, attrs map[string]attr.Value) types.Object {
+	t.Helper()
+	attrTypes := make(map[string]attr.Type, len(attrs))
+	for k, v := range attrs {
+		attrTypes[k] = v.Type(context.Background())
+	}
+	out, diags := types.ObjectValue(attrTypes, attrs)
+	if diags.HasError() {
+		t.Fatalf("object: %v", diags)
+	}
+	return out
+}
+
+func scoreCondition(t *testing.T) types.Dynamic {
+	values, _ := types.TupleValue(
+		[]attr.Type{types.NumberType, types.NumberType},
+		[]attr.Value{types.NumberValue(bigFloat(7)), types.NumberValue(bigFloat(10))},
+	)
+	return dynamic(object(t, map[string]attr.Value{
+		"type":     types.StringValue("float"),
+		"operator": types.StringValue("range"),
+		"key":      types.StringValue("OrcaScore"),
+		"values":   values,
+		"unused":   types.StringNull(),
+	}))
+}
+
+// Normalize sorts keys, strips whitespace and keeps number text intact.
+func TestNormalize(t *testing.T) {
+	got, err := Normalize(` { "type": "object_set", "models": ["Alert"], "limit": 12345678901234567890, "ratio": 0.10 } `)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"limit":12345678901234567890,"models":["Alert"],"ratio":0.10,"type":"object_set"}`
+	if got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+	for _, bad := range []string{``, `{"a":`, `{} {}`} {
+		if _, err := Normalize(bad); err == nil {
+			t.Errorf("expected error for %q", bad)
+		}
+	}
+}
+
+// Combining flattens same-operator operations and unwraps a single condition.
+func TestCombine(t *testing.T) {
+	a := map[string]interface{}{"key": "A"}
+	b := map[string]interface{}{"key": "B"}
+	c := map[string]interface{}{"key": "C"}
+
+	single, err := Combine("and", []map[string]interface{}{a})
+	if err != nil || single["key"] != "A" {
+		t.Errorf("single condition must be returned unchanged, got %v (%v)", single, err)
+	}
+
+	inner, _ := Combine("and", []map[string]interface{}{a, b})
+	flat, _ := Combine("and", []map[string]interface{}{inner, c})
+	if got := len(flat["values"].([]interface{})); got != 3 {
+		t.Errorf("nested and must flatten to 3 values, got %d", got)
+	}
+
+	or, _ := Combine("or", []map[string]interface{}{inner, c})
+	if got := len(or["values"].([]interface{})); got != 2 {
+		t.Errorf("and nested in or must not flatten, got %d values", got)
+	}
+
+	if _, err := Combine("or", nil); err == nil {
+		t.Error("expected an error without conditions")
+	}
+}
+
+// sonar_query output must equal jsonencode of the same HCL object, i.e. compact and key-sorted,
+// and accept both object and JSON-string conditions.
+func TestSonarQueryFunction(t *testing.T) {
+	models := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("Alert")})
+
+	got, errText := run(t, NewSonarQueryFunction(), models, tuple(t))
+	if errText != "" || got != `{"models":["Alert"],"type":"object_set"}` {
+		t.Errorf("no conditions: got %s %s", got, errText)
+	}
+
+	got, errText = run(t, NewSonarQueryFunction(), models, tuple(t, scoreCondition(t)))
+	want := `{"models":["Alert"],"type":"object_set","with":{"key":"OrcaScore","operator":"range","type":"float","values":[7,10]}}`
+	if errText != "" || got != want {
+		t.Errorf("one condition: got %s %s, want %s", got, errText, want)
+	}
+
+	providerCond := dynamic(types.StringValue(`{"type":"str","operator":"in","key":"CloudProvider","values":["aws"]}`))
+	got, errText = run(t, NewSonarQueryFunction(), models, tuple(t, scoreCondition(t), providerCond))
+	want = `{"models":["Alert"],"type":"object_set","with":{"operator":"and","type":"operation","values":[` +
+		`{"key":"OrcaScore","operator":"range","type":"float","values":[7,10]},` +
+		`{"key":"CloudProvider","operator":"in","type":"str","values":["aws"]}]}}`
+	if errText != "" || got != want {
+		t.Errorf("two conditions: got %s %s, want %s", got, errText, want)
+	}
+
+	empty := types.ListValueMust(types.StringType, []attr.Value{})
+	if _, errText := run(t, NewSonarQueryFunction(), empty, tuple(t)); errText == "" {
+		t.Error("expected an error without models")
+	}
+	if _, errText := run(t, NewSonarQueryFunction(), models, tuple(t, dynamic(types.StringValue(`[1]`)))); errText == "" {
+		t.Error("expected an error for a non-object condition")
+	}
+}
+
+// sonar_and / sonar_or results compose: an or nested in an and is kept, an and nested in an and
+// is flattened.
+func TestCombineFunctions(t *testing.T) {
+	a := dynamic(types.StringValue(`{"key":"A"}`))
+	b := dynamic(types.StringValue(`{"key":"B"}`))
+	c := dynamic(types.StringValue(`{"key":"C"}`))
+
+	or, errText := run(t, NewSonarOrFunction(), tuple(t, a, b))
+	if errText != "" || or != `{"operator":"or","type":"operation","values":[{"key":"A"},{"key":"B"}]}` {
+		t.Fatalf("sonar_or: got %s %s", or, errText)
+	}
+	and, errText := run(t, NewSonarAndFunction(), tuple(t, dynamic(types.StringValue(or)), c))
+	if errText != "" || and != `{"operator":"and","type":"operation","values":[`+or+`,{"key":"C"}]}` {
+		t.Errorf("sonar_and over or: got %s %s", and, errText)
+	}
+	flat, _ := run(t, NewSonarAndFunction(), tuple(t, dynamic(types.StringValue(and)), a))
+	if flat != `{"operator":"and","type":"operation","values":[`+or+`,{"key":"C"},{"key":"A"}]}` {
+		t.Errorf("nested sonar_and must flatten, got %s", flat)
+	}
+	if _, errText := run(t, NewSonarAndFunction(), tuple(t)); errText == "" {
+		t.Error("expected an error without conditions")
+	}
+}
+
+func TestSonarNormalizeFunction(t *testing.T) {
+	got, errText := run(t, NewSonarNormalizeFunction(), types.StringValue("{\n  \"type\": \"object_set\",\n  \"models\": [\"Alert\"]\n}"))
+	if errText != "" || got != `{"models":["Alert"],"type":"object_set"}` {
+		t.Errorf("got %s %s", got, errText)
+	}
+	if _, errText := run(t, NewSonarNormalizeFunction(), types.StringValue("not json")); errText == "" {
+		t.Error("expected an error for invalid JSON")
+	}
+}
+
+func bigFloat(v int64) *big.Float { return new(big.Float).SetInt64(v) }
@@ -0,0 +1,84 @@
+package sonar_query
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"terraform-provider-orcasecurity/orcasecurity/api_client"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// ValidatePlanned checks a planned query with the Orca query parser and reports what it finds as
+// errors on attr, so a malformed query fails the plan instead of an apply halfway through. dsl
+// selects the Sonar DSL form (a custom alert `rule`); otherwise planned holds JSON.
+//
+// Nothing is checked when the provider is not configured yet, validation is switched off with
+// skip_sonar_query_validation, the value is unknown, or it matches prior (so refresh-only plans
+// make no extra calls). A backend without the validation endpoint, or a failed call, never
+// blocks the plan: the query is then checked at apply as before.
+func ValidatePlanned(ctx context.Context, client *api_client.APIClient, attr path.Path, planned, prior types.String, dsl bool, diags *diag.Diagnostics) {
+	if client == nil || client.SkipSonarQueryValidation {
+		return
+	}
+	if planned.IsNull() || planned.IsUnknown() || planned.Equal(prior) {
+		return
+	}
+
+	var query any = planned.ValueString()
+	if !dsl {
+		decoded, err := decode(planned.ValueString())
+		if err != nil {
+			diags.AddAttributeError(attr, "Invalid Sonar query", err.Error())
+			return
+		}
+		query = decoded
+	}
+
+	issues, err := client.ValidateSonarQuery(ctx, query)
+	if errors.Is(err, api_client.ErrSonarValidationUnavailable) {
+		tflog.Debug(ctx, "Skipping plan-time Sonar query validation", map[string]interface{}{"reason": err.Error()})
+		return
+	}
+	if err != nil {
+		diags.AddAttributeWarning(attr, "Unable to validate Sonar query",
+			"The query could not be checked during plan and will be validated at apply instead: "+err.Error())
+		return
+	}
+	for _, issue := range issues {
+		diags.AddAttributeError(attr, issueSummary(issue), issueDetail(issue))
+	}
+}
+
+func issueSummary(issue api_client.SonarQueryIssue) string {
+	switch issue.Kind {
+	case api_client.SonarIssueSyntax:
+		return "Sonar query syntax error"
+	case api_client.SonarIssueUnknownModel:
+		return "Unknown Sonar model"
+	case api_client.SonarIssueUnknownField:
+		return "Unknown Sonar field"
+	}
+	return "Invalid Sonar query"
+}
+
+func issueDetail(issue api_client.SonarQueryIssue) string {
+	detail := issue.Message
+	switch {
+	case issue.Kind == api_client.SonarIssueUnknownModel && issue.Model != "":
+		detail = fmt.Sprintf("Model %q: %s", issue.Model, detail)
+	case issue.Kind == api_client.SonarIssueUnknownField && issue.Field != "":
+		if issue.Model != "" {
+			detail = fmt.Sprintf("Field %q of model %q: %s", issue.Field, issue.Model, detail)
+		} else {
+			detail = fmt.Sprintf("Field %q: %s", issue.Field, detail)
+		}
+	case issue.Position != nil:
+		detail = fmt.Sprintf("At character %d: %s", *issue.Position, detail)
+	}
+	return detail
+}
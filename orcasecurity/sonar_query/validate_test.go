@@ -0,0 +1,163 @@
+package sonar_query
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	"terraform-provider-orcasecurity/orcasecurity/internal/testutils"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func validationStub(t *testing.T, status int, body string, sent *any) testutils.RoundTripFunc {
+	return func(req *http.Request) *http.Response {
+		if req.Method != http.MethodPost || req.URL.Path != "/api/sonar/query/validate" {
+			t.Errorf("unexpected request %s %s", req.Method, req.URL)
+		}
+		var payload struct {
+			Query any `json:"query"`
+		}
+		if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+			t.Errorf("decoding request: %v", err)
+		}
+		*sent = payload.Query
+		return &http.Response{
+			StatusCode: status,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(body)),
+		}
+	}
+}
+
+func TestValidatePlanned_ReportsIssues(t *testing.T) {
+	var sent any
+	client := testutils.NewStubAPIClient(validationStub(t, http.StatusOK, `{"status":"success","data":{"valid":false,"errors":[
+		{"type":"syntax","message":"unexpected token 'wth'","position":7},
+		{"type":"unknown_model","message":"no such model","model":"Buckett"},
+		{"type":"unknown_field","message":"no such field","model":"Bucket","field":"Publik"}
+	]}}`, &sent))
+	attr := path.Root("rule")
+	var diags diag.Diagnostics
+	ValidatePlanned(context.Background(), client, attr, types.StringValue("Buckett wth Publik = true"), types.StringNull(), true, &diags)
+
+	if sent != "Buckett wth Publik = true" {
+		t.Errorf("sent query %#v, want the DSL string", sent)
+	}
+	if diags.ErrorsCount() != 3 {
+		t.Fatalf("got %d errors, want 3: %v", diags.ErrorsCount(), diags)
+	}
+	want := []struct{ summary, detail string }{
+		{"Sonar query syntax error", "At character 7: unexpected token 'wth'"},
+		{"Unknown Sonar model", `Model "Buckett": no such model`},
+		{"Unknown Sonar field", `Field "Publik" of model "Bucket": no such field`},
+	}
+	for i, d := range diags {
+		if d.Summary() != want[i].summary || d.Detail() != want[i].detail {
+			t.Errorf("diagnostic %d = %q / %q, want %q / %q", i, d.Summary(), d.Detail(), want[i].summary, want[i].detail)
+		}
+		withPath, ok := d.(diag.DiagnosticWithPath)
+		if !ok || !withPath.Path().Equal(attr) {
+			t.Errorf("diagnostic %d is not on %s", i, attr)
+		}
+	}
+}
+
+// A rejection without details must still fail the plan.
+func TestValidatePlanned_InvalidWithoutErrors(t *testing.T) {
+	var sent any
+	client := testutils.NewStubAPIClient(validationStub(t, http.StatusOK, `{"status":"success","data":{"valid":false,"errors":[]}}`, &sent))
+	var diags diag.Diagnostics
+	ValidatePlanned(context.Background(), client, path.Root("rule"), types.StringValue("Bucket with"), types.StringNull(), true, &diags)
+
+	if diags.ErrorsCount() != 1 {
+		t.Fatalf("got %d errors, want 1: %v", diags.ErrorsCount(), diags)
+	}
+	if d := diags[0]; d.Summary() != "Invalid Sonar query" || d.Detail() != "query is invalid" {
+		t.Errorf("diagnostic = %q / %q", d.Summary(), d.Detail())
+	}
+}
+
+func TestValidatePlanned_SendsJSONQueriesDecoded(t *testing.T) {
+	var sent any
+	client := testutils.NewStubAPIClient(validationStub(t, http.StatusOK, `{"status":"success","data":{"valid":true}}`, &sent))
+	var diags diag.Diagnostics
+	ValidatePlanned(context.Background(), client, path.Root("filter").AtName("sonar_query"),
+		types.StringValue(`{"models":["Alert"],"type":"object_set"}`), types.StringNull(), false, &diags)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if q, ok := sent.(map[string]any); !ok || q["type"] != "object_set" {
+		t.Errorf("sent query %#v, want a JSON object", sent)
+	}
+}
+
+func TestValidatePlanned_InvalidJSONFailsWithoutCall(t *testing.T) {
+	client := testutils.NewStubAPIClient(func(req *http.Request) *http.Response {
+		t.Errorf("unexpected request %s", req.URL)
+		return nil
+	})
+	var diags diag.Diagnostics
+	ValidatePlanned(context.Background(), client, path.Root("q"), types.StringValue(`{"models":`), types.StringNull(), false, &diags)
+	if diags.ErrorsCount() != 1 || diags[0].Summary() != "Invalid Sonar query" {
+		t.Errorf("diagnostics = %v", diags)
+	}
+}
+
+func TestValidatePlanned_Skips(t *testing.T) {
+	noCalls := func(req *http.Request) *http.Response {
+		t.Errorf("unexpected request %s", req.URL)
+		return nil
+	}
+	client := testutils.NewStubAPIClient(noCalls)
+	optedOut := testutils.NewStubAPIClient(noCalls)
+	optedOut.SkipSonarQueryValidation = true
+
+	cases := map[string]struct {
+		client  *api_client.APIClient
+		planned types.String
+		prior   types.String
+	}{
+		"unconfigured provider": {nil, types.StringValue("Bucket"), types.StringNull()},
+		"unknown value":         {client, types.StringUnknown(), types.StringNull()},
+		"unchanged value":       {client, types.StringValue("Bucket"), types.StringValue("Bucket")},
+		"opted out":             {optedOut, types.StringValue("Bucket"), types.StringNull()},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			ValidatePlanned(context.Background(), tc.client, path.Root("rule"), tc.planned, tc.prior, true, &diags)
+			if len(diags) != 0 {
+				t.Errorf("diagnostics = %v", diags)
+			}
+		})
+	}
+}
+
+func TestValidatePlanned_BackendWithoutEndpoint(t *testing.T) {
+	var sent any
+	client := testutils.NewStubAPIClient(validationStub(t, http.StatusNotFound, `{"status":"failure","error":"not found"}`, &sent))
+	var diags diag.Diagnostics
+	ValidatePlanned(context.Background(), client, path.Root("rule"), types.StringValue("Bucket"), types.StringNull(), true, &diags)
+	if len(diags) != 0 {
+		t.Errorf("a missing endpoint must not produce diagnostics: %v", diags)
+	}
+}
+
+func TestValidatePlanned_CallFailureWarns(t *testing.T) {
+	var sent any
+	client := testutils.NewStubAPIClient(validationStub(t, http.StatusForbidden, `{"status":"failure","error":"forbidden"}`, &sent))
+	client.MaxRetryAttempts = 1
+	var diags diag.Diagnostics
+	ValidatePlanned(context.Background(), client, path.Root("rule"), types.StringValue("Bucket"), types.StringNull(), true, &diags)
+	if diags.HasError() || diags.WarningsCount() != 1 {
+		t.Errorf("diagnostics = %v, want a single warning", diags)
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -18,6 +19,8 @@ type state struct {
 	cc.CommonFields
 	URL                 types.String `tfsdk:"url"`
 	Token               types.String `tfsdk:"token"`
+	TokenWO             types.String `tfsdk:"token_wo"`
+	TokenWOVersion      types.Int64  `tfsdk:"token_wo_version"`
 	AllowSelfSignedCert types.Bool   `tfsdk:"allow_self_signed_cert"`
 }
 
@@ -38,7 +41,7 @@ func buildPayload(_ context.Context, st cc.State, _ *diag.Diagnostics) api_clien
 
 // extract maps the API envelope back onto state; an empty URL never clobbers the plan, and the
 // self-signed-cert flag always round-trips from the response.
-func extract(o *api_client.SplunkExternalServiceConfig, st cc.State, _ *diag.Diagnostics) cc.APIObject {
+func extract(_ context.Context, o *api_client.SplunkExternalServiceConfig, st cc.State, _ *diag.Diagnostics) cc.APIObject {
 	s := st.(*state)
 	if o.Config.URL != "" {
 		s.URL = types.StringValue(o.Config.URL)
@@ -50,6 +53,7 @@ func extract(o *api_client.SplunkExternalServiceConfig, st cc.State, _ *diag.Dia
 func NewSplunkResource() resource.Resource {
 	return cc.New(cc.Spec[api_client.SplunkExternalServiceConfig]{
 		TypeNameSuffix: "_integration_splunk",
+		ServiceName:    api_client.SplunkServiceName,
 		UIName:         "Splunk integration",
 		Description:    "Manage a Splunk HEC integration in Orca. Creates an external service config of `service_name = \"splunk\"`. The HEC token is stored in Orca's secret store and is never returned by the API.",
 		VariantAttributes: map[string]schema.Attribute{
@@ -71,12 +75,19 @@ func NewSplunkResource() resource.Resource {
 				Default:     booldefault.StaticBool(false),
 			},
 		},
-		NewState:     func() cc.State { return &state{} },
-		BuildPayload: buildPayload,
-		Extract:      extract,
-		Create:       (*api_client.APIClient).CreateSplunkConfig,
-		Get:          (*api_client.APIClient).GetSplunkConfig,
-		Update:       (*api_client.APIClient).UpdateSplunkConfig,
-		Delete:       (*api_client.APIClient).DeleteSplunkConfig,
+		WriteOnlySecrets: []string{"token"},
+		NewState:         func() cc.State { return &state{} },
+		BuildPayload:     buildPayload,
+		Extract:          extract,
+		Create:           (*api_client.APIClient).CreateSplunkConfig,
+		Get:              (*api_client.APIClient).GetSplunkConfig,
+		Update:           (*api_client.APIClient).UpdateSplunkConfig,
+		Delete:           (*api_client.APIClient).DeleteSplunkConfig,
+		List:             (*api_client.APIClient).ListSplunkConfigs,
 	})
 }
+
+// NewSplunkListResource lists the integrations managed by NewSplunkResource.
+func NewSplunkListResource() list.ListResource {
+	return cc.NewList(NewSplunkResource)
+}
@@ -0,0 +1,54 @@
+package sumo_logic
+
+import (
+	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	cc "terraform-provider-orcasecurity/orcasecurity/config_integration_common"
+	"terraform-provider-orcasecurity/orcasecurity/internal/testutils"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The shared suite covers the envelope plumbing; the closures below describe Sumo Logic's
+// config fields: the source URL is write-only, and the API round-trips the source metadata.
+func TestMapping(t *testing.T) {
+	testutils.RunMappingSuite(t, testutils.MappingSuite[api_client.SumoLogicConfig]{
+		BuildPayload: buildPayload,
+		Extract:      extract,
+		TemplateName: "tf-acc-test-sumo-logic",
+		FilledState: func() cc.State {
+			return &state{
+				URL:            types.StringValue("https://endpoint4.collection.sumologic.com/receiver/v1/http/abc"),
+				SourceCategory: types.StringValue("security/orca"),
+				SourceName:     types.StringValue("orca-alerts"),
+			}
+		},
+		CheckConfig: func(t *testing.T, c api_client.SumoLogicConfig) {
+			testutils.AssertEq(t, "url", c.URL, "https://endpoint4.collection.sumologic.com/receiver/v1/http/abc")
+			testutils.AssertEq(t, "source_category", c.SourceCategory, "security/orca")
+			testutils.AssertEq(t, "source_name", c.SourceName, "orca-alerts")
+		},
+		EchoConfig: api_client.SumoLogicConfig{SourceCategory: "returned/category", SourceName: "returned-name"},
+		EchoState:  func() cc.State { return &state{SourceCategory: types.StringValue("security/orca")} },
+		CheckEchoed: func(t *testing.T, st cc.State) {
+			s := st.(*state)
+			testutils.AssertEq(t, "echoed source_category", s.SourceCategory.ValueString(), "returned/category")
+			testutils.AssertEq(t, "echoed source_name", s.SourceName.ValueString(), "returned-name")
+		},
+		ZeroConfigChecks: []testutils.StateCheck{
+			{
+				Name: "empty config keeps planned and secrets untouched",
+				State: func() cc.State {
+					return &state{SourceCategory: types.StringValue("security/orca"), URL: types.StringValue("planned-url")}
+				},
+				Check: func(t *testing.T, st cc.State) {
+					s := st.(*state)
+					testutils.AssertEq(t, "planned source_category", s.SourceCategory.ValueString(), "security/orca")
+					testutils.AssertEq(t, "planned url", s.URL.ValueString(), "planned-url")
+				},
+			},
+		},
+	})
+}
+
+var _ cc.State = &state{}
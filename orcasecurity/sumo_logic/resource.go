@@ -0,0 +1,91 @@
+package sumo_logic
+
+import (
+	"context"
+	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	cc "terraform-provider-orcasecurity/orcasecurity/config_integration_common"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type state struct {
+	cc.CommonFields
+	URL            types.String `tfsdk:"url"`
+	URLWO          types.String `tfsdk:"url_wo"`
+	URLWOVersion   types.Int64  `tfsdk:"url_wo_version"`
+	SourceCategory types.String `tfsdk:"source_category"`
+	SourceName     types.String `tfsdk:"source_name"`
+}
+
+// buildPayload converts the planned state into the Sumo Logic API payload.
+func buildPayload(_ context.Context, st cc.State, _ *diag.Diagnostics) api_client.SumoLogicExternalServiceConfig {
+	s := st.(*state)
+	return api_client.SumoLogicExternalServiceConfig{
+		TemplateName: s.TemplateName.ValueString(),
+		IsEnabled:    s.IsEnabled.ValueBool(),
+		IsDefault:    s.IsDefault.ValueBool(),
+		Config: api_client.SumoLogicConfig{
+			URL:            s.URL.ValueString(),
+			SourceCategory: s.SourceCategory.ValueString(),
+			SourceName:     s.SourceName.ValueString(),
+		},
+	}
+}
+
+// extract maps the API envelope back onto state; the source URL is never returned and empty
+// metadata fields never clobber the plan.
+func extract(_ context.Context, o *api_client.SumoLogicExternalServiceConfig, st cc.State, _ *diag.Diagnostics) cc.APIObject {
+	s := st.(*state)
+	if o.Config.SourceCategory != "" {
+		s.SourceCategory = types.StringValue(o.Config.SourceCategory)
+	}
+	if o.Config.SourceName != "" {
+		s.SourceName = types.StringValue(o.Config.SourceName)
+	}
+	return cc.APIObject{ID: o.ID, TemplateName: o.TemplateName, IsEnabled: o.IsEnabled, IsDefault: o.IsDefault}
+}
+
+func NewSumoLogicResource() resource.Resource {
+	return cc.New(cc.Spec[api_client.SumoLogicExternalServiceConfig]{
+		TypeNameSuffix: "_integration_sumo_logic",
+		ServiceName:    api_client.SumoLogicServiceName,
+		UIName:         "Sumo Logic integration",
+		Description:    "Manage a Sumo Logic integration in Orca. Creates an external service config of `service_name = \"sumo_logic\"` that streams alerts to a Sumo Logic HTTP Logs & Metrics source. The source URL embeds its auth token, so it is stored in Orca's secret store and is never returned by the API.",
+		VariantAttributes: map[string]schema.Attribute{
+			"url": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "URL of the Sumo Logic hosted HTTP source (for example, `https://endpoint4.collection.sumologic.com/receiver/v1/http/<token>`). Stored in Orca's secret store; never returned by the API.",
+				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"source_category": schema.StringAttribute{
+				Optional:    true,
+				Description: "Source category sent as `X-Sumo-Category`, overriding the one configured on the source.",
+			},
+			"source_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Source name sent as `X-Sumo-Name`, overriding the one configured on the source.",
+			},
+		},
+		WriteOnlySecrets: []string{"url"},
+		NewState:         func() cc.State { return &state{} },
+		BuildPayload:     buildPayload,
+		Extract:          extract,
+		Create:           (*api_client.APIClient).CreateSumoLogicConfig,
+		Get:              (*api_client.APIClient).GetSumoLogicConfig,
+		Update:           (*api_client.APIClient).UpdateSumoLogicConfig,
+		Delete:           (*api_client.APIClient).DeleteSumoLogicConfig,
+		List:             (*api_client.APIClient).ListSumoLogicConfigs,
+	})
+}
+
+// NewSumoLogicListResource lists the integrations managed by NewSumoLogicResource.
+func NewSumoLogicListResource() list.ListResource {
+	return cc.NewList(NewSumoLogicResource)
+}
@@ -0,0 +1,24 @@
+package sumo_logic_test
+
+import (
+	"terraform-provider-orcasecurity/orcasecurity/internal/acctest"
+	"testing"
+)
+
+func TestAccSumoLogicResource(t *testing.T) {
+	acctest.RunIntegrationTest(t, sumoLogicSpec)
+}
+
+func TestSumoLogicResource_Offline(t *testing.T) {
+	acctest.RunIntegrationTestOffline(t, sumoLogicSpec)
+}
+
+var sumoLogicSpec = acctest.IntegrationSpec{
+	ResourceType: "orcasecurity_integration_sumo_logic",
+	TemplateName: "tf-acc-test-sumo-logic",
+	Attributes: map[string]string{
+		"source_category": `"security/orca"`,
+		"url":             `"https://endpoint4.collection.sumologic.com/receiver/v1/http/tf-acc-test"`,
+	},
+	Secrets: []string{"url"},
+}
@@ -0,0 +1,18 @@
+package sumo_logic
+
+import (
+	"terraform-provider-orcasecurity/orcasecurity/internal/testutils"
+	"testing"
+)
+
+// The HTTP source URL carries its own auth token, so it is a secret with a write-only variant.
+// The variant uses the no-BU CommonFields flavour, so business_units must be absent.
+func TestSumoLogicResource_SchemaContract(t *testing.T) {
+	testutils.CheckVariantResource(t, testutils.VariantResourceSpec{
+		NewResource:      NewSumoLogicResource,
+		TypeName:         "orcasecurity_integration_sumo_logic",
+		WriteOnlySecrets: []string{"url"},
+		Forbidden:        []string{"business_units"},
+		State:            &state{},
+	})
+}
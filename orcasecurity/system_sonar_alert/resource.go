@@ -4,6 +4,9 @@ import (
 	"context"
 	"fmt"
 	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	"terraform-provider-orcasecurity/orcasecurity/apierror_common"
+	"terraform-provider-orcasecurity/orcasecurity/identity_common"
+	"terraform-provider-orcasecurity/orcasecurity/timeouts_common"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -18,6 +21,7 @@ var (
 	_ resource.Resource                = &systemSonarAlertResource{}
 	_ resource.ResourceWithConfigure   = &systemSonarAlertResource{}
 	_ resource.ResourceWithImportState = &systemSonarAlertResource{}
+	_ resource.ResourceWithIdentity    = &systemSonarAlertResource{}
 )
 
 type systemSonarAlertResource struct {
@@ -31,6 +35,8 @@ type stateModel struct {
 	Category types.String  `tfsdk:"category"`
 	Score    types.Float64 `tfsdk:"score"`
 	RuleType types.String  `tfsdk:"rule_type"`
+
+	Timeouts timeouts_common.Value `tfsdk:"timeouts"`
 }
 
 func NewSystemSonarAlertResource() resource.Resource {
@@ -48,11 +54,19 @@ func (r *systemSonarAlertResource) Configure(_ context.Context, req resource.Con
 	r.apiClient = req.ProviderData.(*api_client.APIClient)
 }
 
+// IdentitySchema addresses a system alert by its rule ID, the same value as the import ID.
+func (r *systemSonarAlertResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity_common.Schema(identity_common.Attribute{
+		Name:        "rule_id",
+		Description: "Rule ID of the system alert.",
+	})
+}
+
 func (r *systemSonarAlertResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("rule_id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("rule_id"), path.Root("rule_id"), req, resp)
 }
 
-func (r *systemSonarAlertResource) Schema(_ context.Context, req resource.SchemaRequest, res *resource.SchemaResponse) {
+func (r *systemSonarAlertResource) Schema(ctx context.Context, req resource.SchemaRequest, res *resource.SchemaResponse) {
 	res.Schema = schema.Schema{
 		Description: "Manages the enabled/disabled state of a built-in Orca system sonar alert. System alerts are pre-defined by Orca and cannot be created or deleted, only enabled or disabled.",
 		Attributes: map[string]schema.Attribute{
@@ -87,6 +101,9 @@ func (r *systemSonarAlertResource) Schema(_ context.Context, req resource.Schema
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts_common.Block(ctx),
+		},
 	}
 }
 
@@ -98,13 +115,14 @@ func (r *systemSonarAlertResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	ctx, cancel := timeouts_common.Create(ctx, plan.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
 	// First, get the alert to ensure it exists and get its details
-	alert, err := r.apiClient.GetSystemSonarAlert(plan.RuleID.ValueString())
+	alert, err := r.apiClient.GetSystemSonarAlert(ctx, plan.RuleID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error reading system alert",
-			fmt.Sprintf("Could not read system alert ID %s: %s", plan.RuleID.ValueString(), err.Error()),
-		)
+		apierror_common.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, "Error reading system alert",
+			fmt.Sprintf("Could not read system alert ID %s", plan.RuleID.ValueString()), err)
 		return
 	}
 
@@ -118,16 +136,14 @@ func (r *systemSonarAlertResource) Create(ctx context.Context, req resource.Crea
 	}
 
 	// Update the alert status
-	_, err = r.apiClient.UpdateSystemSonarAlertStatus(
+	_, err = r.apiClient.UpdateSystemSonarAlertStatus(ctx,
 		plan.RuleID.ValueString(),
 		alert.RuleType,
 		plan.Enabled.ValueBool(),
 	)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating system alert status",
-			fmt.Sprintf("Could not update system alert ID %s: %s", plan.RuleID.ValueString(), err.Error()),
-		)
+		apierror_common.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, "Error updating system alert status",
+			fmt.Sprintf("Could not update system alert ID %s", plan.RuleID.ValueString()), err)
 		return
 	}
 
@@ -139,9 +155,11 @@ func (r *systemSonarAlertResource) Create(ctx context.Context, req resource.Crea
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(identity_common.SetFromState(ctx, resp.Identity, resp.State, "rule_id")...)
 }
 
 func (r *systemSonarAlertResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(identity_common.SetFromState(ctx, resp.Identity, req.State, "rule_id")...)
 	var state stateModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -149,12 +167,13 @@ func (r *systemSonarAlertResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
-	exists, err := r.apiClient.DoesSystemSonarAlertExist(state.RuleID.ValueString())
+	ctx, cancel := timeouts_common.Read(ctx, state.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
+	exists, err := r.apiClient.DoesSystemSonarAlertExist(ctx, state.RuleID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error checking system alert existence",
-			fmt.Sprintf("Could not check system alert ID %s: %s", state.RuleID.ValueString(), err.Error()),
-		)
+		apierror_common.AddError(ctx, &resp.Diagnostics, req.State.Schema, "Error checking system alert existence",
+			fmt.Sprintf("Could not check system alert ID %s", state.RuleID.ValueString()), err)
 		return
 	}
 
@@ -164,12 +183,10 @@ func (r *systemSonarAlertResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
-	alert, err := r.apiClient.GetSystemSonarAlert(state.RuleID.ValueString())
+	alert, err := r.apiClient.GetSystemSonarAlert(ctx, state.RuleID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error reading system alert",
-			fmt.Sprintf("Could not read system alert ID %s: %s", state.RuleID.ValueString(), err.Error()),
-		)
+		apierror_common.AddError(ctx, &resp.Diagnostics, req.State.Schema, "Error reading system alert",
+			fmt.Sprintf("Could not read system alert ID %s", state.RuleID.ValueString()), err)
 		return
 	}
 
@@ -184,6 +201,7 @@ func (r *systemSonarAlertResource) Read(ctx context.Context, req resource.ReadRe
 }
 
 func (r *systemSonarAlertResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(identity_common.SetFromState(ctx, resp.Identity, req.State, "rule_id")...)
 	var plan stateModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -191,6 +209,9 @@ func (r *systemSonarAlertResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	ctx, cancel := timeouts_common.Update(ctx, plan.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
 	var state stateModel
 	diags = req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -200,16 +221,14 @@ func (r *systemSonarAlertResource) Update(ctx context.Context, req resource.Upda
 
 	ruleType := state.RuleType.ValueString()
 
-	_, err := r.apiClient.UpdateSystemSonarAlertStatus(
+	_, err := r.apiClient.UpdateSystemSonarAlertStatus(ctx,
 		plan.RuleID.ValueString(),
 		ruleType,
 		plan.Enabled.ValueBool(),
 	)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating system alert status",
-			fmt.Sprintf("Could not update system alert ID %s: %s", plan.RuleID.ValueString(), err.Error()),
-		)
+		apierror_common.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, "Error updating system alert status",
+			fmt.Sprintf("Could not update system alert ID %s", plan.RuleID.ValueString()), err)
 		return
 	}
 
@@ -230,5 +249,8 @@ func (r *systemSonarAlertResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
+	ctx, cancel := timeouts_common.Delete(ctx, state.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
 	tflog.Info(ctx, fmt.Sprintf("Removing system alert %s from Terraform state (alert state in Orca unchanged)", state.RuleID.ValueString()))
 }
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -15,8 +16,10 @@ import (
 
 type state struct {
 	cc.CommonFields
-	APIToken types.String `tfsdk:"api_token"`
-	APIURL   types.String `tfsdk:"api_url"`
+	APIToken          types.String `tfsdk:"api_token"`
+	APITokenWO        types.String `tfsdk:"api_token_wo"`
+	APITokenWOVersion types.Int64  `tfsdk:"api_token_wo_version"`
+	APIURL            types.String `tfsdk:"api_url"`
 }
 
 // buildPayload converts the planned state into the Terraform Cloud API payload.
@@ -31,7 +34,7 @@ func buildPayload(_ context.Context, st cc.State, _ *diag.Diagnostics) api_clien
 }
 
 // extract maps the API envelope back onto state; an empty api_url never clobbers the plan.
-func extract(o *api_client.TerraformCloudExternalServiceConfig, st cc.State, _ *diag.Diagnostics) cc.APIObject {
+func extract(_ context.Context, o *api_client.TerraformCloudExternalServiceConfig, st cc.State, _ *diag.Diagnostics) cc.APIObject {
 	if o.Config.APIURL != "" {
 		st.(*state).APIURL = types.StringValue(o.Config.APIURL)
 	}
@@ -41,6 +44,7 @@ func extract(o *api_client.TerraformCloudExternalServiceConfig, st cc.State, _ *
 func NewTerraformCloudResource() resource.Resource {
 	return cc.New(cc.Spec[api_client.TerraformCloudExternalServiceConfig]{
 		TypeNameSuffix: "_integration_terraform_cloud",
+		ServiceName:    api_client.TerraformCloudServiceName,
 		UIName:         "Terraform Cloud integration",
 		Description:    "Manage a Terraform Cloud (HCP Terraform) integration in Orca. Creates an external service config of `service_name = \"terraform_cloud\"`. The API token is stored in Orca's secret store and is never returned by the API.",
 		VariantAttributes: map[string]schema.Attribute{
@@ -56,12 +60,19 @@ func NewTerraformCloudResource() resource.Resource {
 				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
 			},
 		},
-		NewState:     func() cc.State { return &state{} },
-		BuildPayload: buildPayload,
-		Extract:      extract,
-		Create:       (*api_client.APIClient).CreateTerraformCloudConfig,
-		Get:          (*api_client.APIClient).GetTerraformCloudConfig,
-		Update:       (*api_client.APIClient).UpdateTerraformCloudConfig,
-		Delete:       (*api_client.APIClient).DeleteTerraformCloudConfig,
+		WriteOnlySecrets: []string{"api_token"},
+		NewState:         func() cc.State { return &state{} },
+		BuildPayload:     buildPayload,
+		Extract:          extract,
+		Create:           (*api_client.APIClient).CreateTerraformCloudConfig,
+		Get:              (*api_client.APIClient).GetTerraformCloudConfig,
+		Update:           (*api_client.APIClient).UpdateTerraformCloudConfig,
+		Delete:           (*api_client.APIClient).DeleteTerraformCloudConfig,
+		List:             (*api_client.APIClient).ListTerraformCloudConfigs,
 	})
 }
+
+// NewTerraformCloudListResource lists the integrations managed by NewTerraformCloudResource.
+func NewTerraformCloudListResource() list.ListResource {
+	return cc.NewList(NewTerraformCloudResource)
+}
@@ -0,0 +1,78 @@
+// Package timeouts_common wires the standard `timeouts` block (create/read/update/delete) into
+// resources. Each operation's duration becomes a context deadline on the request context, which
+// the api_client honors for both the HTTP attempt and its retry backoff. Operations the block
+// leaves unset fall back to the provider-level default carried on the API client.
+package timeouts_common
+
+import (
+	"context"
+	"time"
+
+	"terraform-provider-orcasecurity/orcasecurity/api_client"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Value is the state-model type of the `timeouts` block. Declare it on the resource model as
+//
+//	Timeouts timeouts_common.Value `tfsdk:"timeouts"`
+type Value = timeouts.Value
+
+// Block returns the `timeouts` block with all four operations configurable.
+func Block(ctx context.Context) schema.Block {
+	return timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true})
+}
+
+// Null returns an unset `timeouts` block. Models built outside the framework (test fixtures)
+// need it because the Go zero value does not carry the block's object type.
+func Null(ctx context.Context) Value {
+	return Value{Object: types.ObjectNull(Block(ctx).Type().(timeouts.Type).AttrTypes)}
+}
+
+// Create bounds ctx by the create timeout. The returned cancel func must be deferred.
+func Create(ctx context.Context, t Value, client *api_client.APIClient, diags *diag.Diagnostics) (context.Context, context.CancelFunc) {
+	return withDeadline(ctx, t.Create, client, diags)
+}
+
+// Read bounds ctx by the read timeout. The returned cancel func must be deferred.
+func Read(ctx context.Context, t Value, client *api_client.APIClient, diags *diag.Diagnostics) (context.Context, context.CancelFunc) {
+	return withDeadline(ctx, t.Read, client, diags)
+}
+
+// Update bounds ctx by the update timeout. The returned cancel func must be deferred.
+func Update(ctx context.Context, t Value, client *api_client.APIClient, diags *diag.Diagnostics) (context.Context, context.CancelFunc) {
+	return withDeadline(ctx, t.Update, client, diags)
+}
+
+// Delete bounds ctx by the delete timeout. The returned cancel func must be deferred.
+func Delete(ctx context.Context, t Value, client *api_client.APIClient, diags *diag.Diagnostics) (context.Context, context.CancelFunc) {
+	return withDeadline(ctx, t.Delete, client, diags)
+}
+
+// Default bounds ctx by the provider-level default timeout alone, for operations that have no
+// `timeouts` block of their own such as actions. The returned cancel func must be deferred.
+func Default(ctx context.Context, client *api_client.APIClient, diags *diag.Diagnostics) (context.Context, context.CancelFunc) {
+	return withDeadline(ctx, func(_ context.Context, fallback time.Duration) (time.Duration, diag.Diagnostics) {
+		return fallback, nil
+	}, client, diags)
+}
+
+// withDeadline resolves the operation's duration (block value, else the client default) and
+// applies it to ctx. A zero duration leaves ctx without a deadline, which keeps the
+// per-attempt api_client request timeout in charge. A null Value (import, or a model built
+// outside the framework) resolves to the default.
+func withDeadline(ctx context.Context, get func(context.Context, time.Duration) (time.Duration, diag.Diagnostics), client *api_client.APIClient, diags *diag.Diagnostics) (context.Context, context.CancelFunc) {
+	var fallback time.Duration
+	if client != nil {
+		fallback = client.DefaultTimeout
+	}
+	d, getDiags := get(ctx, fallback)
+	diags.Append(getDiags...)
+	if d <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, d)
+}
@@ -0,0 +1,95 @@
+package timeouts_common
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"terraform-provider-orcasecurity/orcasecurity/api_client"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var timeoutAttrTypes = map[string]attr.Type{
+	"create": types.StringType,
+	"read":   types.StringType,
+	"update": types.StringType,
+	"delete": types.StringType,
+}
+
+func timeoutsValue(create string) Value {
+	return Value{Object: types.ObjectValueMust(timeoutAttrTypes, map[string]attr.Value{
+		"create": types.StringValue(create),
+		"read":   types.StringNull(),
+		"update": types.StringNull(),
+		"delete": types.StringNull(),
+	})}
+}
+
+func remaining(t *testing.T, ctx context.Context) time.Duration {
+	t.Helper()
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		t.Fatal("expected a deadline on the returned context")
+	}
+	return time.Until(deadline)
+}
+
+func TestCreate_BlockValueWins(t *testing.T) {
+	var diags diag.Diagnostics
+	client := &api_client.APIClient{DefaultTimeout: time.Hour}
+	ctx, cancel := Create(context.Background(), timeoutsValue("2m"), client, &diags)
+	defer cancel()
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+	if got := remaining(t, ctx); got > 2*time.Minute || got < time.Minute {
+		t.Fatalf("remaining = %s, want about 2m", got)
+	}
+}
+
+func TestRead_UnsetFallsBackToClientDefault(t *testing.T) {
+	var diags diag.Diagnostics
+	client := &api_client.APIClient{DefaultTimeout: 3 * time.Minute}
+	ctx, cancel := Read(context.Background(), timeoutsValue("2m"), client, &diags)
+	defer cancel()
+
+	if got := remaining(t, ctx); got > 3*time.Minute || got <= 2*time.Minute {
+		t.Fatalf("remaining = %s, want about 3m", got)
+	}
+}
+
+func TestDelete_NullValueWithoutDefaultHasNoDeadline(t *testing.T) {
+	var diags diag.Diagnostics
+	null := Value{Object: types.ObjectNull(timeoutAttrTypes)}
+	ctx, cancel := Delete(context.Background(), null, &api_client.APIClient{}, &diags)
+	defer cancel()
+
+	if _, ok := ctx.Deadline(); ok {
+		t.Fatal("expected no deadline when neither the block nor the provider sets one")
+	}
+}
+
+func TestCreate_InvalidDurationAddsDiagnostic(t *testing.T) {
+	var diags diag.Diagnostics
+	_, cancel := Create(context.Background(), timeoutsValue("soon"), nil, &diags)
+	defer cancel()
+
+	if !diags.HasError() {
+		t.Fatal("expected an error diagnostic for an unparsable duration")
+	}
+}
+
+func TestDefault_UsesClientDefault(t *testing.T) {
+	var diags diag.Diagnostics
+	client := &api_client.APIClient{DefaultTimeout: 3 * time.Minute}
+	ctx, cancel := Default(context.Background(), client, &diags)
+	defer cancel()
+
+	if got := remaining(t, ctx); got > 3*time.Minute || got <= 2*time.Minute {
+		t.Fatalf("remaining = %s, want about 3m", got)
+	}
+}
@@ -5,14 +5,19 @@ import (
 	"fmt"
 	"strconv"
 	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	"terraform-provider-orcasecurity/orcasecurity/apierror_common"
+	"terraform-provider-orcasecurity/orcasecurity/identity_common"
+	"terraform-provider-orcasecurity/orcasecurity/timeouts_common"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -21,6 +26,7 @@ var (
 	_ resource.Resource                = &trustedCloudAccountResource{}
 	_ resource.ResourceWithConfigure   = &trustedCloudAccountResource{}
 	_ resource.ResourceWithImportState = &trustedCloudAccountResource{}
+	_ resource.ResourceWithIdentity    = &trustedCloudAccountResource{}
 )
 
 type trustedCloudAccountResource struct {
@@ -34,6 +40,8 @@ type trustedCloudAccountResourceModel struct {
 	CloudProvider types.String `tfsdk:"cloud_provider"`
 	//Provider       types.String `tfsdk:"provider"`
 	CloudAccountID types.String `tfsdk:"cloud_provider_id"`
+
+	Timeouts timeouts_common.Value `tfsdk:"timeouts"`
 }
 
 func NewTrustedCloudAccountResource() resource.Resource {
@@ -52,7 +60,7 @@ func (r *trustedCloudAccountResource) Configure(_ context.Context, req resource.
 }
 
 func (r *trustedCloudAccountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	id, err := strconv.ParseInt(req.ID, 10, 64)
+	id, err := strconv.ParseInt(identity_common.ImportedID(ctx, req, &resp.Diagnostics), 10, 64)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error importing trusted cloud account",
@@ -62,7 +70,7 @@ func (r *trustedCloudAccountResource) ImportState(ctx context.Context, req resou
 	}
 
 	// Get the resource from API using the ID
-	account, err := r.apiClient.GetTrustedCloudAccount(strconv.Itoa(int(id)))
+	account, err := r.apiClient.GetTrustedCloudAccount(ctx, strconv.Itoa(int(id)))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error importing trusted cloud account",
@@ -83,6 +91,17 @@ func (r *trustedCloudAccountResource) ImportState(ctx context.Context, req resou
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cloud_provider"), account.CloudProvider)...)
 	//resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("provider"), account.Provider)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cloud_provider_id"), account.CloudAccountID)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, types.Int64Value(id))...)
+}
+
+// IdentitySchema uses the shared string `id`: the integer ID is carried in its decimal form,
+// which is also what the import ID has always been.
+func (r *trustedCloudAccountResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity_common.IDSchema()
+}
+
+func setIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, id types.Int64) diag.Diagnostics {
+	return identity_common.SetID(ctx, identity, strconv.FormatInt(id.ValueInt64(), 10))
 }
 
 func (r *trustedCloudAccountResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -117,6 +136,9 @@ func (r *trustedCloudAccountResource) Schema(ctx context.Context, req resource.S
 				Required:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts_common.Block(ctx),
+		},
 	}
 }
 
@@ -128,6 +150,9 @@ func (r *trustedCloudAccountResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	ctx, cancel := timeouts_common.Create(ctx, plan.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
 	createReq := api_client.TrustedCloudAccount{
 		Name:           plan.Name.ValueString(),
 		CloudProvider:  plan.CloudProvider.ValueString(),
@@ -136,16 +161,14 @@ func (r *trustedCloudAccountResource) Create(ctx context.Context, req resource.C
 		Description:    plan.Description.ValueString(),
 	}
 
-	instance, err := r.apiClient.CreateTrustedCloudAccount(createReq)
+	instance, err := r.apiClient.CreateTrustedCloudAccount(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating trusted cloud account",
-			"Could not create trusted cloud account, unexpected error: "+err.Error(),
-		)
+		apierror_common.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, "Error creating trusted cloud account", "Could not create trusted cloud account", err)
 		return
 	}
 
 	plan.ID = types.Int64Value(instance.ID)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, plan.ID)...)
 
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -161,8 +184,12 @@ func (r *trustedCloudAccountResource) Read(ctx context.Context, req resource.Rea
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, state.ID)...)
+
+	ctx, cancel := timeouts_common.Read(ctx, state.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
 
-	account, err := r.apiClient.GetTrustedCloudAccount(strconv.Itoa(int(state.ID.ValueInt64())))
+	account, err := r.apiClient.GetTrustedCloudAccount(ctx, strconv.Itoa(int(state.ID.ValueInt64())))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading cloud account",
@@ -198,6 +225,11 @@ func (r *trustedCloudAccountResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, plan.ID)...)
+
+	ctx, cancel := timeouts_common.Update(ctx, plan.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
 	updateReq := api_client.TrustedCloudAccount{
 		ID:             plan.ID.ValueInt64(),
 		Name:           plan.Name.ValueString(),
@@ -207,12 +239,9 @@ func (r *trustedCloudAccountResource) Update(ctx context.Context, req resource.U
 		Description:    plan.Description.ValueString(),
 	}
 
-	_, err := r.apiClient.UpdateTrustedCloudAccount(updateReq)
+	_, err := r.apiClient.UpdateTrustedCloudAccount(ctx, updateReq)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating trusted cloud account",
-			"Could not update trusted cloud account, unexpected error: "+err.Error(),
-		)
+		apierror_common.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, "Error updating trusted cloud account", "Could not update trusted cloud account", err)
 		return
 	}
 
@@ -231,7 +260,10 @@ func (r *trustedCloudAccountResource) Delete(ctx context.Context, req resource.D
 		return
 	}
 
-	err := r.apiClient.DeleteTrustedCloudAccount(strconv.Itoa(int(state.ID.ValueInt64())))
+	ctx, cancel := timeouts_common.Delete(ctx, state.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
+	err := r.apiClient.DeleteTrustedCloudAccount(ctx, strconv.Itoa(int(state.ID.ValueInt64())))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting cloud account",
@@ -4,6 +4,9 @@ import (
 	"context"
 	"fmt"
 	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	"terraform-provider-orcasecurity/orcasecurity/apierror_common"
+	"terraform-provider-orcasecurity/orcasecurity/identity_common"
+	"terraform-provider-orcasecurity/orcasecurity/timeouts_common"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -17,6 +20,7 @@ var (
 	_ resource.Resource                = &trustedDynamicIpRangeResource{}
 	_ resource.ResourceWithConfigure   = &trustedDynamicIpRangeResource{}
 	_ resource.ResourceWithImportState = &trustedDynamicIpRangeResource{}
+	_ resource.ResourceWithIdentity    = &trustedDynamicIpRangeResource{}
 )
 
 type trustedDynamicIpRangeResource struct {
@@ -27,6 +31,8 @@ type trustedDynamicIpRangeResourceModel struct {
 	ID      types.String `tfsdk:"id"`
 	OrgID   types.String `tfsdk:"org_id"`
 	Enabled types.Bool   `tfsdk:"enabled"`
+
+	Timeouts timeouts_common.Value `tfsdk:"timeouts"`
 }
 
 func NewTrustedDynamicIpRangeResource() resource.Resource {
@@ -44,11 +50,19 @@ func (r *trustedDynamicIpRangeResource) Configure(_ context.Context, req resourc
 	r.apiClient = req.ProviderData.(*api_client.APIClient)
 }
 
+// IdentitySchema addresses the toggle by organization; the synthetic id is derived from it.
+func (r *trustedDynamicIpRangeResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity_common.Schema(identity_common.Attribute{
+		Name:        "org_id",
+		Description: "Orca organization ID the setting belongs to.",
+	})
+}
+
 func (r *trustedDynamicIpRangeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// The import ID is the org_id. Derive the synthetic resource id and set
-	// org_id so the subsequent Read (which keys off org_id) can reconcile the
-	// enabled toggle from the API.
-	orgID := req.ID
+	// The import ID (or identity org_id) is the org_id. Derive the synthetic
+	// resource id and set org_id so the subsequent Read (which keys off
+	// org_id) can reconcile the enabled toggle from the API.
+	orgID := identity_common.Imported(ctx, req, "org_id", &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("org_id"), orgID)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), fmt.Sprintf("toggle_setting_%s", orgID))...)
 }
@@ -73,16 +87,19 @@ func (r *trustedDynamicIpRangeResource) Schema(ctx context.Context, req resource
 				Required:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts_common.Block(ctx),
+		},
 	}
 }
 
 // setEnabledState sets or unsets the dynamic trusted IP range based on the enabled value
-func (r *trustedDynamicIpRangeResource) setEnabledState(orgID string, enabled bool) error {
+func (r *trustedDynamicIpRangeResource) setEnabledState(ctx context.Context, orgID string, enabled bool) error {
 	if enabled {
-		_, err := r.apiClient.SetTrustedDynamicIpRange(orgID)
+		_, err := r.apiClient.SetTrustedDynamicIpRange(ctx, orgID)
 		return err
 	}
-	return r.apiClient.UnsetTrustedDynamicIpRange(orgID)
+	return r.apiClient.UnsetTrustedDynamicIpRange(ctx, orgID)
 }
 
 // Create creates the resource and sets the initial Terraform state
@@ -99,16 +116,21 @@ func (r *trustedDynamicIpRangeResource) Create(ctx context.Context, req resource
 		return
 	}
 
-	if err := r.setEnabledState(plan.OrgID.ValueString(), plan.Enabled.ValueBool()); err != nil {
-		resp.Diagnostics.AddError("Error setting dynamic trusted IP range", fmt.Sprintf("Could not set enabled state: %s", err))
+	ctx, cancel := timeouts_common.Create(ctx, plan.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
+	if err := r.setEnabledState(ctx, plan.OrgID.ValueString(), plan.Enabled.ValueBool()); err != nil {
+		apierror_common.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, "Error setting dynamic trusted IP range", "Could not set enabled state", err)
 		return
 	}
 
 	plan.ID = types.StringValue(fmt.Sprintf("toggle_setting_%s", plan.OrgID.ValueString()))
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(identity_common.SetFromState(ctx, resp.Identity, resp.State, "org_id")...)
 }
 
 func (r *trustedDynamicIpRangeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(identity_common.SetFromState(ctx, resp.Identity, req.State, "org_id")...)
 	var state trustedDynamicIpRangeResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -116,8 +138,11 @@ func (r *trustedDynamicIpRangeResource) Read(ctx context.Context, req resource.R
 		return
 	}
 
+	ctx, cancel := timeouts_common.Read(ctx, state.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
 	// Get toggle value from backend
-	enabled, err := r.apiClient.GetTrustedDynamicIpRangeStatus(state.OrgID.ValueString())
+	enabled, err := r.apiClient.GetTrustedDynamicIpRangeStatus(ctx, state.OrgID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading toggle setting",
@@ -135,6 +160,7 @@ func (r *trustedDynamicIpRangeResource) Read(ctx context.Context, req resource.R
 }
 
 func (r *trustedDynamicIpRangeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(identity_common.SetFromState(ctx, resp.Identity, req.State, "org_id")...)
 	var plan trustedDynamicIpRangeResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -142,8 +168,11 @@ func (r *trustedDynamicIpRangeResource) Update(ctx context.Context, req resource
 		return
 	}
 
-	if err := r.setEnabledState(plan.OrgID.ValueString(), plan.Enabled.ValueBool()); err != nil {
-		resp.Diagnostics.AddError("Error updating dynamic trusted IP range", fmt.Sprintf("Could not update enabled state: %s", err))
+	ctx, cancel := timeouts_common.Update(ctx, plan.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
+	if err := r.setEnabledState(ctx, plan.OrgID.ValueString(), plan.Enabled.ValueBool()); err != nil {
+		apierror_common.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, "Error updating dynamic trusted IP range", "Could not update enabled state", err)
 		return
 	}
 
@@ -163,7 +192,10 @@ func (r *trustedDynamicIpRangeResource) Delete(ctx context.Context, req resource
 		return
 	}
 
-	if err := r.apiClient.UnsetTrustedDynamicIpRange(state.OrgID.ValueString()); err != nil {
+	ctx, cancel := timeouts_common.Delete(ctx, state.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
+	if err := r.apiClient.UnsetTrustedDynamicIpRange(ctx, state.OrgID.ValueString()); err != nil {
 		resp.Diagnostics.AddError("Error deleting dynamic trusted IP range", fmt.Sprintf("Could not delete: %v", err))
 	}
 }
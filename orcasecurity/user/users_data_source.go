@@ -120,7 +120,7 @@ type usersDataSourceModel struct {
 }
 
 func (ds *usersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	users, err := ds.apiClient.ListUsers()
+	users, err := ds.apiClient.ListUsers(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to read users", err.Error())
 		return
@@ -3,8 +3,12 @@ package user_access
 import (
 	"context"
 	"fmt"
+	"strings"
 	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	"terraform-provider-orcasecurity/orcasecurity/apierror_common"
+	"terraform-provider-orcasecurity/orcasecurity/identity_common"
 	common "terraform-provider-orcasecurity/orcasecurity/integrations_common"
+	"terraform-provider-orcasecurity/orcasecurity/timeouts_common"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -19,6 +23,7 @@ var (
 	_ resource.Resource                = &userAccessResource{}
 	_ resource.ResourceWithConfigure   = &userAccessResource{}
 	_ resource.ResourceWithImportState = &userAccessResource{}
+	_ resource.ResourceWithIdentity    = &userAccessResource{}
 )
 
 type userAccessResource struct {
@@ -33,6 +38,8 @@ type userAccessResourceModel struct {
 	CloudAccounts     types.List   `tfsdk:"cloud_accounts"`
 	ShiftleftProjects types.List   `tfsdk:"shiftleft_projects"`
 	UserFilters       types.List   `tfsdk:"user_filters"`
+
+	Timeouts timeouts_common.Value `tfsdk:"timeouts"`
 }
 
 func NewUserAccessResource() resource.Resource {
@@ -41,6 +48,9 @@ func NewUserAccessResource() resource.Resource {
 
 func (r *userAccessResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_user_access"
+	// Read falls back to matching by role and scope when Orca has reissued the assignment id,
+	// so the identity can change on refresh.
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 func (r *userAccessResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -50,11 +60,49 @@ func (r *userAccessResource) Configure(_ context.Context, req resource.Configure
 	r.apiClient = req.ProviderData.(*api_client.APIClient)
 }
 
+// IdentitySchema addresses an assignment by its id. The user and role do not identify an
+// assignment on their own: Orca lets the same role be assigned several times with different scopes.
+func (r *userAccessResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity_common.IDSchema()
+}
+
+// ImportState accepts the assignment id, as the import ID or the identity. As a convenience the
+// import ID may instead be <user_id>/<role_id>, which must resolve to exactly one assignment.
 func (r *userAccessResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	ownerID, roleID, ok := strings.Cut(req.ID, "/")
+	if !ok {
+		identity_common.ImportID(ctx, req, resp)
+		return
+	}
+	assignments, err := r.apiClient.ListUserAccessForUser(ctx, ownerID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing user access", err.Error())
+		return
+	}
+	var ids []string
+	for _, a := range assignments {
+		if a.RoleID == roleID {
+			ids = append(ids, a.ID)
+		}
+	}
+	if len(ids) == 0 {
+		resp.Diagnostics.AddError("Error importing user access",
+			fmt.Sprintf("No assignment of role %s to user %s found.", roleID, ownerID))
+		return
+	}
+	if len(ids) > 1 {
+		resp.Diagnostics.AddError("Error importing user access",
+			fmt.Sprintf("Role %s is assigned to user %s %d times with different scopes; import by assignment id instead (one of %s).",
+				roleID, ownerID, len(ids), strings.Join(ids, ", ")))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), ids[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), ownerID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role_id"), roleID)...)
+	resp.Diagnostics.Append(identity_common.SetID(ctx, resp.Identity, ids[0])...)
 }
 
-func (r *userAccessResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *userAccessResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages a single user's permissions by assigning an RBAC role to a user with optional scope: " +
 			"all cloud accounts, specific cloud accounts, Shift Left projects, or user filters (business unit IDs from /api/filters). " +
@@ -101,6 +149,9 @@ func (r *userAccessResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Optional:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts_common.Block(ctx),
+		},
 	}
 }
 
@@ -146,6 +197,7 @@ func (r *userAccessResource) apiToModel(ctx context.Context, ua *api_client.User
 		CloudAccounts:     cloudList,
 		ShiftleftProjects: shiftList,
 		UserFilters:       filterList,
+		Timeouts:          ref.Timeouts,
 	}, diags
 }
 
@@ -156,20 +208,23 @@ func (r *userAccessResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	ctx, cancel := timeouts_common.Create(ctx, plan.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
 	payload, diags := r.modelToAPI(ctx, plan, "")
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	created, err := r.apiClient.CreateUserAccess(payload)
+	created, err := r.apiClient.CreateUserAccess(ctx, payload)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating user access", err.Error())
+		apierror_common.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, "Error creating user access", "Could not create user access", err)
 		return
 	}
 
 	// Re-read the canonical row so nested scope fields reflect the server.
-	refreshed, err := r.apiClient.FindUserAccess(created.ID, payload)
+	refreshed, err := r.apiClient.FindUserAccess(ctx, created.ID, payload)
 	if err != nil {
 		resp.Diagnostics.AddWarning("Could not read user access after create", err.Error())
 	}
@@ -184,22 +239,27 @@ func (r *userAccessResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(identity_common.SetIDFromState(ctx, resp.Identity, resp.State)...)
 }
 
 func (r *userAccessResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(identity_common.SetIDFromState(ctx, resp.Identity, req.State)...)
 	var prior userAccessResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := timeouts_common.Read(ctx, prior.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
 	want, diags := r.modelToAPI(ctx, prior, prior.ID.ValueString())
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	ua, err := r.apiClient.FindUserAccess(prior.ID.ValueString(), want)
+	ua, err := r.apiClient.FindUserAccess(ctx, prior.ID.ValueString(), want)
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading user access", fmt.Sprintf("Could not read id %s: %s", prior.ID.ValueString(), err.Error()))
 		return
@@ -215,29 +275,35 @@ func (r *userAccessResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+	resp.Diagnostics.Append(identity_common.SetIDFromState(ctx, resp.Identity, resp.State)...)
 }
 
 func (r *userAccessResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(identity_common.SetIDFromState(ctx, resp.Identity, req.State)...)
 	var plan userAccessResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
 	var prior userAccessResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := timeouts_common.Update(ctx, plan.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
 	payload, diags := r.modelToAPI(ctx, plan, prior.ID.ValueString())
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	updated, err := r.apiClient.UpdateUserAccess(payload)
+	updated, err := r.apiClient.UpdateUserAccess(ctx, payload)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating user access", err.Error())
+		apierror_common.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, "Error updating user access", "Could not update user access", err)
 		return
 	}
 
@@ -247,6 +313,7 @@ func (r *userAccessResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(identity_common.SetIDFromState(ctx, resp.Identity, resp.State)...)
 }
 
 func (r *userAccessResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -256,7 +323,10 @@ func (r *userAccessResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
-	if err := r.apiClient.DeleteUserAccess(state.ID.ValueString()); err != nil {
+	ctx, cancel := timeouts_common.Delete(ctx, state.Timeouts, r.apiClient, &resp.Diagnostics)
+	defer cancel()
+
+	if err := r.apiClient.DeleteUserAccess(ctx, state.ID.ValueString()); err != nil {
 		resp.Diagnostics.AddError("Error deleting user access", err.Error())
 	}
 }
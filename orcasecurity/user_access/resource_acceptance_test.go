@@ -1,6 +1,7 @@
 package user_access_test
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"testing"
@@ -33,7 +34,7 @@ func testAccCheckUserAccessDestroyed(t *testing.T) resource.TestCheckFunc {
 		}
 		id := rs.Primary.ID
 		userID := rs.Primary.Attributes["user_id"]
-		list, err := testAccUserAccessClient(t).ListUserAccessForUser(userID)
+		list, err := testAccUserAccessClient(t).ListUserAccessForUser(context.Background(), userID)
 		if err != nil {
 			return fmt.Errorf("verifying destroy: %s", err)
 		}
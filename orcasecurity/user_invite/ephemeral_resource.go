@@ -0,0 +1,210 @@
+package user_invite
+
+import (
+	"context"
+	"strings"
+	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	common "terraform-provider-orcasecurity/orcasecurity/integrations_common"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/ephemeralvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ ephemeral.EphemeralResource                     = &userInviteEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure        = &userInviteEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigValidators = &userInviteEphemeralResource{}
+)
+
+type userInviteEphemeralResource struct {
+	apiClient *api_client.APIClient
+}
+
+type userInviteEphemeralModel struct {
+	Email             types.String `tfsdk:"email"`
+	RoleID            types.String `tfsdk:"role_id"`
+	Groups            types.List   `tfsdk:"groups"`
+	AllCloudAccounts  types.Bool   `tfsdk:"all_cloud_accounts"`
+	CloudAccounts     types.List   `tfsdk:"cloud_accounts"`
+	UserFilters       types.List   `tfsdk:"user_filters"`
+	ShiftleftProjects types.List   `tfsdk:"shiftleft_projects"`
+	MFARequired       types.Bool   `tfsdk:"mfa_required"`
+	ShouldSendEmail   types.Bool   `tfsdk:"should_send_email"`
+	ID                types.String `tfsdk:"id"`
+	InviteLink        types.String `tfsdk:"invite_link"`
+	Expired           types.Bool   `tfsdk:"expired"`
+}
+
+func NewUserInviteEphemeralResource() ephemeral.EphemeralResource {
+	return &userInviteEphemeralResource{}
+}
+
+func (r *userInviteEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_invite"
+}
+
+func (r *userInviteEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.apiClient = req.ProviderData.(*api_client.APIClient)
+}
+
+func (r *userInviteEphemeralResource) ConfigValidators(_ context.Context) []ephemeral.ConfigValidator {
+	return []ephemeral.ConfigValidator{
+		ephemeralvalidator.ExactlyOneOf(
+			path.MatchRoot("role_id"),
+			path.MatchRoot("groups"),
+		),
+	}
+}
+
+func (r *userInviteEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Issues an invite for a single user and returns its registration link without storing it in state or plan. " +
+			"Each time Terraform opens the ephemeral resource (during plan and again during apply) any pending invite for the " +
+			"same email is revoked and a new one is issued, so only the most recent link works. Use it instead of " +
+			"`orcasecurity_add_users` to hand `invite_link` to a mailer or secret manager; do not manage the same email with both.",
+		Attributes: map[string]schema.Attribute{
+			"email": schema.StringAttribute{
+				Description: "Email address of the user to invite.",
+				Required:    true,
+			},
+			"role_id": schema.StringAttribute{
+				Description: "RBAC role id to grant on registration. Mutually exclusive with `groups`.",
+				Optional:    true,
+			},
+			"groups": schema.ListAttribute{
+				Description: "RBAC group ids to add the user to on registration. Mutually exclusive with `role_id`.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"all_cloud_accounts": schema.BoolAttribute{
+				Description: "When true, the granted role applies to all cloud accounts. Only relevant with `role_id`. Defaults to false.",
+				Optional:    true,
+			},
+			"cloud_accounts": schema.ListAttribute{
+				Description: "Cloud account ids the role applies to when not using `all_cloud_accounts`.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"user_filters": schema.ListAttribute{
+				Description: "User filter ids (business units use filter ids from orcasecurity_business_unit / /api/filters).",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"shiftleft_projects": schema.ListAttribute{
+				Description: "Shift Left project ids the role applies to.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"mfa_required": schema.BoolAttribute{
+				Description: "Require the invited user to set up MFA after registration. Defaults to false.",
+				Optional:    true,
+			},
+			"should_send_email": schema.BoolAttribute{
+				Description: "Also have Orca email the invitation. Defaults to false, since the link is usually delivered by other means.",
+				Optional:    true,
+			},
+			"id": schema.StringAttribute{
+				Description: "Id of the issued invite.",
+				Computed:    true,
+			},
+			"invite_link": schema.StringAttribute{
+				Description: "Registration link for the invited user.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"expired": schema.BoolAttribute{
+				Description: "Whether the invite has expired.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *userInviteEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	if r.apiClient == nil {
+		resp.Diagnostics.AddError("Error creating user invite", "API client not configured.")
+		return
+	}
+	var data userInviteEphemeralModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload, diags := inviteRequest(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.revokePending(ctx, data.Email.ValueString(), &resp.Diagnostics)
+
+	invite, err := r.apiClient.CreateUserInvite(ctx, payload)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating user invite", err.Error())
+		return
+	}
+	if invite.InviteLink == "" {
+		resp.Diagnostics.AddError("Error creating user invite", "The invite API did not return an invite link for "+data.Email.ValueString()+".")
+		return
+	}
+
+	data.ID = types.StringValue(invite.ID)
+	data.InviteLink = types.StringValue(invite.InviteLink)
+	data.Expired = types.BoolValue(invite.Expired)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// revokePending removes earlier pending invites for email so that re-opening
+// the resource on every plan and apply does not pile up live links. Failures
+// are warnings: the API may still accept the new invite.
+func (r *userInviteEphemeralResource) revokePending(ctx context.Context, email string, diags *diag.Diagnostics) {
+	invites, err := r.apiClient.ListUserInvites(ctx)
+	if err != nil {
+		diags.AddWarning("Could not revoke older user invites", err.Error())
+		return
+	}
+	for _, inv := range invites {
+		if !strings.EqualFold(inv.Email, email) {
+			continue
+		}
+		tflog.Debug(ctx, "Revoking superseded user invite", map[string]interface{}{"id": inv.ID})
+		if err := r.apiClient.DeleteUserInvite(ctx, inv.ID); err != nil {
+			diags.AddWarning("Could not revoke older user invite", "Invite "+inv.ID+": "+err.Error())
+		}
+	}
+}
+
+func inviteRequest(ctx context.Context, data userInviteEphemeralModel) (api_client.UserInviteRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	groups, d := common.StringSliceFromList(ctx, data.Groups)
+	diags.Append(d...)
+	cloudAccounts, d := common.StringSliceFromList(ctx, data.CloudAccounts)
+	diags.Append(d...)
+	userFilters, d := common.StringSliceFromList(ctx, data.UserFilters)
+	diags.Append(d...)
+	shiftleft, d := common.StringSliceFromList(ctx, data.ShiftleftProjects)
+	diags.Append(d...)
+	if diags.HasError() {
+		return api_client.UserInviteRequest{}, diags
+	}
+	return api_client.UserInviteRequest{
+		InviteUserEmails:  []string{data.Email.ValueString()},
+		RoleID:            data.RoleID.ValueString(),
+		Groups:            groups,
+		AllCloudAccounts:  data.AllCloudAccounts.ValueBool(),
+		CloudAccounts:     cloudAccounts,
+		UserFilters:       userFilters,
+		ShiftleftProjects: shiftleft,
+		MFARequired:       data.MFARequired.ValueBool(),
+		ShouldSendEmail:   data.ShouldSendEmail.ValueBool(),
+	}, diags
+}
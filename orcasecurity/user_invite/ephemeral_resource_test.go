@@ -0,0 +1,136 @@
+package user_invite
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"terraform-provider-orcasecurity/orcasecurity/internal/testutils"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func jsonResponse(status int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: status,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}
+}
+
+// open runs Open against fn with the given config and returns the result model. A nil fn
+// leaves the resource unconfigured.
+func open(t *testing.T, fn testutils.RoundTripFunc, config userInviteEphemeralModel) (userInviteEphemeralModel, *ephemeral.OpenResponse) {
+	t.Helper()
+	ctx := context.Background()
+	r := &userInviteEphemeralResource{}
+	if fn != nil {
+		r.apiClient = testutils.NewStubAPIClient(fn)
+	}
+	schemaResp := &ephemeral.SchemaResponse{}
+	r.Schema(ctx, ephemeral.SchemaRequest{}, schemaResp)
+
+	seed := tfsdk.State{Schema: schemaResp.Schema}
+	if diags := seed.Set(ctx, &config); diags.HasError() {
+		t.Fatalf("failed to seed config: %v", diags)
+	}
+	req := ephemeral.OpenRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: seed.Raw}}
+	resp := &ephemeral.OpenResponse{Result: tfsdk.EphemeralResultData{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}}
+	r.Open(ctx, req, resp)
+
+	var got userInviteEphemeralModel
+	if !resp.Diagnostics.HasError() {
+		if diags := resp.Result.Get(ctx, &got); diags.HasError() {
+			t.Fatalf("failed to read result: %v", diags)
+		}
+	}
+	return got, resp
+}
+
+func baseConfig() userInviteEphemeralModel {
+	return userInviteEphemeralModel{
+		Email:             types.StringValue("tf-acc-test-x@example.com"),
+		RoleID:            types.StringValue("role-1"),
+		Groups:            types.ListNull(types.StringType),
+		AllCloudAccounts:  types.BoolNull(),
+		CloudAccounts:     types.ListNull(types.StringType),
+		UserFilters:       types.ListNull(types.StringType),
+		ShiftleftProjects: types.ListNull(types.StringType),
+		MFARequired:       types.BoolNull(),
+		ShouldSendEmail:   types.BoolNull(),
+		ID:                types.StringNull(),
+		InviteLink:        types.StringNull(),
+		Expired:           types.BoolNull(),
+	}
+}
+
+// Open revokes the pending invite for the same email (and only that one)
+// before issuing a new invite, and returns the new link.
+func TestOpen_RevokesPendingAndReturnsLink(t *testing.T) {
+	var deleted []string
+	var sent map[string]interface{}
+	got, resp := open(t, func(req *http.Request) *http.Response {
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/api/user_invites/":
+			return jsonResponse(200, `{"data":[
+				{"id":"old","email":"TF-ACC-TEST-X@example.com"},
+				{"id":"other","email":"someone@example.com"}]}`)
+		case req.Method == http.MethodDelete:
+			deleted = append(deleted, req.URL.Path)
+			return jsonResponse(204, ``)
+		case req.Method == http.MethodPost && req.URL.Path == "/api/user_invites/bulk_create/":
+			body, _ := io.ReadAll(req.Body)
+			_ = json.Unmarshal(body, &sent)
+			return jsonResponse(201, `[{"id":"new","email":"tf-acc-test-x@example.com","invite_link":"https://app/invite/abc","expired":false}]`)
+		}
+		t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+		return jsonResponse(500, `{}`)
+	}, baseConfig())
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diags: %v", resp.Diagnostics)
+	}
+	if got.ID.ValueString() != "new" || got.InviteLink.ValueString() != "https://app/invite/abc" || got.Expired.ValueBool() {
+		t.Errorf("unexpected result: %+v", got)
+	}
+	if len(deleted) != 1 || deleted[0] != "/api/user_invites/old/" {
+		t.Errorf("expected only the matching pending invite to be revoked, got %v", deleted)
+	}
+	if sent["should_send_email"] != false || sent["role_id"] != "role-1" {
+		t.Errorf("unexpected payload: %v", sent)
+	}
+}
+
+// A failed revocation is a warning; a create response without a link is an
+// error since the resource exists only to return it.
+func TestOpen_MissingLinkIsError(t *testing.T) {
+	_, resp := open(t, func(req *http.Request) *http.Response {
+		switch req.Method {
+		case http.MethodGet:
+			return jsonResponse(500, `{"message":"boom"}`)
+		case http.MethodPost:
+			return jsonResponse(201, `[{"id":"new","email":"tf-acc-test-x@example.com"}]`)
+		}
+		return jsonResponse(500, `{}`)
+	}, baseConfig())
+	if resp.Diagnostics.WarningsCount() == 0 {
+		t.Error("expected a warning for the failed revocation")
+	}
+	if !resp.Diagnostics.HasError() {
+		t.Error("expected an error for the missing invite link")
+	}
+}
+
+func TestOpen_Unconfigured(t *testing.T) {
+	_, resp := open(t, nil, baseConfig())
+	if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics[0].Detail(), "API client not configured") {
+		t.Fatalf("diagnostics %v do not report the missing client", resp.Diagnostics)
+	}
+}
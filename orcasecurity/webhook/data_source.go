@@ -94,7 +94,7 @@ func (ds *dataSource) Read(ctx context.Context, req datasource.ReadRequest, resp
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 
 	//item, err := ds.apiClient.GetWebhookByName(req.Config.Raw.String())
-	item, err := ds.apiClient.GetWebhookByName(state.TemplateName.ValueString())
+	item, err := ds.apiClient.GetWebhookByName(ctx, state.TemplateName.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to read Webhook", err.Error())
 		return
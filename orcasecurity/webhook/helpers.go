@@ -89,7 +89,7 @@ func CustomHeadersFromAPI(headers map[string][]api_client.WebhookCustomHeaderVal
 
 // ExtractTopLevel populates only the cross-cutting identifiers. Used on Create/Update so the
 // planned (sensitive) config block survives the Plugin Framework's consistency check.
-func ExtractTopLevel(api *api_client.WebhookExternalServiceConfig, _ cc.State, _ *diag.Diagnostics) cc.APIObject {
+func ExtractTopLevel(_ context.Context, api *api_client.WebhookExternalServiceConfig, _ cc.State, _ *diag.Diagnostics) cc.APIObject {
 	return cc.APIObject{
 		ID:            api.ID,
 		TemplateName:  api.TemplateName,
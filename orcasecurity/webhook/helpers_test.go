@@ -45,7 +45,7 @@ func TestExtractTopLevel_LeavesConfigBlockAlone(t *testing.T) {
 	s := plannedState("https://planned.example.com", "planned-key")
 	apiObj := sampleAPIResponse()
 
-	obj := ExtractTopLevel(apiObj, s, &diag.Diagnostics{})
+	obj := ExtractTopLevel(context.Background(), apiObj, s, &diag.Diagnostics{})
 
 	if obj.ID != "id-1" {
 		t.Errorf("expected ID extracted, got %q", obj.ID)
@@ -62,7 +62,7 @@ func TestExtractFull_RefreshesConfigFromAPI(t *testing.T) {
 	s := plannedState("https://stale-planned.example.com", "planned-key")
 	apiObj := sampleAPIResponse()
 
-	obj := extractFull(apiObj, s, &diag.Diagnostics{})
+	obj := extractFull(context.Background(), apiObj, s, &diag.Diagnostics{})
 
 	if obj.ID != "id-1" {
 		t.Errorf("expected ID extracted, got %q", obj.ID)
@@ -89,7 +89,7 @@ func TestExtractFull_APIKeyUnknownTakesAPIValue(t *testing.T) {
 	}
 	apiObj := sampleAPIResponse()
 
-	extractFull(apiObj, s, &diag.Diagnostics{})
+	extractFull(context.Background(), apiObj, s, &diag.Diagnostics{})
 
 	if s.Config.APIKey.ValueString() != "echoed-key" {
 		t.Errorf("expected API key copied when planned is unknown, got %q", s.Config.APIKey.ValueString())
@@ -102,8 +102,8 @@ func TestExtractFull_APIKeyUnknownTakesAPIValue(t *testing.T) {
 func TestExtractHooks_AgreeOnCommon(t *testing.T) {
 	apiObj := sampleAPIResponse()
 
-	topLevel := ExtractTopLevel(apiObj, &state{}, &diag.Diagnostics{})
-	full := extractFull(apiObj, plannedState("https://planned.example.com", "planned-key"), &diag.Diagnostics{})
+	topLevel := ExtractTopLevel(context.Background(), apiObj, &state{}, &diag.Diagnostics{})
+	full := extractFull(context.Background(), apiObj, plannedState("https://planned.example.com", "planned-key"), &diag.Diagnostics{})
 
 	if topLevel.ID != full.ID || topLevel.TemplateName != full.TemplateName {
 		t.Errorf("Extract vs ExtractOnRead disagree on common fields: %+v vs %+v", topLevel, full)
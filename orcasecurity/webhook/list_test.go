@@ -0,0 +1,76 @@
+package webhook
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"terraform-provider-orcasecurity/orcasecurity/internal/testutils"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func jsonResponse(status int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: status,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}
+}
+
+// Generic webhooks and every vendor variant share service_name = "webhook".
+const mixedListBody = `{"status":"success","data":[
+	{"id":"c1","service_name":"webhook","template_name":"generic","config":{"type":"common","webhook_url":"https://example.com/hook"},"is_enabled":true},
+	{"id":"c2","service_name":"webhook","template_name":"tines-alerts","config":{"type":"tines"},"is_enabled":true},
+	{"id":"c3","service_name":"webhook","template_name":"torq-alerts","config":{"type":"torq"},"is_enabled":true},
+	{"id":"c4","service_name":"webhook","template_name":"opus-alerts","config":{"type":"opus"},"is_enabled":true},
+	{"id":"c5","service_name":"webhook","template_name":"panther-alerts","config":{"type":"panther"},"is_enabled":true},
+	{"id":"c6","service_name":"webhook","template_name":"coralogix-alerts","config":{"type":"coralogix"},"is_enabled":true},
+	{"id":"c7","service_name":"webhook","template_name":"untyped","config":{},"is_enabled":false}
+]}`
+
+func mixedListStub(t *testing.T) testutils.RoundTripFunc {
+	return func(req *http.Request) *http.Response {
+		if req.URL.Query().Get("service_name") != "webhook" {
+			t.Errorf("unexpected request %s", req.URL)
+			return jsonResponse(http.StatusNotFound, `{}`)
+		}
+		return jsonResponse(http.StatusOK, mixedListBody)
+	}
+}
+
+// Each config is offered as an import target by exactly one resource type: vendor configs by
+// their vendor resource, everything else by the generic webhook resource.
+func TestList_VendorTypesAreNotDuplicated(t *testing.T) {
+	cases := []struct {
+		name        string
+		lr          list.ListResource
+		newResource func() resource.Resource
+		want        []string
+	}{
+		{"webhook", NewWebhookListResource(), NewWebhookResource, []string{"generic", "untyped"}},
+		{"tines", NewTinesListResource(), NewTinesResource, []string{"tines-alerts"}},
+		{"torq", NewTorqListResource(), NewTorqResource, []string{"torq-alerts"}},
+		{"opus", NewOpusListResource(), NewOpusResource, []string{"opus-alerts"}},
+		{"panther", NewPantherListResource(), NewPantherResource, []string{"panther-alerts"}},
+		{"coralogix", NewCoralogixListResource(), NewCoralogixResource, []string{"coralogix-alerts"}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			results := testutils.RunList(t, tc.lr, tc.newResource,
+				testutils.NewStubAPIClient(mixedListStub(t)), testutils.ListQuery{})
+			if len(results) != len(tc.want) {
+				t.Fatalf("got %d results, want %d", len(results), len(tc.want))
+			}
+			for i, r := range results {
+				if r.Diagnostics.HasError() {
+					t.Fatalf("result %d: %v", i, r.Diagnostics)
+				}
+				if got := testutils.IdentityString(t, r, "template_name"); got != tc.want[i] {
+					t.Errorf("result %d template_name = %q, want %q", i, got, tc.want[i])
+				}
+			}
+		})
+	}
+}
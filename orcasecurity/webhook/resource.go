@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -36,6 +37,7 @@ type state struct {
 func NewWebhookResource() resource.Resource {
 	return cc.New(cc.Spec[api_client.WebhookExternalServiceConfig]{
 		TypeNameSuffix:        "_integration_webhook_template",
+		ServiceName:           api_client.WebhookConfigServiceName,
 		UIName:                "Webhook integration",
 		Description:           "Manage a Webhook integration in Orca. Creates an external service config of `service_name = \"webhook\"` so automations can fire HTTP callbacks to a customer-controlled endpoint.",
 		SupportsBusinessUnits: true,
@@ -108,12 +110,19 @@ func NewWebhookResource() resource.Resource {
 		Get:           (*api_client.APIClient).GetWebhookConfigByTemplate,
 		Update:        (*api_client.APIClient).UpdateWebhookConfig,
 		Delete:        (*api_client.APIClient).DeleteWebhookConfig,
+		List:          (*api_client.APIClient).ListWebhookConfigs,
 	})
 }
 
+// NewWebhookListResource lists the integrations managed by NewWebhookResource. Configs whose
+// type has a dedicated vendor resource are left to that resource's list.
+func NewWebhookListResource() list.ListResource {
+	return cc.NewList(NewWebhookResource)
+}
+
 // extractFull is the Read-path refresh of the whole config block. Reuses the shared webhook
 // conversion helpers so there is one source of truth for body_fields / custom_headers.
-func extractFull(api *api_client.WebhookExternalServiceConfig, st cc.State, diags *diag.Diagnostics) cc.APIObject {
+func extractFull(ctx context.Context, api *api_client.WebhookExternalServiceConfig, st cc.State, diags *diag.Diagnostics) cc.APIObject {
 	s := st.(*state)
 	if s.Config == nil {
 		s.Config = &webhookConfigModel{}
@@ -127,11 +136,11 @@ func extractFull(api *api_client.WebhookExternalServiceConfig, st cc.State, diag
 			s.Config.APIKey = types.StringNull()
 		}
 	}
-	bodyFields, bfDiags := BodyFieldsFromAPI(context.Background(), api.Config.BodyFields, s.Config.BodyFields)
+	bodyFields, bfDiags := BodyFieldsFromAPI(ctx, api.Config.BodyFields, s.Config.BodyFields)
 	diags.Append(bfDiags...)
 	s.Config.BodyFields = bodyFields
 	headers, headerDiags := CustomHeadersFromAPI(api.Config.CustomHeaders, s.Config.CustomHeaders)
 	diags.Append(headerDiags...)
 	s.Config.CustomHeaders = headers
-	return ExtractTopLevel(api, st, diags)
+	return ExtractTopLevel(ctx, api, st, diags)
 }
@@ -0,0 +1,225 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	cc "terraform-provider-orcasecurity/orcasecurity/config_integration_common"
+	common "terraform-provider-orcasecurity/orcasecurity/integrations_common"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// vendorState is the flat TF model shared by the webhook vendor resources (Tines, Torq, Opus,
+// Panther and Coralogix). Unlike the
+// generic webhook resource there is no nested config block and no type argument: the
+// resource type picks the discriminator.
+type vendorState struct {
+	cc.CommonFieldsWithBU
+	WebhookURL          types.String `tfsdk:"webhook_url"`
+	WebhookURLWO        types.String `tfsdk:"webhook_url_wo"`
+	WebhookURLWOVersion types.Int64  `tfsdk:"webhook_url_wo_version"`
+	AuthHeaders         types.Map    `tfsdk:"auth_headers"`
+}
+
+// webhookVendor describes one webhook-delivered vendor integration.
+type webhookVendor struct {
+	name           string // UI name, e.g. "Tines"
+	typeName       string // resource type suffix after "_integration_"
+	webhookType    string // config.type discriminator
+	urlDescription string
+	headerExample  string
+	create         func(*api_client.APIClient, context.Context, api_client.WebhookExternalServiceConfig) (*api_client.WebhookExternalServiceConfig, error)
+	get            func(*api_client.APIClient, context.Context, string) (*api_client.WebhookExternalServiceConfig, error)
+	update         func(*api_client.APIClient, context.Context, string, api_client.WebhookExternalServiceConfig) (*api_client.WebhookExternalServiceConfig, error)
+	list           func(*api_client.APIClient, context.Context) ([]api_client.WebhookExternalServiceConfig, error)
+}
+
+var (
+	tinesVendor = webhookVendor{
+		name:           "Tines",
+		typeName:       "tines",
+		webhookType:    api_client.WebhookTypeTines,
+		urlDescription: "URL of the Tines webhook action that receives Orca alerts, for example `https://<tenant>.tines.com/webhook/<path>/<secret>`.",
+		headerExample:  "`Authorization`",
+		create:         (*api_client.APIClient).CreateTinesConfig,
+		get:            (*api_client.APIClient).GetTinesConfig,
+		update:         (*api_client.APIClient).UpdateTinesConfig,
+		list:           (*api_client.APIClient).ListTinesConfigs,
+	}
+	torqVendor = webhookVendor{
+		name:           "Torq",
+		typeName:       "torq",
+		webhookType:    api_client.WebhookTypeTorq,
+		urlDescription: "URL of the Torq webhook trigger that receives Orca alerts, for example `https://hooks.torq.io/v1/webhooks/<id>`.",
+		headerExample:  "the secret header configured on the Torq trigger",
+		create:         (*api_client.APIClient).CreateTorqConfig,
+		get:            (*api_client.APIClient).GetTorqConfig,
+		update:         (*api_client.APIClient).UpdateTorqConfig,
+		list:           (*api_client.APIClient).ListTorqConfigs,
+	}
+	opusVendor = webhookVendor{
+		name:           "Opus",
+		typeName:       "opus",
+		webhookType:    api_client.WebhookTypeOpus,
+		urlDescription: "URL of the Opus webhook that receives Orca alerts.",
+		headerExample:  "`Authorization`",
+		create:         (*api_client.APIClient).CreateOpusConfig,
+		get:            (*api_client.APIClient).GetOpusConfig,
+		update:         (*api_client.APIClient).UpdateOpusConfig,
+		list:           (*api_client.APIClient).ListOpusConfigs,
+	}
+	pantherVendor = webhookVendor{
+		name:           "Panther",
+		typeName:       "panther",
+		webhookType:    api_client.WebhookTypePanther,
+		urlDescription: "Ingest URL of the Panther HTTP log source that receives Orca alerts, for example `https://logs.<instance>.runpanther.net/http/<source-id>`.",
+		headerExample:  "the bearer token or shared-secret header configured on the HTTP log source",
+		create:         (*api_client.APIClient).CreatePantherConfig,
+		get:            (*api_client.APIClient).GetPantherConfig,
+		update:         (*api_client.APIClient).UpdatePantherConfig,
+		list:           (*api_client.APIClient).ListPantherConfigs,
+	}
+	coralogixVendor = webhookVendor{
+		name:           "Coralogix",
+		typeName:       "coralogix",
+		webhookType:    api_client.WebhookTypeCoralogix,
+		urlDescription: "Coralogix ingestion endpoint for the account's region that receives Orca alerts, for example `https://ingress.eu2.coralogix.com/logs/v1/singles`.",
+		headerExample:  "`Authorization` with the Send-Your-Data API key",
+		create:         (*api_client.APIClient).CreateCoralogixConfig,
+		get:            (*api_client.APIClient).GetCoralogixConfig,
+		update:         (*api_client.APIClient).UpdateCoralogixConfig,
+		list:           (*api_client.APIClient).ListCoralogixConfigs,
+	}
+)
+
+func (v webhookVendor) variantAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"webhook_url": schema.StringAttribute{
+			Required:    true,
+			Sensitive:   true,
+			Description: v.urlDescription + " Treated as a secret because vendor webhook URLs usually embed the credential.",
+			Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+		"auth_headers": schema.MapAttribute{
+			Optional:    true,
+			Sensitive:   true,
+			ElementType: types.StringType,
+			Description: fmt.Sprintf("HTTP headers Orca sends with every request, keyed by header name, such as %s. Sent as the webhook's custom headers.", v.headerExample),
+		},
+	}
+}
+
+// buildVendorPayload converts the planned state into the webhook payload. The API client
+// pins config.type, so it is left empty here.
+func buildVendorPayload(ctx context.Context, st cc.State, diags *diag.Diagnostics) api_client.WebhookExternalServiceConfig {
+	s := st.(*vendorState)
+	payload := api_client.WebhookExternalServiceConfig{
+		TemplateName: s.TemplateName.ValueString(),
+		IsEnabled:    s.IsEnabled.ValueBool(),
+		IsDefault:    s.IsDefault.ValueBool(),
+		Config: api_client.WebhookResourceConfig{
+			WebhookURL:    s.WebhookURL.ValueString(),
+			CustomHeaders: authHeadersToAPI(ctx, s.AuthHeaders, diags),
+		},
+	}
+	payload.BusinessUnits = common.BusinessUnitsToAPI(ctx, s.BusinessUnits, diags)
+	return payload
+}
+
+// extractVendorOnRead refreshes the sensitive fields so the next plan detects drift. Create
+// and Update use ExtractTopLevel instead, for the same consistency-check reason as the
+// generic webhook resource. webhook_url is only refreshed when it is stored in state; a value
+// supplied through webhook_url_wo must stay out of it.
+func extractVendorOnRead(ctx context.Context, api *api_client.WebhookExternalServiceConfig, st cc.State, diags *diag.Diagnostics) cc.APIObject {
+	s := st.(*vendorState)
+	if api.Config.WebhookURL != "" && !s.WebhookURL.IsNull() && !s.WebhookURL.IsUnknown() {
+		s.WebhookURL = types.StringValue(api.Config.WebhookURL)
+	}
+	if len(api.Config.CustomHeaders) > 0 || !s.AuthHeaders.IsNull() {
+		s.AuthHeaders = authHeadersFromAPI(ctx, api.Config.CustomHeaders, diags)
+	}
+	return ExtractTopLevel(ctx, api, st, diags)
+}
+
+// authHeadersToAPI converts the flat header map into the API's list-of-objects shape.
+// Returns nil when the map is null/unknown.
+func authHeadersToAPI(ctx context.Context, headers types.Map, diags *diag.Diagnostics) map[string][]api_client.WebhookCustomHeaderValue {
+	if headers.IsNull() || headers.IsUnknown() {
+		return nil
+	}
+	raw := map[string]string{}
+	diags.Append(headers.ElementsAs(ctx, &raw, false)...)
+	out := make(map[string][]api_client.WebhookCustomHeaderValue, len(raw))
+	for name, value := range raw {
+		out[name] = []api_client.WebhookCustomHeaderValue{{Custom: value}}
+	}
+	return out
+}
+
+// authHeadersFromAPI flattens the API's custom headers. A header given several values (only
+// possible through the UI or the generic webhook resource) is folded into one comma-separated
+// value, which is how HTTP combines repeated headers.
+func authHeadersFromAPI(ctx context.Context, headers map[string][]api_client.WebhookCustomHeaderValue, diags *diag.Diagnostics) types.Map {
+	flat := make(map[string]string, len(headers))
+	for name, values := range headers {
+		parts := make([]string, 0, len(values))
+		for _, v := range values {
+			parts = append(parts, v.Custom)
+		}
+		flat[name] = strings.Join(parts, ", ")
+	}
+	result, d := types.MapValueFrom(ctx, types.StringType, flat)
+	diags.Append(d...)
+	return result
+}
+
+func newVendorResource(v webhookVendor) resource.Resource {
+	return cc.New(cc.Spec[api_client.WebhookExternalServiceConfig]{
+		TypeNameSuffix:        "_integration_" + v.typeName,
+		ServiceName:           api_client.WebhookConfigServiceName,
+		UIName:                v.name + " integration",
+		Description:           fmt.Sprintf("Manage a %s integration in Orca. Creates an external service config of `service_name = \"webhook\"` with `type = \"%s\"` that sends alerts to a %s webhook.", v.name, v.webhookType, v.name),
+		SupportsBusinessUnits: true,
+		VariantAttributes:     v.variantAttributes(),
+		WriteOnlySecrets:      []string{"webhook_url"},
+		NewState:              func() cc.State { return &vendorState{} },
+		BuildPayload:          buildVendorPayload,
+		Extract:               ExtractTopLevel,
+		ExtractOnRead:         extractVendorOnRead,
+		Create:                v.create,
+		Get:                   v.get,
+		Update:                v.update,
+		Delete:                (*api_client.APIClient).DeleteWebhookConfig,
+		List:                  v.list,
+	})
+}
+
+func NewTinesResource() resource.Resource { return newVendorResource(tinesVendor) }
+func NewTorqResource() resource.Resource  { return newVendorResource(torqVendor) }
+func NewOpusResource() resource.Resource  { return newVendorResource(opusVendor) }
+
+func NewPantherResource() resource.Resource   { return newVendorResource(pantherVendor) }
+func NewCoralogixResource() resource.Resource { return newVendorResource(coralogixVendor) }
+
+// NewTinesListResource lists the integrations managed by NewTinesResource.
+func NewTinesListResource() list.ListResource { return cc.NewList(NewTinesResource) }
+
+// NewTorqListResource lists the integrations managed by NewTorqResource.
+func NewTorqListResource() list.ListResource { return cc.NewList(NewTorqResource) }
+
+// NewOpusListResource lists the integrations managed by NewOpusResource.
+func NewOpusListResource() list.ListResource { return cc.NewList(NewOpusResource) }
+
+// NewPantherListResource lists the integrations managed by NewPantherResource.
+func NewPantherListResource() list.ListResource { return cc.NewList(NewPantherResource) }
+
+// NewCoralogixListResource lists the integrations managed by NewCoralogixResource.
+func NewCoralogixListResource() list.ListResource { return cc.NewList(NewCoralogixResource) }
@@ -0,0 +1,137 @@
+package webhook
+
+import (
+	"context"
+	"terraform-provider-orcasecurity/orcasecurity/api_client"
+	cc "terraform-provider-orcasecurity/orcasecurity/config_integration_common"
+	"terraform-provider-orcasecurity/orcasecurity/internal/testutils"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The webhook URL is a secret with a write-only variant; there is no type argument because
+// the resource type picks the discriminator. Vendor resources carry business_units.
+func TestVendorResources_SchemaContract(t *testing.T) {
+	for typeName, newResource := range map[string]func() resource.Resource{
+		"orcasecurity_integration_tines":     NewTinesResource,
+		"orcasecurity_integration_torq":      NewTorqResource,
+		"orcasecurity_integration_opus":      NewOpusResource,
+		"orcasecurity_integration_panther":   NewPantherResource,
+		"orcasecurity_integration_coralogix": NewCoralogixResource,
+	} {
+		t.Run(typeName, func(t *testing.T) {
+			testutils.CheckVariantResource(t, testutils.VariantResourceSpec{
+				NewResource:      newResource,
+				TypeName:         typeName,
+				WriteOnlySecrets: []string{"webhook_url"},
+				Forbidden:        []string{"config", "type"},
+				State:            &vendorState{},
+			})
+		})
+	}
+}
+
+func TestVendorMapping(t *testing.T) {
+	headers := func(t *testing.T, kv ...string) types.Map {
+		t.Helper()
+		m := map[string]string{}
+		for i := 0; i < len(kv); i += 2 {
+			m[kv[i]] = kv[i+1]
+		}
+		v, d := types.MapValueFrom(context.Background(), types.StringType, m)
+		if d.HasError() {
+			t.Fatalf("building headers: %v", d)
+		}
+		return v
+	}
+
+	testutils.RunMappingSuite(t, testutils.MappingSuite[api_client.WebhookResourceConfig]{
+		BuildPayload:          buildVendorPayload,
+		Extract:               extractVendorOnRead,
+		TemplateName:          "tf-acc-test-tines",
+		SupportsBusinessUnits: true,
+		FilledState: func() cc.State {
+			return &vendorState{
+				WebhookURL:  types.StringValue("https://acme.tines.com/webhook/abc/def"),
+				AuthHeaders: headers(t, "Authorization", "Bearer tines-token"),
+			}
+		},
+		CheckConfig: func(t *testing.T, c api_client.WebhookResourceConfig) {
+			testutils.AssertEq(t, "webhook_url", c.WebhookURL, "https://acme.tines.com/webhook/abc/def")
+			testutils.AssertEq(t, "type", c.Type, "")
+			if got := c.CustomHeaders["Authorization"]; len(got) != 1 || got[0].Custom != "Bearer tines-token" {
+				t.Errorf("custom_headers[Authorization] = %v", got)
+			}
+		},
+		EchoConfig: api_client.WebhookResourceConfig{
+			WebhookURL: "https://acme.tines.com/webhook/returned",
+			Type:       api_client.WebhookTypeTines,
+			CustomHeaders: map[string][]api_client.WebhookCustomHeaderValue{
+				"X-Token": {{Custom: "a"}, {Custom: "b"}},
+			},
+		},
+		EchoState: func() cc.State {
+			return &vendorState{
+				WebhookURL:  types.StringValue("https://planned"),
+				AuthHeaders: types.MapNull(types.StringType),
+			}
+		},
+		CheckEchoed: func(t *testing.T, st cc.State) {
+			s := st.(*vendorState)
+			testutils.AssertEq(t, "echoed webhook_url", s.WebhookURL.ValueString(), "https://acme.tines.com/webhook/returned")
+			got := map[string]string{}
+			s.AuthHeaders.ElementsAs(context.Background(), &got, false)
+			testutils.AssertEq(t, "echoed X-Token", got["X-Token"], "a, b")
+		},
+		ZeroConfigChecks: []testutils.StateCheck{
+			{
+				Name: "empty config keeps planned webhook_url and null headers",
+				State: func() cc.State {
+					return &vendorState{
+						WebhookURL:  types.StringValue("https://planned"),
+						AuthHeaders: types.MapNull(types.StringType),
+					}
+				},
+				Check: func(t *testing.T, st cc.State) {
+					s := st.(*vendorState)
+					testutils.AssertEq(t, "planned webhook_url", s.WebhookURL.ValueString(), "https://planned")
+					if !s.AuthHeaders.IsNull() {
+						t.Errorf("auth_headers = %v, want null", s.AuthHeaders)
+					}
+				},
+			},
+			{
+				Name: "write-only webhook_url stays out of state",
+				State: func() cc.State {
+					return &vendorState{WebhookURL: types.StringNull(), AuthHeaders: types.MapNull(types.StringType)}
+				},
+				Check: func(t *testing.T, st cc.State) {
+					if s := st.(*vendorState); !s.WebhookURL.IsNull() {
+						t.Errorf("webhook_url = %v, want null", s.WebhookURL)
+					}
+				},
+			},
+		},
+	})
+}
+
+// Headers removed outside Terraform show up as drift rather than being masked by the plan.
+func TestExtractVendorOnRead_DetectsRemovedHeaders(t *testing.T) {
+	s := &vendorState{AuthHeaders: types.MapValueMust(types.StringType, map[string]attr.Value{
+		"Authorization": types.StringValue("Bearer x"),
+	})}
+	var diags diag.Diagnostics
+	extractVendorOnRead(context.Background(), &api_client.WebhookExternalServiceConfig{ID: "id-1"}, s, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if s.AuthHeaders.IsNull() || len(s.AuthHeaders.Elements()) != 0 {
+		t.Errorf("auth_headers = %v, want empty map", s.AuthHeaders)
+	}
+}
+
+var _ cc.State = &vendorState{}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -15,9 +16,11 @@ import (
 
 type state struct {
 	cc.CommonFields
-	VanityDomain types.String `tfsdk:"vanity_domain"`
-	ClientID     types.String `tfsdk:"client_id"`
-	ClientSecret types.String `tfsdk:"client_secret"`
+	VanityDomain          types.String `tfsdk:"vanity_domain"`
+	ClientID              types.String `tfsdk:"client_id"`
+	ClientSecret          types.String `tfsdk:"client_secret"`
+	ClientSecretWO        types.String `tfsdk:"client_secret_wo"`
+	ClientSecretWOVersion types.Int64  `tfsdk:"client_secret_wo_version"`
 }
 
 // buildPayload converts the planned state into the Zscaler API payload.
@@ -36,7 +39,7 @@ func buildPayload(_ context.Context, st cc.State, _ *diag.Diagnostics) api_clien
 }
 
 // extract maps the API envelope back onto state; an empty vanity_domain never clobbers the plan.
-func extract(o *api_client.ZscalerExternalServiceConfig, st cc.State, _ *diag.Diagnostics) cc.APIObject {
+func extract(_ context.Context, o *api_client.ZscalerExternalServiceConfig, st cc.State, _ *diag.Diagnostics) cc.APIObject {
 	if o.Config.VanityDomain != "" {
 		st.(*state).VanityDomain = types.StringValue(o.Config.VanityDomain)
 	}
@@ -46,6 +49,7 @@ func extract(o *api_client.ZscalerExternalServiceConfig, st cc.State, _ *diag.Di
 func NewZscalerResource() resource.Resource {
 	return cc.New(cc.Spec[api_client.ZscalerExternalServiceConfig]{
 		TypeNameSuffix: "_integration_zscaler_zpa",
+		ServiceName:    api_client.ZscalerServiceName,
 		UIName:         "Zscaler ZPA integration",
 		Description:    "Manage a Zscaler ZPA integration in Orca. Creates an external service config of `service_name = \"zscaler\"`. The OAuth `client_id` and `client_secret` are stored in Orca's secret store and are never returned by the API.",
 		VariantAttributes: map[string]schema.Attribute{
@@ -67,12 +71,19 @@ func NewZscalerResource() resource.Resource {
 				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
 			},
 		},
-		NewState:     func() cc.State { return &state{} },
-		BuildPayload: buildPayload,
-		Extract:      extract,
-		Create:       (*api_client.APIClient).CreateZscalerConfig,
-		Get:          (*api_client.APIClient).GetZscalerConfig,
-		Update:       (*api_client.APIClient).UpdateZscalerConfig,
-		Delete:       (*api_client.APIClient).DeleteZscalerConfig,
+		WriteOnlySecrets: []string{"client_secret"},
+		NewState:         func() cc.State { return &state{} },
+		BuildPayload:     buildPayload,
+		Extract:          extract,
+		Create:           (*api_client.APIClient).CreateZscalerConfig,
+		Get:              (*api_client.APIClient).GetZscalerConfig,
+		Update:           (*api_client.APIClient).UpdateZscalerConfig,
+		Delete:           (*api_client.APIClient).DeleteZscalerConfig,
+		List:             (*api_client.APIClient).ListZscalerConfigs,
 	})
 }
+
+// NewZscalerListResource lists the integrations managed by NewZscalerResource.
+func NewZscalerListResource() list.ListResource {
+	return cc.NewList(NewZscalerResource)
+}